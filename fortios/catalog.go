@@ -0,0 +1,391 @@
+package fortios
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// catalogValidation describes the ValidateFunc of an argument. The limits are found by
+// calling the function, Kind is "custom" if they cannot be found this way.
+type catalogValidation struct {
+	Kind string `json:"kind"`
+	Min  *int   `json:"min,omitempty"`
+	Max  *int   `json:"max,omitempty"`
+	// Zero tells that 0 is allowed besides the range, as by intBetweenWithZero
+	Zero bool `json:"zero,omitempty"`
+}
+
+type catalogAttribute struct {
+	// APIKey is the key of the argument in the FortiOS JSON objects, "" if the argument
+	// is not sent to FortiOS
+	APIKey     string                       `json:"api_key,omitempty"`
+	Type       string                       `json:"type"`
+	ElemType   string                       `json:"elem_type,omitempty"`
+	Required   bool                         `json:"required,omitempty"`
+	Optional   bool                         `json:"optional,omitempty"`
+	Computed   bool                         `json:"computed,omitempty"`
+	Sensitive  bool                         `json:"sensitive,omitempty"`
+	ForceNew   bool                         `json:"force_new,omitempty"`
+	Default    interface{}                  `json:"default,omitempty"`
+	MaxItems   int                          `json:"max_items,omitempty"`
+	Validation *catalogValidation           `json:"validation,omitempty"`
+	Attributes map[string]*catalogAttribute `json:"attributes,omitempty"`
+}
+
+// catalogChildTable is a table nested in the objects, such as the members of a group.
+type catalogChildTable struct {
+	Attribute string `json:"attribute"`
+	APIKey    string `json:"api_key"`
+	Mkey      string `json:"mkey"`
+}
+
+// catalogVersionGate is an argument whose API format depends on the FortiOS version.
+type catalogVersionGate struct {
+	Attribute string `json:"attribute"`
+	Since     string `json:"since"`
+	// Format is the format used since the version: "string" for a number sent as a string,
+	// "name_list" for a space separated string sent as a table of names
+	Format string `json:"format"`
+}
+
+type catalogEntry struct {
+	APIPath        string                       `json:"api_path,omitempty"`
+	Mkey           string                       `json:"mkey,omitempty"`
+	MkeyAPI        string                       `json:"mkey_api,omitempty"`
+	MkeyType       string                       `json:"mkey_type,omitempty"`
+	Singleton      bool                         `json:"singleton,omitempty"`
+	ParentPath     string                       `json:"parent_path,omitempty"`
+	ChildResources []string                     `json:"child_resources,omitempty"`
+	Attributes     map[string]*catalogAttribute `json:"attributes"`
+	ChildTables    []catalogChildTable          `json:"child_tables,omitempty"`
+	VersionGates   []catalogVersionGate         `json:"version_gates,omitempty"`
+}
+
+type catalog struct {
+	Resources   map[string]*catalogEntry `json:"resources"`
+	DataSources map[string]*catalogEntry `json:"data_sources"`
+}
+
+// catalogVersionGates lists the arguments converted with i2ss2arrFortiAPIUpgrade by the
+// resources.
+var catalogVersionGates = map[string][]catalogVersionGate{
+	"fortios_firewall_policy":                {{Attribute: "session_ttl", Since: "6.2.4", Format: "string"}},
+	"fortios_firewall_policy6":               {{Attribute: "session_ttl", Since: "6.2.4", Format: "string"}},
+	"fortios_firewall_sslsshprofile":         {{Attribute: "server_cert", Since: "7.0.0", Format: "name_list"}},
+	"fortios_firewall_vip":                   {{Attribute: "realservers.monitor", Since: "6.4.2", Format: "name_list"}},
+	"fortios_firewall_vip46":                 {{Attribute: "realservers.monitor", Since: "7.0.0", Format: "name_list"}},
+	"fortios_firewall_vip6":                  {{Attribute: "realservers.monitor", Since: "6.4.2", Format: "name_list"}},
+	"fortios_firewall_vip64":                 {{Attribute: "realservers.monitor", Since: "7.0.0", Format: "name_list"}},
+	"fortios_firewallservice_custom":         {{Attribute: "session_ttl", Since: "6.2.4", Format: "string"}},
+	"fortios_router_keychain":                {{Attribute: "key.id", Since: "6.2.4", Format: "string"}},
+	"fortios_router_policy6":                 {{Attribute: "input_device", Since: "6.2.4", Format: "name_list"}},
+	"fortios_switchcontroller_managedswitch": {{Attribute: "dynamic_capability", Since: "6.4.2", Format: "string"}},
+	"fortios_system_virtualwanlink":          {{Attribute: "service.health_check", Since: "6.4.0", Format: "name_list"}},
+	"fortios_user_domaincontroller":          {{Attribute: "ldap_server", Since: "7.0.0", Format: "name_list"}},
+	"fortios_user_krbkeytab":                 {{Attribute: "ldap_server", Since: "7.0.0", Format: "name_list"}},
+	"fortios_wanopt_profile":                 {{Attribute: "tcp.ssl_port", Since: "6.4.0", Format: "string"}},
+	"fortios_wirelesscontroller_vap":         {{Attribute: "schedule", Since: "6.2.4", Format: "name_list"}},
+}
+
+func catalogType(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeSet:
+		return "set"
+	}
+	return "invalid"
+}
+
+// catalogSearch returns the first value in [lo, hi] for which valid is true, assuming
+// that valid is false below it and true from it up to hi.
+func catalogSearch(lo, hi int, valid func(int) bool) int {
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if valid(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+func catalogIntValidation(f schema.SchemaValidateFunc) *catalogValidation {
+	valid := func(v int) bool {
+		_, errs := f(v, "")
+		return len(errs) == 0
+	}
+
+	v0, found := 0, false
+	for i := 0; i < 31 && !found; i++ {
+		for _, v := range []int{1 << uint(i), -(1 << uint(i))} {
+			if valid(v) {
+				v0, found = v, true
+				break
+			}
+		}
+	}
+	if !found {
+		return &catalogValidation{Kind: "custom"}
+	}
+
+	res := &catalogValidation{Kind: "int_range"}
+
+	if !valid(math.MaxInt32) {
+		max := catalogSearch(v0, math.MaxInt32, func(v int) bool { return !valid(v + 1) })
+		res.Max = &max
+	}
+
+	// 0 may be allowed apart from the range, as by intBetweenWithZero, so the minimum
+	// of a positive range is searched among the positive values
+	if !valid(math.MinInt32) {
+		var min int
+		switch {
+		case v0 > 0 && !valid(-1):
+			min = catalogSearch(1, v0, valid)
+			if min == 1 && valid(0) {
+				min = 0
+			}
+		case v0 > 0:
+			min = catalogSearch(math.MinInt32, -1, valid)
+		default:
+			min = catalogSearch(math.MinInt32, v0, valid)
+		}
+		res.Min = &min
+	}
+
+	res.Zero = res.Min != nil && *res.Min > 0 && valid(0)
+
+	return res
+}
+
+func catalogStringValidation(f schema.SchemaValidateFunc) *catalogValidation {
+	valid := func(n int) bool {
+		_, errs := f(strings.Repeat("a", n), "")
+		return len(errs) == 0
+	}
+
+	const limit = 1 << 16
+	if !valid(1) || valid(limit) {
+		return &catalogValidation{Kind: "custom"}
+	}
+
+	max := catalogSearch(1, limit, func(n int) bool { return !valid(n + 1) })
+	min := 0
+	if !valid(0) {
+		min = 1
+	}
+
+	return &catalogValidation{Kind: "string_length", Min: &min, Max: &max}
+}
+
+func catalogValidate(s *schema.Schema) *catalogValidation {
+	if s.ValidateFunc == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case schema.TypeInt:
+		return catalogIntValidation(s.ValidateFunc)
+	case schema.TypeString:
+		return catalogStringValidation(s.ValidateFunc)
+	}
+
+	return &catalogValidation{Kind: "custom"}
+}
+
+// catalogAttributes describes the arguments of sch. The API keys are only set if api is true.
+func catalogAttributes(sch map[string]*schema.Schema, api bool, top bool, meta map[string]bool) map[string]*catalogAttribute {
+	res := make(map[string]*catalogAttribute, len(sch))
+
+	for k, s := range sch {
+		a := &catalogAttribute{
+			Type:       catalogType(s.Type),
+			Required:   s.Required,
+			Optional:   s.Optional,
+			Computed:   s.Computed,
+			Sensitive:  s.Sensitive,
+			ForceNew:   s.ForceNew,
+			Default:    s.Default,
+			MaxItems:   s.MaxItems,
+			Validation: catalogValidate(s),
+		}
+
+		if api && !(top && meta[k]) {
+			a.APIKey = fortiAPIKey(k)
+		}
+
+		switch e := s.Elem.(type) {
+		case *schema.Resource:
+			a.Attributes = catalogAttributes(e.Schema, api, false, meta)
+		case *schema.Schema:
+			a.ElemType = catalogType(e.Type)
+		}
+
+		res[k] = a
+	}
+
+	return res
+}
+
+// catalogChildTables lists the tables nested in sch, with the path of their argument.
+func catalogChildTables(sch map[string]*schema.Schema, prefix string) []catalogChildTable {
+	var res []catalogChildTable
+
+	for k, s := range sch {
+		r := cliConfigElem(s)
+		if r == nil {
+			continue
+		}
+		if s.MaxItems != 1 {
+			res = append(res, catalogChildTable{
+				Attribute: prefix + k,
+				APIKey:    fortiAPIKey(k),
+				Mkey:      cliConfigElemKey(r),
+			})
+		}
+		res = append(res, catalogChildTables(r.Schema, prefix+k+".")...)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Attribute < res[j].Attribute })
+
+	return res
+}
+
+// catalogProviderArgs are the arguments of resources which the provider handles, with no
+// FortiOS attribute of the same name.
+var catalogProviderArgs = map[string][]string{
+	"fortios_firewallschedule_onetime": {"timezone", "expired", "active_now"},
+	"fortios_firewallservice_custom":   {"tcp_ports", "udp_ports", "sctp_ports"},
+}
+
+func catalogNewEntry(r *schema.Resource, rtype string, data bool) *catalogEntry {
+	meta := map[string]bool{"vdomparam": true, "dynamic_sort_subtable": true}
+	if data {
+		meta["filter"] = true
+		meta["namelist"] = true
+		for k := range dataSourceListSchema(map[string]*schema.Schema{}) {
+			meta[k] = true
+		}
+	} else {
+		// Added by setPositionHooks and setUsageHooks
+		_, meta["position"] = orderTableNames[rtype]
+		meta["on_destroy_referenced"] = objectDestroyTables[strings.TrimPrefix(cmdbTables[rtype].Path, "/api/v2/cmdb/")]
+		for _, k := range catalogProviderArgs[rtype] {
+			meta[k] = true
+		}
+	}
+
+	t, ok := cmdbTables[rtype]
+	e := &catalogEntry{
+		Attributes: catalogAttributes(r.Schema, ok, true, meta),
+	}
+	if !ok {
+		return e
+	}
+
+	e.APIPath = t.Path
+	if t.IsSingle() {
+		e.Singleton = true
+	} else {
+		e.Mkey = t.Mkey
+		e.MkeyAPI = t.MkeyAPI()
+		e.MkeyType = t.MkeyType
+	}
+
+	if l := strings.Split(strings.TrimPrefix(t.Path, "/api/v2/cmdb/"), "/"); len(l) > 2 {
+		e.ParentPath = "/api/v2/cmdb/" + strings.Join(l[:len(l)-1], "/")
+	}
+
+	e.ChildTables = catalogChildTables(r.Schema, "")
+	if !data {
+		e.VersionGates = catalogVersionGates[rtype]
+	}
+
+	return e
+}
+
+func newCatalog() *catalog {
+	p := Provider().(*schema.Provider)
+
+	c := &catalog{
+		Resources:   make(map[string]*catalogEntry, len(p.ResourcesMap)),
+		DataSources: make(map[string]*catalogEntry, len(p.DataSourcesMap)),
+	}
+
+	for rtype, r := range p.ResourcesMap {
+		c.Resources[rtype] = catalogNewEntry(r, rtype, false)
+	}
+
+	for rtype, e := range c.Resources {
+		if e.ParentPath == "" {
+			continue
+		}
+		for _, pe := range c.Resources {
+			if pe.APIPath == e.ParentPath {
+				pe.ChildResources = append(pe.ChildResources, rtype)
+			}
+		}
+	}
+	for _, e := range c.Resources {
+		sort.Strings(e.ChildResources)
+	}
+
+	for name, r := range p.DataSourcesMap {
+		// The list data sources read the table of the resource
+		rtype := name
+		if _, ok := cmdbTables[rtype]; !ok {
+			rtype = strings.TrimSuffix(name, "list")
+		}
+		c.DataSources[name] = catalogNewEntry(r, rtype, true)
+	}
+
+	return c
+}
+
+// Catalog implements the catalog command of the provider binary, which writes a JSON
+// catalogue of the resources and data sources with their FortiOS API mapping.
+func Catalog(args []string) error {
+	fs := flag.NewFlagSet("catalog", flag.ContinueOnError)
+	output := fs.String("output", "-", "file to write the catalogue to, - for the standard output")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(newCatalog(), "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+
+	if err := ioutil.WriteFile(*output, b, 0644); err != nil {
+		return fmt.Errorf("Error writing %s: %v", *output, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package fortios
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// certificateLocalPath is the CMDB table of the local certificates.
+const certificateLocalPath = "vpn.certificate/local"
+
+// certificateFingerprint returns the SHA-256 fingerprint of the first certificate of the
+// PEM data, in uppercase hexadecimal bytes separated by colons.
+func certificateFingerprint(data string) (string, error) {
+	rest := []byte(data)
+	for {
+		var b *pem.Block
+		b, rest = pem.Decode(rest)
+		if b == nil {
+			return "", fmt.Errorf("no PEM certificate found")
+		}
+		if b.Type == "CERTIFICATE" {
+			return certificateFingerprintDER(b.Bytes), nil
+		}
+	}
+}
+
+func certificateFingerprintDER(der []byte) string {
+	sum := sha256.Sum256(der)
+
+	l := make([]string, len(sum))
+	for i, b := range sum {
+		l[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(l, ":")
+}
+
+// certificatePKCS12Fingerprint returns the fingerprint of the certificate of the private
+// key in the base64 PKCS#12 content, or of its first certificate when no certificate has
+// the ID of the key. It returns "" for content with algorithms it cannot decrypt, such as
+// the AES encryption of OpenSSL 3, which only FortiOS can read.
+func certificatePKCS12Fingerprint(content, password string) (string, error) {
+	pfx, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 content: %v", err)
+	}
+
+	blocks, err := pkcs12.ToPEM(pfx, password)
+	if _, ok := err.(pkcs12.NotImplementedError); ok {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot read the PKCS#12 content: %v", err)
+	}
+
+	var keyID string
+	for _, b := range blocks {
+		if b.Type == "PRIVATE KEY" {
+			keyID = b.Headers["localKeyId"]
+		}
+	}
+
+	var first []byte
+	for _, b := range blocks {
+		if b.Type != "CERTIFICATE" {
+			continue
+		}
+		if keyID != "" && b.Headers["localKeyId"] == keyID {
+			return certificateFingerprintDER(b.Bytes), nil
+		}
+		if first == nil {
+			first = b.Bytes
+		}
+	}
+	if first == nil {
+		return "", fmt.Errorf("no certificate in the PKCS#12 content")
+	}
+	return certificateFingerprintDER(first), nil
+}
+
+func validateCertificatePEM(v interface{}, k string) (ws []string, es []error) {
+	if _, err := certificateFingerprint(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s: %v", k, err))
+	}
+	return
+}
+
+func validateCertificateKeyPEM(v interface{}, k string) (ws []string, es []error) {
+	if b, _ := pem.Decode([]byte(v.(string))); b == nil || !strings.HasSuffix(b.Type, "PRIVATE KEY") {
+		es = append(es, fmt.Errorf("%s: no PEM private key found", k))
+	}
+	return
+}
+
+func validateCertificateBase64(v interface{}, k string) (ws []string, es []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s: invalid base64 content: %v", k, err))
+	}
+	return
+}
+
+// certificateBase64 encodes PEM data as the file content of the monitor API.
+func certificateBase64(data string) string {
+	return base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+// certificateLocalImport imports a local certificate with monitor vpn-certificate/local/import,
+// body being the type, the file contents and the other parameters of the import.
+func certificateLocalImport(c *forticlient.FortiSDKClient, body map[string]interface{}, vdomparam string) error {
+	_, err := fortiGenericRequest(c, "POST", "/api/v2/monitor/vpn-certificate/local/import", "", body, vdomparam)
+	return err
+}
+
+// certificateLocalRead returns the local certificate name, nil if it does not exist.
+func certificateLocalRead(c *forticlient.FortiSDKClient, name, vdomparam string) (map[string]interface{}, error) {
+	return cmdbObjectRead(c, certificateLocalPath, name, vdomparam)
+}
+
+// certificateLocalDelete deletes the local certificate name if it exists.
+func certificateLocalDelete(c *forticlient.FortiSDKClient, name, vdomparam string) error {
+	res, err := fortiGenericRequest(c, "DELETE", cmdbObjectURL(certificateLocalPath, name), "", nil, vdomparam)
+	if err != nil && !fortiGenericNotFound(res) {
+		return err
+	}
+	return nil
+}
+
+// certificateLocalFingerprint returns the fingerprint of the certificate of the local
+// certificate o, "" while it has no certificate, such as a CSR not signed yet.
+func certificateLocalFingerprint(o map[string]interface{}) string {
+	fp, err := certificateFingerprint(cmdbObjectString(o["certificate"]))
+	if err != nil {
+		return ""
+	}
+	return fp
+}
+
+// certificateFingerprintDiff plans the fingerprint of the certificate configured, fp,
+// unknown when the certificate is not known yet. A change of fingerprint is what makes
+// the certificate imported again.
+func certificateFingerprintDiff(d *schema.ResourceDiff, fp string, known bool) error {
+	if !known {
+		return d.SetNewComputed("fingerprint")
+	}
+	if d.Get("fingerprint").(string) == fp {
+		return nil
+	}
+	return d.SetNew("fingerprint", fp)
+}
@@ -0,0 +1,697 @@
+package fortios
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fortinetdev/forti-sdk-go/fortios/auth"
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cliConfigNode is an object, or a table when table is true, of a FortiOS CLI
+// configuration such as the output of "show full-configuration".
+type cliConfigNode struct {
+	// key is the edit key of a table entry
+	key string
+
+	table   bool
+	entries []*cliConfigNode
+
+	// order is the order of the attributes set, unset or configured in the object
+	order []string
+	// values holds the values of the set attributes, nil for the unset ones
+	values map[string][]string
+	config map[string]*cliConfigNode
+}
+
+func newCliConfigNode(key string) *cliConfigNode {
+	return &cliConfigNode{
+		key:    key,
+		values: make(map[string][]string),
+		config: make(map[string]*cliConfigNode),
+	}
+}
+
+func (n *cliConfigNode) addAttr(name string) {
+	if _, ok := n.values[name]; ok {
+		return
+	}
+	if _, ok := n.config[name]; ok {
+		return
+	}
+	n.order = append(n.order, name)
+}
+
+func (n *cliConfigNode) entry(key string) *cliConfigNode {
+	for _, e := range n.entries {
+		if e.key == key {
+			return e
+		}
+	}
+	return nil
+}
+
+// cliConfig is a parsed FortiOS CLI configuration. The configuration outside of any
+// vdom, which is the whole configuration when vdoms are disabled, is in global.
+type cliConfig struct {
+	version string
+	global  *cliConfigNode
+	vdoms   map[string]*cliConfigNode
+}
+
+// cliConfigStatement is a line of the configuration split into words.
+type cliConfigStatement struct {
+	line  int
+	words []string
+}
+
+var cliConfigVersion = regexp.MustCompile(`^#config-version=[^-]*-(\d+\.\d+\.\d+)-`)
+
+// cliConfigTokenize splits the configuration into statements. Quoted words may span
+// several lines, such as certificates and replacement messages.
+func cliConfigTokenize(r io.Reader) ([]cliConfigStatement, string, error) {
+	br := bufio.NewReader(r)
+
+	var res []cliConfigStatement
+	var words []string
+	var word strings.Builder
+	version := ""
+	line, start := 1, 1
+	inWord := false
+	var quote rune
+	escape := false
+	comment := false
+
+	endWord := func() {
+		if inWord {
+			words = append(words, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+
+	for {
+		c, _, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if comment {
+			if c == '\n' {
+				comment = false
+				line++
+			} else {
+				word.WriteRune(c)
+			}
+			if !comment {
+				if m := cliConfigVersion.FindStringSubmatch(word.String()); m != nil {
+					version = "v" + m[1]
+				}
+				word.Reset()
+			}
+			continue
+		}
+
+		if !inWord && quote == 0 && len(words) == 0 && !escape && c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			start = line
+		}
+
+		if escape {
+			word.WriteRune(c)
+			escape = false
+			if c == '\n' {
+				line++
+			}
+			continue
+		}
+
+		switch {
+		case c == '\\':
+			escape = true
+			inWord = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+			if c == '\n' {
+				line++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == '#' && !inWord && len(words) == 0:
+			comment = true
+			word.WriteRune(c)
+		case c == '\n':
+			endWord()
+			if len(words) > 0 {
+				res = append(res, cliConfigStatement{line: start, words: words})
+				words = nil
+			}
+			line++
+			start = line
+		case c == ' ' || c == '\t' || c == '\r':
+			endWord()
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, "", fmt.Errorf("line %d: unterminated quoted string", start)
+	}
+	endWord()
+	if len(words) > 0 {
+		res = append(res, cliConfigStatement{line: start, words: words})
+	}
+
+	return res, version, nil
+}
+
+type cliConfigParser struct {
+	stmts []cliConfigStatement
+	i     int
+}
+
+func (p *cliConfigParser) errorf(format string, a ...interface{}) error {
+	line := 0
+	if p.i < len(p.stmts) {
+		line = p.stmts[p.i].line
+	} else if len(p.stmts) > 0 {
+		line = p.stmts[len(p.stmts)-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, a...))
+}
+
+// body parses the statements of n up to "next" or "end", and returns the one found.
+func (p *cliConfigParser) body(n *cliConfigNode) (string, error) {
+	for p.i < len(p.stmts) {
+		s := p.stmts[p.i]
+		w := s.words
+
+		switch w[0] {
+		case "set":
+			if len(w) < 2 {
+				return "", p.errorf("set without attribute")
+			}
+			n.addAttr(w[1])
+			n.values[w[1]] = append([]string{}, w[2:]...)
+			delete(n.config, w[1])
+			p.i++
+		case "unset":
+			if len(w) < 2 {
+				return "", p.errorf("unset without attribute")
+			}
+			n.addAttr(w[1])
+			n.values[w[1]] = nil
+			delete(n.config, w[1])
+			p.i++
+		case "config":
+			if len(w) < 2 {
+				return "", p.errorf("config without path")
+			}
+			p.i++
+			c, err := p.block()
+			if err != nil {
+				return "", err
+			}
+			name := cliConfigPath(w[1:])
+			n.addAttr(name)
+			delete(n.values, name)
+			n.config[name] = c
+		case "edit":
+			if len(w) != 2 {
+				return "", p.errorf("edit should have one key")
+			}
+			if len(n.order) > 0 && !n.table {
+				return "", p.errorf("edit in an object")
+			}
+			n.table = true
+			p.i++
+
+			e := n.entry(w[1])
+			if e == nil {
+				e = newCliConfigNode(w[1])
+				n.entries = append(n.entries, e)
+			}
+			t, err := p.body(e)
+			if err != nil {
+				return "", err
+			}
+			if t == "end" {
+				// The entry is closed by the end of the table
+				return t, nil
+			}
+		case "next", "end":
+			if len(w) != 1 {
+				return "", p.errorf("%s with arguments", w[0])
+			}
+			p.i++
+			return w[0], nil
+		default:
+			return "", p.errorf("unknown statement %s", w[0])
+		}
+	}
+
+	return "", nil
+}
+
+// block parses a config block up to its "end".
+func (p *cliConfigParser) block() (*cliConfigNode, error) {
+	n := newCliConfigNode("")
+
+	t, err := p.body(n)
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case "next":
+		p.i--
+		return nil, p.errorf("next outside of edit")
+	case "":
+		return nil, p.errorf("config without end")
+	}
+
+	return n, nil
+}
+
+// cliConfigPath converts the words of a config statement to the API path, such
+// as "system replacemsg mail" to "system.replacemsg/mail".
+func cliConfigPath(w []string) string {
+	if len(w) == 1 {
+		return w[0]
+	}
+	return strings.Join(w[:len(w)-1], ".") + "/" + w[len(w)-1]
+}
+
+// parseCliConfig parses a FortiOS CLI configuration. With vdoms enabled, the configuration
+// inside "config global" goes to the global section, and the one inside "config vdom",
+// "edit <vdom>" to the section of the vdom.
+func parseCliConfig(r io.Reader) (*cliConfig, error) {
+	stmts, version, err := cliConfigTokenize(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &cliConfig{
+		version: version,
+		global:  newCliConfigNode(""),
+		vdoms:   make(map[string]*cliConfigNode),
+	}
+
+	p := &cliConfigParser{stmts: stmts}
+	for p.i < len(p.stmts) {
+		w := p.stmts[p.i].words
+		if w[0] != "config" || len(w) < 2 {
+			return nil, p.errorf("expected config, got %s", strings.Join(w, " "))
+		}
+		p.i++
+
+		b, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case len(w) == 2 && w[1] == "global":
+			cliConfigMerge(res.global, b)
+		case len(w) == 2 && w[1] == "vdom":
+			for _, e := range b.entries {
+				v := res.vdoms[e.key]
+				if v == nil {
+					v = newCliConfigNode(e.key)
+					res.vdoms[e.key] = v
+				}
+				cliConfigMerge(v, e)
+			}
+		default:
+			path := cliConfigPath(w[1:])
+			res.global.addAttr(path)
+			res.global.config[path] = b
+		}
+	}
+
+	return res, nil
+}
+
+// cliConfigMerge adds the configuration of src to dst, a later block of the same path
+// replacing the earlier one.
+func cliConfigMerge(dst, src *cliConfigNode) {
+	for _, k := range src.order {
+		dst.addAttr(k)
+		if c, ok := src.config[k]; ok {
+			dst.config[k] = c
+			delete(dst.values, k)
+		} else {
+			dst.values[k] = src.values[k]
+			delete(dst.config, k)
+		}
+	}
+}
+
+// lookup returns the node at the API path, such as "firewall/address" or
+// "router/bgp/neighbor", looking in the vdom first and then in the global section.
+func (c *cliConfig) lookup(vdom, path string) *cliConfigNode {
+	segs := strings.Split(path, "/")
+	if len(segs) < 2 {
+		return nil
+	}
+	top := segs[0] + "/" + segs[1]
+
+	var n *cliConfigNode
+	if v := c.vdoms[vdom]; v != nil {
+		n = v.config[top]
+	}
+	if n == nil && vdom == "" && len(c.vdoms) > 0 {
+		if v := c.vdoms["root"]; v != nil {
+			n = v.config[top]
+		}
+	}
+	if n == nil {
+		n = c.global.config[top]
+	}
+
+	for _, s := range segs[2:] {
+		if n == nil {
+			return nil
+		}
+		n = n.config[s]
+	}
+
+	return n
+}
+
+type cliConfigTable struct {
+	resource *schema.Resource
+	mkey     string
+}
+
+// cliConfigTables indexes the resources of cmdbTables by API path. It is built once, as
+// data sources read concurrently. A path managed by several resources, such as
+// extender-controller/extender by fortios_extendercontroller_extender and its numbered
+// variant fortios_extendercontroller_extender1, goes to the first resource type in order.
+var (
+	cliConfigTables     map[string]cliConfigTable
+	cliConfigTablesOnce sync.Once
+)
+
+// cliConfigResource returns the resource of the table or object at the API path and the
+// argument holding the mkey of the table, or nil if no resource manages the path.
+func cliConfigResource(path string) (*schema.Resource, string) {
+	cliConfigTablesOnce.Do(func() {
+		p := Provider().(*schema.Provider)
+		cliConfigTables = make(map[string]cliConfigTable)
+		rtypes := make([]string, 0, len(cmdbTables))
+		for rtype := range cmdbTables {
+			rtypes = append(rtypes, rtype)
+		}
+		sort.Strings(rtypes)
+
+		for _, rtype := range rtypes {
+			t := cmdbTables[rtype]
+			path := strings.TrimPrefix(t.Path, "/api/v2/cmdb/")
+			if _, ok := cliConfigTables[path]; ok {
+				continue
+			}
+			cliConfigTables[path] = cliConfigTable{
+				resource: p.ResourcesMap[rtype],
+				mkey:     t.Mkey,
+			}
+		}
+	})
+
+	t := cliConfigTables[path]
+	return t.resource, t.mkey
+}
+
+// cliConfigElemKey returns the argument of a nested table holding the key of its entries.
+func cliConfigElemKey(r *schema.Resource) string {
+	if r == nil {
+		return "name"
+	}
+
+	for _, k := range []string{"name", "fosid", "id"} {
+		if _, ok := r.Schema[k]; ok {
+			return k
+		}
+	}
+
+	keys := make([]string, 0, len(r.Schema))
+	for k := range r.Schema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) > 0 {
+		return keys[0]
+	}
+
+	return "name"
+}
+
+func cliConfigElem(s *schema.Schema) *schema.Resource {
+	if s == nil {
+		return nil
+	}
+	r, _ := s.Elem.(*schema.Resource)
+	return r
+}
+
+// cliConfigScalar converts a value to the JSON type of the argument.
+func cliConfigScalar(s *schema.Schema, v string) interface{} {
+	if s != nil && (s.Type == schema.TypeInt || s.Type == schema.TypeFloat) {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+// cliConfigValue converts the values of a set statement to the JSON the API returns for
+// the argument: multiple values of a table become entries, of other arguments one string.
+func cliConfigValue(s *schema.Schema, v []string) interface{} {
+	if v == nil {
+		if s != nil && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			return []interface{}{}
+		}
+		if s != nil && (s.Type == schema.TypeInt || s.Type == schema.TypeFloat) {
+			return float64(0)
+		}
+		return ""
+	}
+
+	if s != nil && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+		l := make([]interface{}, 0, len(v))
+		if r := cliConfigElem(s); r != nil {
+			k := cliConfigElemKey(r)
+			for _, e := range v {
+				l = append(l, map[string]interface{}{
+					fortiAPIKey(k): cliConfigScalar(r.Schema[k], e),
+				})
+			}
+		} else {
+			es, _ := s.Elem.(*schema.Schema)
+			for _, e := range v {
+				l = append(l, cliConfigScalar(es, e))
+			}
+		}
+		return l
+	}
+
+	if len(v) == 1 {
+		return cliConfigScalar(s, v[0])
+	}
+	return strings.Join(v, " ")
+}
+
+// object returns the JSON the API returns for the object n, whose arguments are described
+// by r, and whose key, for a table entry, is held by the argument mkey.
+func (n *cliConfigNode) object(r *schema.Resource, mkey string) map[string]interface{} {
+	res := make(map[string]interface{})
+
+	var sch map[string]*schema.Schema
+	if r != nil {
+		sch = r.Schema
+	}
+
+	if mkey != "" {
+		res[fortiAPIKey(mkey)] = cliConfigScalar(sch[mkey], n.key)
+	}
+
+	for _, k := range n.order {
+		s := fortiAttrSchema(sch, k)
+		if c, ok := n.config[k]; ok {
+			if !c.table && len(c.order) == 0 && s != nil && s.MaxItems != 1 {
+				// An empty table
+				res[k] = []interface{}{}
+				continue
+			}
+			res[k] = c.results(cliConfigElem(s), "")
+		} else {
+			res[k] = cliConfigValue(s, n.values[k])
+		}
+	}
+
+	return res
+}
+
+// results returns the JSON the API returns for the table or object n.
+func (n *cliConfigNode) results(r *schema.Resource, mkey string) interface{} {
+	if !n.table {
+		if mkey != "" && len(n.order) == 0 {
+			// An empty table
+			return []interface{}{}
+		}
+		return n.object(r, "")
+	}
+
+	if mkey == "" {
+		mkey = cliConfigElemKey(r)
+	}
+
+	l := make([]interface{}, 0, len(n.entries))
+	for _, e := range n.entries {
+		l = append(l, e.object(r, mkey))
+	}
+	return l
+}
+
+// results returns the results of a GET of the CMDB API path split into segments, such
+// as "firewall/address", "firewall/address/<mkey>" or "system/global", in the vdom. It
+// returns false if there is no such table, entry or object in the configuration.
+func (c *cliConfig) results(vdom string, segs []string) (interface{}, bool) {
+	if len(segs) > 2 {
+		parent := strings.Join(segs[:len(segs)-1], "/")
+		if n := c.lookup(vdom, parent); n != nil && n.table {
+			e := n.entry(segs[len(segs)-1])
+			if e == nil {
+				return nil, false
+			}
+
+			r, mkey := cliConfigResource(parent)
+			if mkey == "" {
+				mkey = cliConfigElemKey(r)
+			}
+
+			return []interface{}{e.object(r, mkey)}, true
+		}
+	}
+
+	path := strings.Join(segs, "/")
+	n := c.lookup(vdom, path)
+	if n == nil {
+		return nil, false
+	}
+
+	r, mkey := cliConfigResource(path)
+
+	return n.results(r, mkey), true
+}
+
+// cliConfigTransport answers the GET requests of the CMDB API from a CLI configuration
+// instead of a FortiGate, so that the resources can read their objects offline.
+type cliConfigTransport struct {
+	config *cliConfig
+}
+
+func (t *cliConfigTransport) response(req *http.Request, status int, body map[string]interface{}) (*http.Response, error) {
+	body["http_method"] = req.Method
+	body["http_status"] = status
+	body["version"] = t.config.version
+	if status == http.StatusOK {
+		body["status"] = "success"
+	} else {
+		body["status"] = "error"
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(b)),
+		Request:    req,
+	}, nil
+}
+
+func (t *cliConfigTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	if req.Method != http.MethodGet {
+		return t.response(req, http.StatusMethodNotAllowed, map[string]interface{}{})
+	}
+
+	const prefix = "/api/v2/cmdb/"
+	p := req.URL.EscapedPath()
+	if !strings.HasPrefix(p, prefix) {
+		return t.response(req, http.StatusNotFound, map[string]interface{}{})
+	}
+
+	segs := strings.Split(strings.Trim(strings.TrimPrefix(p, prefix), "/"), "/")
+	for i, s := range segs {
+		v, err := url.PathUnescape(s)
+		if err != nil {
+			return t.response(req, http.StatusBadRequest, map[string]interface{}{})
+		}
+		segs[i] = v
+	}
+	path := strings.Join(segs, "/")
+	vdom := req.URL.Query().Get("vdom")
+
+	res, ok := t.config.results(vdom, segs)
+	if !ok {
+		if path == "system/global" {
+			// Queried for the version by the client
+			res = map[string]interface{}{}
+		} else {
+			return t.response(req, http.StatusNotFound, map[string]interface{}{})
+		}
+	}
+
+	return t.response(req, http.StatusOK, map[string]interface{}{
+		"path":    path,
+		"vdom":    vdom,
+		"results": res,
+	})
+}
+
+// newCliConfigClient returns a client reading the objects from the CLI configuration.
+func newCliConfigClient(c *cliConfig) (*FortiClient, error) {
+	a := auth.NewAuth("cli-config", "", "", "", "", "", "", "")
+	client := &http.Client{
+		Transport: &cliConfigTransport{config: c},
+	}
+
+	fc, err := forticlient.NewClient(a, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FortiClient{Client: fc}, nil
+}
@@ -0,0 +1,216 @@
+package fortios
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCliConfigResourcePaths(t *testing.T) {
+	paths := make(map[string][]string)
+	for rtype, tbl := range cmdbTables {
+		paths[tbl.Path] = append(paths[tbl.Path], rtype)
+	}
+
+	// Only the numbered variants of a resource, such as ..._extender1, may share its path
+	numbered := regexp.MustCompile(`^(.*\D)\d+$`)
+	for path, rtypes := range paths {
+		sort.Strings(rtypes)
+		for _, rtype := range rtypes[1:] {
+			if m := numbered.FindStringSubmatch(rtype); m == nil || m[1] != rtypes[0] {
+				t.Errorf("%s is managed by both %s and %s", path, rtypes[0], rtype)
+			}
+		}
+	}
+
+	r, mkey := cliConfigResource("extender-controller/extender")
+	if _, ok := r.Schema["fosid"]; !ok || mkey != "fosid" {
+		t.Errorf("extender-controller/extender: got the resource of mkey %q, want fortios_extendercontroller_extender", mkey)
+	}
+}
+
+func TestCliConfigTokenize(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  string
+		words   [][]string
+		version string
+		err     string
+	}{
+		{
+			name:   "plain words",
+			config: "config system global\n    set hostname fgt1\nend\n",
+			words:  [][]string{{"config", "system", "global"}, {"set", "hostname", "fgt1"}, {"end"}},
+		},
+		{
+			name:   "quoted strings",
+			config: `set comment "a b" 'c d' ""` + "\n",
+			words:  [][]string{{"set", "comment", "a b", "c d", ""}},
+		},
+		{
+			name:   "quotes inside quotes",
+			config: `set comment "it's" 'say "hi"'` + "\n",
+			words:  [][]string{{"set", "comment", "it's", `say "hi"`}},
+		},
+		{
+			name:   "escapes",
+			config: `set comment "a \"b\" \\c" d\ e` + "\n",
+			words:  [][]string{{"set", "comment", `a "b" \c`, "d e"}},
+		},
+		{
+			name:   "quoted string over several lines",
+			config: "set certificate \"-----BEGIN-----\nabc\n-----END-----\"\nset x y\n",
+			words:  [][]string{{"set", "certificate", "-----BEGIN-----\nabc\n-----END-----"}, {"set", "x", "y"}},
+		},
+		{
+			name:    "comments and version",
+			config:  "#config-version=FGVM64-7.0.5-FW-build0304-220208:opmode=0:vdom=0\n#conf_file_ver=1\nconfig system global\nend",
+			words:   [][]string{{"config", "system", "global"}, {"end"}},
+			version: "v7.0.5",
+		},
+		{
+			name:   "hash inside a statement",
+			config: "set comment a#b #c\n",
+			words:  [][]string{{"set", "comment", "a#b", "#c"}},
+		},
+		{
+			name:   "unterminated quote",
+			config: "set comment \"abc\nend\n",
+			err:    "line 1: unterminated quoted string",
+		},
+	}
+
+	for _, c := range cases {
+		stmts, version, err := cliConfigTokenize(strings.NewReader(c.config))
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%s: got error %v, want %q", c.name, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+			continue
+		}
+
+		var words [][]string
+		for _, s := range stmts {
+			words = append(words, s.words)
+		}
+		if !reflect.DeepEqual(words, c.words) {
+			t.Errorf("%s: got %q, want %q", c.name, words, c.words)
+		}
+		if version != c.version {
+			t.Errorf("%s: got version %q, want %q", c.name, version, c.version)
+		}
+	}
+}
+
+const testCliConfig = `#config-version=FGVM64-7.0.5-FW-build0304-220208:opmode=0:vdom=1
+config global
+config system global
+    set hostname "fgt1"
+end
+end
+config vdom
+edit root
+config firewall address
+    edit "web"
+        set subnet 10.0.0.0 255.0.0.0
+        set comment "a \"quoted\" comment"
+        unset color
+    next
+    edit "lan"
+        set type iprange
+    next
+end
+config router bgp
+    set as 65000
+    config neighbor
+        edit "10.0.0.1"
+            set remote-as 65001
+        next
+    end
+end
+config vpn certificate local
+    edit "srv"
+        set certificate "-----BEGIN CERTIFICATE-----
+MIIB
+-----END CERTIFICATE-----"
+    next
+end
+next
+end
+`
+
+func TestParseCliConfig(t *testing.T) {
+	c, err := parseCliConfig(strings.NewReader(testCliConfig))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if c.version != "v7.0.5" {
+		t.Errorf("got version %q, want v7.0.5", c.version)
+	}
+	if n := c.lookup("", "system/global"); n == nil || !reflect.DeepEqual(n.values["hostname"], []string{"fgt1"}) {
+		t.Errorf("system/global: hostname not found")
+	}
+
+	addr := c.lookup("root", "firewall/address")
+	if addr == nil || !addr.table || len(addr.entries) != 2 {
+		t.Fatalf("firewall/address: got %+v, want a table of 2 entries", addr)
+	}
+	web := addr.entry("web")
+	if web == nil {
+		t.Fatalf("firewall/address: entry web not found")
+	}
+	if !reflect.DeepEqual(web.order, []string{"subnet", "comment", "color"}) {
+		t.Errorf("web: got attributes %v", web.order)
+	}
+	if !reflect.DeepEqual(web.values["subnet"], []string{"10.0.0.0", "255.0.0.0"}) {
+		t.Errorf("web: got subnet %q", web.values["subnet"])
+	}
+	if !reflect.DeepEqual(web.values["comment"], []string{`a "quoted" comment`}) {
+		t.Errorf("web: got comment %q", web.values["comment"])
+	}
+	if v, ok := web.values["color"]; !ok || v != nil {
+		t.Errorf("web: color is not unset")
+	}
+
+	// The vdom is the default, root, when none is given
+	if n := c.lookup("", "router/bgp/neighbor"); n == nil || n.entry("10.0.0.1") == nil {
+		t.Errorf("router/bgp/neighbor: entry 10.0.0.1 not found")
+	}
+	if n := c.lookup("root", "vpn.certificate/local"); n == nil || n.entry("srv") == nil ||
+		!reflect.DeepEqual(n.entry("srv").values["certificate"], []string{"-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"}) {
+		t.Errorf("vpn.certificate/local: certificate of srv not found")
+	}
+	if n := c.lookup("other", "firewall/address"); n != nil {
+		t.Errorf("firewall/address: found in vdom other")
+	}
+}
+
+func TestParseCliConfigErrors(t *testing.T) {
+	cases := []struct {
+		config string
+		err    string
+	}{
+		{"set hostname fgt1\n", "line 1: expected config, got set hostname fgt1"},
+		{"config system global\nset hostname fgt1\n", "line 2: config without end"},
+		{"config firewall address\nedit a\nset x\nnext\nnext\nend\n", "line 5: next outside of edit"},
+		{"config firewall address\nedit a b\nnext\nend\n", "line 2: edit should have one key"},
+		{"config system global\nset a b\nedit c\nnext\nend\n", "line 3: edit in an object"},
+		{"config system global\nfoo\nend\n", "line 2: unknown statement foo"},
+		{"config system global\nunset\nend\n", "line 2: unset without attribute"},
+		{"config system global\nend now\n", "line 2: end with arguments"},
+	}
+
+	for _, c := range cases {
+		_, err := parseCliConfig(strings.NewReader(c.config))
+		if err == nil || err.Error() != c.err {
+			t.Errorf("%q: got error %v, want %q", c.config, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package fortios
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cliWriter renders FortiOS JSON objects as CLI configuration.
+type cliWriter struct {
+	b strings.Builder
+}
+
+func cliQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// cliTextKeys are the attributes holding names and free text, quoted as FortiOS shows them.
+var cliTextKeys = map[string]bool{
+	"name":        true,
+	"comment":     true,
+	"comments":    true,
+	"description": true,
+}
+
+// cliNumberWord matches the words of the values made of numbers, addresses and port ranges,
+// such as "10.0.0.0 255.255.255.0" or "80 443-445:1024-65535".
+var cliNumberWord = regexp.MustCompile(`^[0-9A-Fa-f.:/-]*[0-9][0-9A-Fa-f.:/-]*$`)
+
+// cliWord returns the value of the attribute k as written after set. Names and free text
+// are quoted, as well as the values FortiOS would otherwise split or cut: empty values,
+// values with quotes, backslashes, # or line breaks, and values with spaces unless they are
+// lists of numbers, addresses and port ranges, which FortiOS takes as several words.
+func cliWord(k, v string) string {
+	if v == "" || cliTextKeys[k] || strings.ContainsAny(v, "\"\\'#\n\r") {
+		return cliQuote(v)
+	}
+
+	words := strings.Fields(v)
+	if len(words) > 1 || len(words) == 1 && words[0] != v {
+		for _, w := range words {
+			if !cliNumberWord.MatchString(w) {
+				return cliQuote(v)
+			}
+		}
+		return strings.Join(words, " ")
+	}
+	return v
+}
+
+// cliScalar writes a number without exponent or fraction when it is an integer.
+func cliScalar(k string, v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return cliWord(k, t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(t)
+	case bool:
+		if t {
+			return "enable"
+		}
+		return "disable"
+	}
+	return cliQuote(fmt.Sprintf("%v", v))
+}
+
+// cliKey returns the key of a table entry as written after edit.
+func cliKey(v interface{}) string {
+	switch t := v.(type) {
+	case float64, int:
+		return cliScalar("", t)
+	}
+	return cliQuote(fmt.Sprintf("%v", v))
+}
+
+// cliEntryKey returns the key holding the mkey of the entries of a nested table.
+func cliEntryKey(r *schema.Resource, entries []interface{}) string {
+	if r != nil {
+		return fortiAPIKey(cliConfigElemKey(r))
+	}
+
+	for _, k := range []string{"name", "id"} {
+		for _, e := range entries {
+			if m, ok := e.(map[string]interface{}); ok && m[k] != nil {
+				return k
+			}
+		}
+	}
+
+	return "name"
+}
+
+// cliIsKeyList reports whether the entries of a nested table only hold their key, such as
+// the members of a group, which FortiOS shows as values of set.
+func cliIsKeyList(r *schema.Resource, entries []interface{}) bool {
+	if r != nil {
+		return len(r.Schema) == 1
+	}
+
+	for _, e := range entries {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		n := 0
+		for k := range m {
+			if k != "q_origin_key" {
+				n++
+			}
+		}
+		if n != 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (w *cliWriter) line(indent int, format string, a ...interface{}) {
+	w.b.WriteString(strings.Repeat("    ", indent))
+	fmt.Fprintf(&w.b, format, a...)
+	w.b.WriteString("\n")
+}
+
+// body writes the attributes of obj, the set statements first and then the nested tables
+// and objects, each in alphabetical order. skip is the key of the entry written after edit.
+func (w *cliWriter) body(indent int, r *schema.Resource, obj map[string]interface{}, skip string) {
+	var sets, configs []string
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if k == skip || k == "q_origin_key" || obj[k] == nil {
+			continue
+		}
+
+		var s *schema.Schema
+		if r != nil {
+			s = fortiAttrSchema(r.Schema, k)
+		}
+
+		switch t := obj[k].(type) {
+		case map[string]interface{}:
+			configs = append(configs, k)
+		case []interface{}:
+			if len(t) == 0 {
+				continue
+			}
+			if _, ok := t[0].(map[string]interface{}); ok && !cliIsKeyList(cliConfigElem(s), t) {
+				configs = append(configs, k)
+				continue
+			}
+			sets = append(sets, k)
+		case string:
+			if t != "" {
+				sets = append(sets, k)
+			}
+		default:
+			sets = append(sets, k)
+		}
+	}
+
+	for _, k := range sets {
+		var s *schema.Schema
+		if r != nil {
+			s = fortiAttrSchema(r.Schema, k)
+		}
+
+		l, ok := obj[k].([]interface{})
+		if !ok {
+			w.line(indent, "set %s %s", k, cliScalar(k, obj[k]))
+			continue
+		}
+
+		elem := cliConfigElem(s)
+		ek := cliEntryKey(elem, l)
+		vals := make([]string, 0, len(l))
+		for _, e := range l {
+			if m, ok := e.(map[string]interface{}); ok {
+				vals = append(vals, cliKey(m[ek]))
+			} else {
+				vals = append(vals, cliScalar(k, e))
+			}
+		}
+		w.line(indent, "set %s %s", k, strings.Join(vals, " "))
+	}
+
+	for _, k := range configs {
+		var s *schema.Schema
+		if r != nil {
+			s = fortiAttrSchema(r.Schema, k)
+		}
+		w.config(indent, k, cliConfigElem(s), obj[k], "")
+	}
+}
+
+// config writes a config block for the object, or the table of entries keyed by mkey.
+func (w *cliWriter) config(indent int, name string, r *schema.Resource, v interface{}, mkey string) {
+	w.line(indent, "config %s", name)
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		w.body(indent+1, r, t, "")
+	case []interface{}:
+		if mkey == "" {
+			mkey = cliEntryKey(r, t)
+		}
+		for _, e := range t {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			w.line(indent+1, "edit %s", cliKey(m[mkey]))
+			w.body(indent+2, r, m, mkey)
+			w.line(indent+1, "next")
+		}
+	}
+
+	w.line(indent, "end")
+}
+
+func (w *cliWriter) String() string {
+	return w.b.String()
+}
+
+// cliRender returns the CLI configuration of obj, the JSON object of the table or object
+// at the CMDB API path, such as "firewall/address" or "router/bgp/neighbor". r describes
+// the object and mkey is the argument holding the key of the table, "" for an object.
+func cliRender(path string, r *schema.Resource, mkey string, obj map[string]interface{}) string {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+
+	var names []string
+	if len(segs) >= 2 {
+		names = append(names, strings.ReplaceAll(segs[0], ".", " ")+" "+segs[1])
+		names = append(names, segs[2:]...)
+	} else {
+		names = segs
+	}
+
+	w := &cliWriter{}
+	for i, n := range names[:len(names)-1] {
+		w.line(i, "config %s", n)
+	}
+
+	last := len(names) - 1
+	if mkey != "" {
+		w.config(last, names[last], r, []interface{}{obj}, fortiAPIKey(mkey))
+	} else {
+		w.config(last, names[last], r, obj, "")
+	}
+
+	for i := last - 1; i >= 0; i-- {
+		w.line(i, "end")
+	}
+
+	return w.String()
+}
+
+// cliAPIObject converts the arguments of a resource to the JSON object FortiOS uses, with
+// the same key mapping as getObject: fosid to id and "_" to "-".
+func cliAPIObject(sch map[string]*schema.Schema, v map[string]interface{}, path string) (map[string]interface{}, error) {
+	res := make(map[string]interface{}, len(v))
+
+	for k, e := range v {
+		if path == "" && (k == "vdomparam" || k == "dynamic_sort_subtable") {
+			continue
+		}
+
+		s, ok := sch[k]
+		if !ok {
+			return nil, fmt.Errorf("unsupported argument %s%s", path, k)
+		}
+
+		elem := cliConfigElem(s)
+		if elem == nil || e == nil {
+			res[fortiAPIKey(k)] = e
+			continue
+		}
+
+		l, ok := e.([]interface{})
+		if !ok {
+			if m, ok := e.(map[string]interface{}); ok {
+				l = []interface{}{m}
+			} else {
+				return nil, fmt.Errorf("%s%s should be a list of objects", path, k)
+			}
+		}
+
+		entries := make([]interface{}, 0, len(l))
+		for _, i := range l {
+			m, ok := i.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s%s should be a list of objects", path, k)
+			}
+			o, err := cliAPIObject(elem.Schema, m, path+k+".")
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, o)
+		}
+
+		if s.MaxItems == 1 {
+			if len(entries) > 0 {
+				res[fortiAPIKey(k)] = entries[0]
+			}
+			continue
+		}
+		res[fortiAPIKey(k)] = entries
+	}
+
+	return res, nil
+}
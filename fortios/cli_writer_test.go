@@ -0,0 +1,78 @@
+package fortios
+
+import (
+	"testing"
+)
+
+func TestCliWord(t *testing.T) {
+	cases := []struct {
+		k, v string
+		want string
+	}{
+		{"action", "accept", "accept"},
+		{"srcintf", "port1", "port1"},
+		{"name", "web", `"web"`},
+		{"comments", "allow web", `"allow web"`},
+		{"comment", "", `""`},
+		{"tcp-portrange", "80 443-445", "80 443-445"},
+		{"udp-portrange", "53:1024-65535  5353", "53:1024-65535 5353"},
+		{"subnet", "10.0.0.0 255.255.255.0", "10.0.0.0 255.255.255.0"},
+		{"ip6", "2001:db8::1/64", "2001:db8::1/64"},
+		{"fqdn", "www.example.com", "www.example.com"},
+		{"buffer", "two words", `"two words"`},
+		{"buffer", "dead beef", `"dead beef"`},
+		{"buffer", "80 web", `"80 web"`},
+		{"buffer", "say \"hi\"", `"say \"hi\""`},
+		{"buffer", "#1", `"#1"`},
+		{"buffer", `a\b`, `"a\\b"`},
+		{"buffer", "line\nbreak", "\"line\nbreak\""},
+	}
+
+	for _, c := range cases {
+		if s := cliWord(c.k, c.v); s != c.want {
+			t.Errorf("%s %q: got %s, want %s", c.k, c.v, s, c.want)
+		}
+	}
+}
+
+func TestCliRender(t *testing.T) {
+	obj := map[string]interface{}{
+		"name":          "web",
+		"comment":       "web ports",
+		"protocol":      "TCP/UDP/SCTP",
+		"tcp-portrange": "80 443-445",
+		"udp-portrange": "",
+		"color":         float64(3),
+	}
+
+	want := `config firewall service custom
+    edit "web"
+        set color 3
+        set comment "web ports"
+        set protocol TCP/UDP/SCTP
+        set tcp-portrange 80 443-445
+    next
+end
+`
+	if s := cliRender("firewall.service/custom", resourceFirewallServiceCustom(), "name", obj); s != want {
+		t.Errorf("got\n%s\nwant\n%s", s, want)
+	}
+
+	obj = map[string]interface{}{
+		"name":   "servers",
+		"member": []interface{}{
+			map[string]interface{}{"name": "web 1"},
+			map[string]interface{}{"name": "db"},
+		},
+	}
+
+	want = `config firewall addrgrp
+    edit "servers"
+        set member "web 1" "db"
+    next
+end
+`
+	if s := cliRender("firewall/addrgrp", resourceFirewallAddrgrp(), "name", obj); s != want {
+		t.Errorf("got\n%s\nwant\n%s", s, want)
+	}
+}
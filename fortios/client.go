@@ -32,6 +32,8 @@ type Config struct {
 	CaCert     string
 	ClientCert string
 	ClientKey  string
+
+	IgnoreFields map[string][]string
 }
 
 // FortiClient contains the basic FortiOS SDK connection information to FortiOS
@@ -43,6 +45,12 @@ type FortiClient struct {
 	//to sdk client
 	Client             *forticlient.FortiSDKClient
 	ClientFortimanager *fmgclient.FmgSDKClient
+
+	// IgnoreFields lists the attribute paths per resource type that are never diffed or sent
+	IgnoreFields map[string][]string
+
+	// orders holds the order of the ordered tables read to refresh the position blocks
+	orders orderCache
 }
 
 // CreateClient creates a FortiClient Object with the authentication information.
@@ -73,6 +81,8 @@ func (c *Config) CreateClient() (interface{}, error) {
 		return nil, fmt.Errorf("FortiOS or FortiManager, at least one of their hostnames should be set")
 	}
 
+	fClient.IgnoreFields = c.IgnoreFields
+
 	return &fClient, nil
 }
 
@@ -0,0 +1,608 @@
+package fortios
+
+// cmdbTable describes the FortiOS CMDB path that a resource manages.
+type cmdbTable struct {
+	// Path is the API path of the table or object
+	Path string
+	// Mkey is the argument holding the mkey of the table, "" if Path is a single object
+	Mkey string
+	// MkeyType is "string" or "integer"
+	MkeyType string
+	// ID is the resource ID used for the single object at Path
+	ID string
+}
+
+// cmdbTables maps the resource types to the CMDB paths they manage.
+var cmdbTables = map[string]cmdbTable{
+	"fortios_alertemail_setting":                                 {Path: "/api/v2/cmdb/alertemail/setting", ID: "AlertemailSetting"},
+	"fortios_antivirus_heuristic":                                {Path: "/api/v2/cmdb/antivirus/heuristic", ID: "AntivirusHeuristic"},
+	"fortios_antivirus_profile":                                  {Path: "/api/v2/cmdb/antivirus/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_antivirus_quarantine":                               {Path: "/api/v2/cmdb/antivirus/quarantine", ID: "AntivirusQuarantine"},
+	"fortios_antivirus_settings":                                 {Path: "/api/v2/cmdb/antivirus/settings", ID: "AntivirusSettings"},
+	"fortios_application_custom":                                 {Path: "/api/v2/cmdb/application/custom", Mkey: "tag", MkeyType: "string"},
+	"fortios_application_group":                                  {Path: "/api/v2/cmdb/application/group", Mkey: "name", MkeyType: "string"},
+	"fortios_application_list":                                   {Path: "/api/v2/cmdb/application/list", Mkey: "name", MkeyType: "string"},
+	"fortios_application_name":                                   {Path: "/api/v2/cmdb/application/name", Mkey: "name", MkeyType: "string"},
+	"fortios_application_rulesettings":                           {Path: "/api/v2/cmdb/application/rule-settings", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_authentication_rule":                                {Path: "/api/v2/cmdb/authentication/rule", Mkey: "name", MkeyType: "string"},
+	"fortios_authentication_scheme":                              {Path: "/api/v2/cmdb/authentication/scheme", Mkey: "name", MkeyType: "string"},
+	"fortios_authentication_setting":                             {Path: "/api/v2/cmdb/authentication/setting", ID: "AuthenticationSetting"},
+	"fortios_certificate_ca":                                     {Path: "/api/v2/cmdb/certificate/ca", Mkey: "name", MkeyType: "string"},
+	"fortios_certificate_crl":                                    {Path: "/api/v2/cmdb/certificate/crl", Mkey: "name", MkeyType: "string"},
+	"fortios_certificate_local":                                  {Path: "/api/v2/cmdb/certificate/local", Mkey: "name", MkeyType: "string"},
+	"fortios_certificate_remote":                                 {Path: "/api/v2/cmdb/certificate/remote", Mkey: "name", MkeyType: "string"},
+	"fortios_cifs_domaincontroller":                              {Path: "/api/v2/cmdb/cifs/domain-controller", Mkey: "server_name", MkeyType: "string"},
+	"fortios_cifs_profile":                                       {Path: "/api/v2/cmdb/cifs/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_credentialstore_domaincontroller":                   {Path: "/api/v2/cmdb/credential-store/domain-controller", Mkey: "server_name", MkeyType: "string"},
+	"fortios_dlp_filepattern":                                    {Path: "/api/v2/cmdb/dlp/filepattern", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_dlp_fpdocsource":                                    {Path: "/api/v2/cmdb/dlp/fp-doc-source", Mkey: "name", MkeyType: "string"},
+	"fortios_dlp_fpsensitivity":                                  {Path: "/api/v2/cmdb/dlp/fp-sensitivity", Mkey: "name", MkeyType: "string"},
+	"fortios_dlp_sensitivity":                                    {Path: "/api/v2/cmdb/dlp/sensitivity", Mkey: "name", MkeyType: "string"},
+	"fortios_dlp_sensor":                                         {Path: "/api/v2/cmdb/dlp/sensor", Mkey: "name", MkeyType: "string"},
+	"fortios_dlp_settings":                                       {Path: "/api/v2/cmdb/dlp/settings", ID: "DlpSettings"},
+	"fortios_dnsfilter_domainfilter":                             {Path: "/api/v2/cmdb/dnsfilter/domain-filter", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_dnsfilter_profile":                                  {Path: "/api/v2/cmdb/dnsfilter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_dpdk_cpus":                                          {Path: "/api/v2/cmdb/dpdk/cpus", ID: "DpdkCpus"},
+	"fortios_dpdk_global":                                        {Path: "/api/v2/cmdb/dpdk/global", ID: "DpdkGlobal"},
+	"fortios_emailfilter_blockallowlist":                         {Path: "/api/v2/cmdb/emailfilter/block-allow-list", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_bwl":                                    {Path: "/api/v2/cmdb/emailfilter/bwl", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_bword":                                  {Path: "/api/v2/cmdb/emailfilter/bword", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_dnsbl":                                  {Path: "/api/v2/cmdb/emailfilter/dnsbl", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_fortishield":                            {Path: "/api/v2/cmdb/emailfilter/fortishield", ID: "EmailfilterFortishield"},
+	"fortios_emailfilter_iptrust":                                {Path: "/api/v2/cmdb/emailfilter/iptrust", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_mheader":                                {Path: "/api/v2/cmdb/emailfilter/mheader", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_emailfilter_options":                                {Path: "/api/v2/cmdb/emailfilter/options", ID: "EmailfilterOptions"},
+	"fortios_emailfilter_profile":                                {Path: "/api/v2/cmdb/emailfilter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_endpointcontrol_client":                             {Path: "/api/v2/cmdb/endpoint-control/client", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_endpointcontrol_fctems":                             {Path: "/api/v2/cmdb/endpoint-control/fctems", Mkey: "name", MkeyType: "string"},
+	"fortios_endpointcontrol_forticlientems":                     {Path: "/api/v2/cmdb/endpoint-control/forticlient-ems", Mkey: "name", MkeyType: "string"},
+	"fortios_endpointcontrol_forticlientregistrationsync":        {Path: "/api/v2/cmdb/endpoint-control/forticlient-registration-sync", Mkey: "peer_name", MkeyType: "string"},
+	"fortios_endpointcontrol_profile":                            {Path: "/api/v2/cmdb/endpoint-control/profile", Mkey: "profile_name", MkeyType: "string"},
+	"fortios_endpointcontrol_registeredforticlient":              {Path: "/api/v2/cmdb/endpoint-control/registered-forticlient", Mkey: "uid", MkeyType: "string"},
+	"fortios_endpointcontrol_settings":                           {Path: "/api/v2/cmdb/endpoint-control/settings", ID: "EndpointControlSettings"},
+	"fortios_extendercontroller_dataplan":                        {Path: "/api/v2/cmdb/extender-controller/dataplan", Mkey: "name", MkeyType: "string"},
+	"fortios_extendercontroller_extender":                        {Path: "/api/v2/cmdb/extender-controller/extender", Mkey: "fosid", MkeyType: "string"},
+	"fortios_extendercontroller_extender1":                       {Path: "/api/v2/cmdb/extender-controller/extender", Mkey: "name", MkeyType: "string"},
+	"fortios_extendercontroller_extenderprofile":                 {Path: "/api/v2/cmdb/extender-controller/extender-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_filefilter_profile":                                 {Path: "/api/v2/cmdb/file-filter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_DoSpolicy":                                 {Path: "/api/v2/cmdb/firewall/DoS-policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_DoSpolicy6":                                {Path: "/api/v2/cmdb/firewall/DoS-policy6", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_accessproxy":                               {Path: "/api/v2/cmdb/firewall/access-proxy", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_accessproxy6":                              {Path: "/api/v2/cmdb/firewall/access-proxy6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_accessproxysshclientcert":                  {Path: "/api/v2/cmdb/firewall/access-proxy-ssh-client-cert", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_accessproxyvirtualhost":                    {Path: "/api/v2/cmdb/firewall/access-proxy-virtual-host", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_address":                                   {Path: "/api/v2/cmdb/firewall/address", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_address6":                                  {Path: "/api/v2/cmdb/firewall/address6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_address6template":                          {Path: "/api/v2/cmdb/firewall/address6-template", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_addrgrp":                                   {Path: "/api/v2/cmdb/firewall/addrgrp", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_addrgrp6":                                  {Path: "/api/v2/cmdb/firewall/addrgrp6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_authportal":                                {Path: "/api/v2/cmdb/firewall/auth-portal", ID: "FirewallAuthPortal"},
+	"fortios_firewall_centralsnatmap":                            {Path: "/api/v2/cmdb/firewall/central-snat-map", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_city":                                      {Path: "/api/v2/cmdb/firewall/city", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_country":                                   {Path: "/api/v2/cmdb/firewall/country", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_decryptedtrafficmirror":                    {Path: "/api/v2/cmdb/firewall/decrypted-traffic-mirror", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_dnstranslation":                            {Path: "/api/v2/cmdb/firewall/dnstranslation", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_identitybasedroute":                        {Path: "/api/v2/cmdb/firewall/identity-based-route", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_interfacepolicy":                           {Path: "/api/v2/cmdb/firewall/interface-policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_interfacepolicy6":                          {Path: "/api/v2/cmdb/firewall/interface-policy6", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_internetservice":                           {Path: "/api/v2/cmdb/firewall/internet-service", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetserviceaddition":                   {Path: "/api/v2/cmdb/firewall/internet-service-addition", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetserviceappend":                     {Path: "/api/v2/cmdb/firewall/internet-service-append", ID: "FirewallInternetServiceAppend"},
+	"fortios_firewall_internetservicebotnet":                     {Path: "/api/v2/cmdb/firewall/internet-service-botnet", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetservicecustom":                     {Path: "/api/v2/cmdb/firewall/internet-service-custom", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_internetservicecustomgroup":                {Path: "/api/v2/cmdb/firewall/internet-service-custom-group", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_internetservicedefinition":                 {Path: "/api/v2/cmdb/firewall/internet-service-definition", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetserviceextension":                  {Path: "/api/v2/cmdb/firewall/internet-service-extension", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetservicegroup":                      {Path: "/api/v2/cmdb/firewall/internet-service-group", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_internetserviceipblreason":                 {Path: "/api/v2/cmdb/firewall/internet-service-ipbl-reason", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetserviceipblvendor":                 {Path: "/api/v2/cmdb/firewall/internet-service-ipbl-vendor", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetservicelist":                       {Path: "/api/v2/cmdb/firewall/internet-service-list", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetservicename":                       {Path: "/api/v2/cmdb/firewall/internet-service-name", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_internetserviceowner":                      {Path: "/api/v2/cmdb/firewall/internet-service-owner", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_internetservicereputation":                 {Path: "/api/v2/cmdb/firewall/internet-service-reputation", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_ippool":                                    {Path: "/api/v2/cmdb/firewall/ippool", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_ippool6":                                   {Path: "/api/v2/cmdb/firewall/ippool6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_iptranslation":                             {Path: "/api/v2/cmdb/firewall/ip-translation", Mkey: "transid", MkeyType: "integer"},
+	"fortios_firewall_ipv6ehfilter":                              {Path: "/api/v2/cmdb/firewall/ipv6-eh-filter", ID: "FirewallIpv6EhFilter"},
+	"fortios_firewall_ldbmonitor":                                {Path: "/api/v2/cmdb/firewall/ldb-monitor", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_localinpolicy":                             {Path: "/api/v2/cmdb/firewall/local-in-policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_localinpolicy6":                            {Path: "/api/v2/cmdb/firewall/local-in-policy6", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_multicastaddress":                          {Path: "/api/v2/cmdb/firewall/multicast-address", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_multicastaddress6":                         {Path: "/api/v2/cmdb/firewall/multicast-address6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_multicastpolicy":                           {Path: "/api/v2/cmdb/firewall/multicast-policy", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_multicastpolicy6":                          {Path: "/api/v2/cmdb/firewall/multicast-policy6", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_policy":                                    {Path: "/api/v2/cmdb/firewall/policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_policy46":                                  {Path: "/api/v2/cmdb/firewall/policy46", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_policy6":                                   {Path: "/api/v2/cmdb/firewall/policy6", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_policy64":                                  {Path: "/api/v2/cmdb/firewall/policy64", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_profilegroup":                              {Path: "/api/v2/cmdb/firewall/profile-group", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_profileprotocoloptions":                    {Path: "/api/v2/cmdb/firewall/profile-protocol-options", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_proxyaddress":                              {Path: "/api/v2/cmdb/firewall/proxy-address", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_proxyaddrgrp":                              {Path: "/api/v2/cmdb/firewall/proxy-addrgrp", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_proxypolicy":                               {Path: "/api/v2/cmdb/firewall/proxy-policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_region":                                    {Path: "/api/v2/cmdb/firewall/region", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_securitypolicy":                            {Path: "/api/v2/cmdb/firewall/security-policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewall_shapingpolicy":                             {Path: "/api/v2/cmdb/firewall/shaping-policy", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_shapingprofile":                            {Path: "/api/v2/cmdb/firewall/shaping-profile", Mkey: "profile_name", MkeyType: "string"},
+	"fortios_firewall_sniffer":                                   {Path: "/api/v2/cmdb/firewall/sniffer", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_sslserver":                                 {Path: "/api/v2/cmdb/firewall/ssl-server", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_sslsshprofile":                             {Path: "/api/v2/cmdb/firewall/ssl-ssh-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_trafficclass":                              {Path: "/api/v2/cmdb/firewall/traffic-class", Mkey: "class_id", MkeyType: "integer"},
+	"fortios_firewall_ttlpolicy":                                 {Path: "/api/v2/cmdb/firewall/ttl-policy", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_vendormac":                                 {Path: "/api/v2/cmdb/firewall/vendor-mac", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_firewall_vip":                                       {Path: "/api/v2/cmdb/firewall/vip", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vip46":                                     {Path: "/api/v2/cmdb/firewall/vip46", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vip6":                                      {Path: "/api/v2/cmdb/firewall/vip6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vip64":                                     {Path: "/api/v2/cmdb/firewall/vip64", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vipgrp":                                    {Path: "/api/v2/cmdb/firewall/vipgrp", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vipgrp46":                                  {Path: "/api/v2/cmdb/firewall/vipgrp46", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vipgrp6":                                   {Path: "/api/v2/cmdb/firewall/vipgrp6", Mkey: "name", MkeyType: "string"},
+	"fortios_firewall_vipgrp64":                                  {Path: "/api/v2/cmdb/firewall/vipgrp64", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallconsolidated_policy":                        {Path: "/api/v2/cmdb/firewall.consolidated/policy", Mkey: "policyid", MkeyType: "integer"},
+	"fortios_firewallipmacbinding_setting":                       {Path: "/api/v2/cmdb/firewall.ipmacbinding/setting", ID: "FirewallIpmacbindingSetting"},
+	"fortios_firewallipmacbinding_table":                         {Path: "/api/v2/cmdb/firewall.ipmacbinding/table", Mkey: "seq_num", MkeyType: "integer"},
+	"fortios_firewallschedule_group":                             {Path: "/api/v2/cmdb/firewall.schedule/group", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallschedule_onetime":                           {Path: "/api/v2/cmdb/firewall.schedule/onetime", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallschedule_recurring":                         {Path: "/api/v2/cmdb/firewall.schedule/recurring", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallservice_category":                           {Path: "/api/v2/cmdb/firewall.service/category", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallservice_custom":                             {Path: "/api/v2/cmdb/firewall.service/custom", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallservice_group":                              {Path: "/api/v2/cmdb/firewall.service/group", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallshaper_peripshaper":                         {Path: "/api/v2/cmdb/firewall.shaper/per-ip-shaper", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallshaper_trafficshaper":                       {Path: "/api/v2/cmdb/firewall.shaper/traffic-shaper", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallssh_hostkey":                                {Path: "/api/v2/cmdb/firewall.ssh/host-key", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallssh_localca":                                {Path: "/api/v2/cmdb/firewall.ssh/local-ca", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallssh_localkey":                               {Path: "/api/v2/cmdb/firewall.ssh/local-key", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallssh_setting":                                {Path: "/api/v2/cmdb/firewall.ssh/setting", ID: "FirewallSshSetting"},
+	"fortios_firewallssl_setting":                                {Path: "/api/v2/cmdb/firewall.ssl/setting", ID: "FirewallSslSetting"},
+	"fortios_firewallwildcardfqdn_custom":                        {Path: "/api/v2/cmdb/firewall.wildcard-fqdn/custom", Mkey: "name", MkeyType: "string"},
+	"fortios_firewallwildcardfqdn_group":                         {Path: "/api/v2/cmdb/firewall.wildcard-fqdn/group", Mkey: "name", MkeyType: "string"},
+	"fortios_ftpproxy_explicit":                                  {Path: "/api/v2/cmdb/ftp-proxy/explicit", ID: "FtpProxyExplicit"},
+	"fortios_icap_profile":                                       {Path: "/api/v2/cmdb/icap/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_icap_server":                                        {Path: "/api/v2/cmdb/icap/server", Mkey: "name", MkeyType: "string"},
+	"fortios_ips_custom":                                         {Path: "/api/v2/cmdb/ips/custom", Mkey: "tag", MkeyType: "string"},
+	"fortios_ips_decoder":                                        {Path: "/api/v2/cmdb/ips/decoder", Mkey: "name", MkeyType: "string"},
+	"fortios_ips_global":                                         {Path: "/api/v2/cmdb/ips/global", ID: "IpsGlobal"},
+	"fortios_ips_rule":                                           {Path: "/api/v2/cmdb/ips/rule", Mkey: "name", MkeyType: "string"},
+	"fortios_ips_rulesettings":                                   {Path: "/api/v2/cmdb/ips/rule-settings", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_ips_sensor":                                         {Path: "/api/v2/cmdb/ips/sensor", Mkey: "name", MkeyType: "string"},
+	"fortios_ips_settings":                                       {Path: "/api/v2/cmdb/ips/settings", ID: "IpsSettings"},
+	"fortios_ips_viewmap":                                        {Path: "/api/v2/cmdb/ips/view-map", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_log_customfield":                                    {Path: "/api/v2/cmdb/log/custom-field", Mkey: "fosid", MkeyType: "string"},
+	"fortios_log_eventfilter":                                    {Path: "/api/v2/cmdb/log/eventfilter", ID: "LogEventfilter"},
+	"fortios_log_guidisplay":                                     {Path: "/api/v2/cmdb/log/gui-display", ID: "LogGuiDisplay"},
+	"fortios_log_setting":                                        {Path: "/api/v2/cmdb/log/setting", ID: "LogSetting"},
+	"fortios_log_threatweight":                                   {Path: "/api/v2/cmdb/log/threat-weight", ID: "LogThreatWeight"},
+	"fortios_logdisk_filter":                                     {Path: "/api/v2/cmdb/log.disk/filter", ID: "LogDiskFilter"},
+	"fortios_logdisk_setting":                                    {Path: "/api/v2/cmdb/log.disk/setting", ID: "LogDiskSetting"},
+	"fortios_logfortianalyzer2_filter":                           {Path: "/api/v2/cmdb/log.fortianalyzer2/filter", ID: "LogFortianalyzer2Filter"},
+	"fortios_logfortianalyzer2_overridefilter":                   {Path: "/api/v2/cmdb/log.fortianalyzer2/override-filter", ID: "LogFortianalyzer2OverrideFilter"},
+	"fortios_logfortianalyzer2_overridesetting":                  {Path: "/api/v2/cmdb/log.fortianalyzer2/override-setting", ID: "LogFortianalyzer2OverrideSetting"},
+	"fortios_logfortianalyzer2_setting":                          {Path: "/api/v2/cmdb/log.fortianalyzer2/setting", ID: "LogFortianalyzer2Setting"},
+	"fortios_logfortianalyzer3_filter":                           {Path: "/api/v2/cmdb/log.fortianalyzer3/filter", ID: "LogFortianalyzer3Filter"},
+	"fortios_logfortianalyzer3_overridefilter":                   {Path: "/api/v2/cmdb/log.fortianalyzer3/override-filter", ID: "LogFortianalyzer3OverrideFilter"},
+	"fortios_logfortianalyzer3_overridesetting":                  {Path: "/api/v2/cmdb/log.fortianalyzer3/override-setting", ID: "LogFortianalyzer3OverrideSetting"},
+	"fortios_logfortianalyzer3_setting":                          {Path: "/api/v2/cmdb/log.fortianalyzer3/setting", ID: "LogFortianalyzer3Setting"},
+	"fortios_logfortianalyzer_filter":                            {Path: "/api/v2/cmdb/log.fortianalyzer/filter", ID: "LogFortianalyzerFilter"},
+	"fortios_logfortianalyzer_overridefilter":                    {Path: "/api/v2/cmdb/log.fortianalyzer/override-filter", ID: "LogFortianalyzerOverrideFilter"},
+	"fortios_logfortianalyzer_overridesetting":                   {Path: "/api/v2/cmdb/log.fortianalyzer/override-setting", ID: "LogFortianalyzerOverrideSetting"},
+	"fortios_logfortianalyzer_setting":                           {Path: "/api/v2/cmdb/log.fortianalyzer/setting", ID: "LogFortianalyzerSetting"},
+	"fortios_logfortianalyzercloud_filter":                       {Path: "/api/v2/cmdb/log.fortianalyzer-cloud/filter", ID: "LogFortianalyzerCloudFilter"},
+	"fortios_logfortianalyzercloud_overridefilter":               {Path: "/api/v2/cmdb/log.fortianalyzer-cloud/override-filter", ID: "LogFortianalyzerCloudOverrideFilter"},
+	"fortios_logfortianalyzercloud_overridesetting":              {Path: "/api/v2/cmdb/log.fortianalyzer-cloud/override-setting", ID: "LogFortianalyzerCloudOverrideSetting"},
+	"fortios_logfortianalyzercloud_setting":                      {Path: "/api/v2/cmdb/log.fortianalyzer-cloud/setting", ID: "LogFortianalyzerCloudSetting"},
+	"fortios_logfortiguard_filter":                               {Path: "/api/v2/cmdb/log.fortiguard/filter", ID: "LogFortiguardFilter"},
+	"fortios_logfortiguard_overridefilter":                       {Path: "/api/v2/cmdb/log.fortiguard/override-filter", ID: "LogFortiguardOverrideFilter"},
+	"fortios_logfortiguard_overridesetting":                      {Path: "/api/v2/cmdb/log.fortiguard/override-setting", ID: "LogFortiguardOverrideSetting"},
+	"fortios_logfortiguard_setting":                              {Path: "/api/v2/cmdb/log.fortiguard/setting", ID: "LogFortiguardSetting"},
+	"fortios_logmemory_filter":                                   {Path: "/api/v2/cmdb/log.memory/filter", ID: "LogMemoryFilter"},
+	"fortios_logmemory_globalsetting":                            {Path: "/api/v2/cmdb/log.memory/global-setting", ID: "LogMemoryGlobalSetting"},
+	"fortios_logmemory_setting":                                  {Path: "/api/v2/cmdb/log.memory/setting", ID: "LogMemorySetting"},
+	"fortios_lognulldevice_filter":                               {Path: "/api/v2/cmdb/log.null-device/filter", ID: "LogNullDeviceFilter"},
+	"fortios_lognulldevice_setting":                              {Path: "/api/v2/cmdb/log.null-device/setting", ID: "LogNullDeviceSetting"},
+	"fortios_logsyslogd2_filter":                                 {Path: "/api/v2/cmdb/log.syslogd2/filter", ID: "LogSyslogd2Filter"},
+	"fortios_logsyslogd2_overridefilter":                         {Path: "/api/v2/cmdb/log.syslogd2/override-filter", ID: "LogSyslogd2OverrideFilter"},
+	"fortios_logsyslogd2_overridesetting":                        {Path: "/api/v2/cmdb/log.syslogd2/override-setting", ID: "LogSyslogd2OverrideSetting"},
+	"fortios_logsyslogd2_setting":                                {Path: "/api/v2/cmdb/log.syslogd2/setting", ID: "LogSyslogd2Setting"},
+	"fortios_logsyslogd3_filter":                                 {Path: "/api/v2/cmdb/log.syslogd3/filter", ID: "LogSyslogd3Filter"},
+	"fortios_logsyslogd3_overridefilter":                         {Path: "/api/v2/cmdb/log.syslogd3/override-filter", ID: "LogSyslogd3OverrideFilter"},
+	"fortios_logsyslogd3_overridesetting":                        {Path: "/api/v2/cmdb/log.syslogd3/override-setting", ID: "LogSyslogd3OverrideSetting"},
+	"fortios_logsyslogd3_setting":                                {Path: "/api/v2/cmdb/log.syslogd3/setting", ID: "LogSyslogd3Setting"},
+	"fortios_logsyslogd4_filter":                                 {Path: "/api/v2/cmdb/log.syslogd4/filter", ID: "LogSyslogd4Filter"},
+	"fortios_logsyslogd4_overridefilter":                         {Path: "/api/v2/cmdb/log.syslogd4/override-filter", ID: "LogSyslogd4OverrideFilter"},
+	"fortios_logsyslogd4_overridesetting":                        {Path: "/api/v2/cmdb/log.syslogd4/override-setting", ID: "LogSyslogd4OverrideSetting"},
+	"fortios_logsyslogd4_setting":                                {Path: "/api/v2/cmdb/log.syslogd4/setting", ID: "LogSyslogd4Setting"},
+	"fortios_logsyslogd_filter":                                  {Path: "/api/v2/cmdb/log.syslogd/filter", ID: "LogSyslogdFilter"},
+	"fortios_logsyslogd_overridefilter":                          {Path: "/api/v2/cmdb/log.syslogd/override-filter", ID: "LogSyslogdOverrideFilter"},
+	"fortios_logsyslogd_overridesetting":                         {Path: "/api/v2/cmdb/log.syslogd/override-setting", ID: "LogSyslogdOverrideSetting"},
+	"fortios_logsyslogd_setting":                                 {Path: "/api/v2/cmdb/log.syslogd/setting", ID: "LogSyslogdSetting"},
+	"fortios_logtacacsaccounting2_filter":                        {Path: "/api/v2/cmdb/log.tacacs+accounting2/filter", ID: "LogTacacsAccounting2Filter"},
+	"fortios_logtacacsaccounting2_setting":                       {Path: "/api/v2/cmdb/log.tacacs+accounting2/setting", ID: "LogTacacsAccounting2Setting"},
+	"fortios_logtacacsaccounting3_filter":                        {Path: "/api/v2/cmdb/log.tacacs+accounting3/filter", ID: "LogTacacsAccounting3Filter"},
+	"fortios_logtacacsaccounting3_setting":                       {Path: "/api/v2/cmdb/log.tacacs+accounting3/setting", ID: "LogTacacsAccounting3Setting"},
+	"fortios_logtacacsaccounting_filter":                         {Path: "/api/v2/cmdb/log.tacacs+accounting/filter", ID: "LogTacacsAccountingFilter"},
+	"fortios_logtacacsaccounting_setting":                        {Path: "/api/v2/cmdb/log.tacacs+accounting/setting", ID: "LogTacacsAccountingSetting"},
+	"fortios_logwebtrends_filter":                                {Path: "/api/v2/cmdb/log.webtrends/filter", ID: "LogWebtrendsFilter"},
+	"fortios_logwebtrends_setting":                               {Path: "/api/v2/cmdb/log.webtrends/setting", ID: "LogWebtrendsSetting"},
+	"fortios_nsxt_servicechain":                                  {Path: "/api/v2/cmdb/nsxt/service-chain", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_nsxt_setting":                                       {Path: "/api/v2/cmdb/nsxt/setting", ID: "NsxtSetting"},
+	"fortios_report_chart":                                       {Path: "/api/v2/cmdb/report/chart", Mkey: "name", MkeyType: "string"},
+	"fortios_report_dataset":                                     {Path: "/api/v2/cmdb/report/dataset", Mkey: "name", MkeyType: "string"},
+	"fortios_report_layout":                                      {Path: "/api/v2/cmdb/report/layout", Mkey: "name", MkeyType: "string"},
+	"fortios_report_setting":                                     {Path: "/api/v2/cmdb/report/setting", ID: "ReportSetting"},
+	"fortios_report_style":                                       {Path: "/api/v2/cmdb/report/style", Mkey: "name", MkeyType: "string"},
+	"fortios_report_theme":                                       {Path: "/api/v2/cmdb/report/theme", Mkey: "name", MkeyType: "string"},
+	"fortios_router_accesslist":                                  {Path: "/api/v2/cmdb/router/access-list", Mkey: "name", MkeyType: "string"},
+	"fortios_router_accesslist6":                                 {Path: "/api/v2/cmdb/router/access-list6", Mkey: "name", MkeyType: "string"},
+	"fortios_router_aspathlist":                                  {Path: "/api/v2/cmdb/router/aspath-list", Mkey: "name", MkeyType: "string"},
+	"fortios_router_authpath":                                    {Path: "/api/v2/cmdb/router/auth-path", Mkey: "name", MkeyType: "string"},
+	"fortios_router_bfd":                                         {Path: "/api/v2/cmdb/router/bfd", ID: "RouterBfd"},
+	"fortios_router_bfd6":                                        {Path: "/api/v2/cmdb/router/bfd6", ID: "RouterBfd6"},
+	"fortios_router_bgp":                                         {Path: "/api/v2/cmdb/router/bgp", ID: "RouterBgp"},
+	"fortios_router_communitylist":                               {Path: "/api/v2/cmdb/router/community-list", Mkey: "name", MkeyType: "string"},
+	"fortios_router_isis":                                        {Path: "/api/v2/cmdb/router/isis", ID: "RouterIsis"},
+	"fortios_router_keychain":                                    {Path: "/api/v2/cmdb/router/key-chain", Mkey: "name", MkeyType: "string"},
+	"fortios_router_multicast":                                   {Path: "/api/v2/cmdb/router/multicast", ID: "RouterMulticast"},
+	"fortios_router_multicast6":                                  {Path: "/api/v2/cmdb/router/multicast6", ID: "RouterMulticast6"},
+	"fortios_router_multicastflow":                               {Path: "/api/v2/cmdb/router/multicast-flow", Mkey: "name", MkeyType: "string"},
+	"fortios_router_ospf":                                        {Path: "/api/v2/cmdb/router/ospf", ID: "RouterOspf"},
+	"fortios_router_ospf6":                                       {Path: "/api/v2/cmdb/router/ospf6", ID: "RouterOspf6"},
+	"fortios_router_policy":                                      {Path: "/api/v2/cmdb/router/policy", Mkey: "seq_num", MkeyType: "integer"},
+	"fortios_router_policy6":                                     {Path: "/api/v2/cmdb/router/policy6", Mkey: "seq_num", MkeyType: "integer"},
+	"fortios_router_prefixlist":                                  {Path: "/api/v2/cmdb/router/prefix-list", Mkey: "name", MkeyType: "string"},
+	"fortios_router_prefixlist6":                                 {Path: "/api/v2/cmdb/router/prefix-list6", Mkey: "name", MkeyType: "string"},
+	"fortios_router_rip":                                         {Path: "/api/v2/cmdb/router/rip", ID: "RouterRip"},
+	"fortios_router_ripng":                                       {Path: "/api/v2/cmdb/router/ripng", ID: "RouterRipng"},
+	"fortios_router_routemap":                                    {Path: "/api/v2/cmdb/router/route-map", Mkey: "name", MkeyType: "string"},
+	"fortios_router_setting":                                     {Path: "/api/v2/cmdb/router/setting", ID: "RouterSetting"},
+	"fortios_router_static":                                      {Path: "/api/v2/cmdb/router/static", Mkey: "seq_num", MkeyType: "integer"},
+	"fortios_router_static6":                                     {Path: "/api/v2/cmdb/router/static6", Mkey: "seq_num", MkeyType: "integer"},
+	"fortios_routerbgp_neighbor":                                 {Path: "/api/v2/cmdb/router/bgp/neighbor", Mkey: "ip", MkeyType: "string"},
+	"fortios_routerbgp_network":                                  {Path: "/api/v2/cmdb/router/bgp/network", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_routerbgp_network6":                                 {Path: "/api/v2/cmdb/router/bgp/network6", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_routerospf6_ospf6interface":                         {Path: "/api/v2/cmdb/router/ospf6/ospf6-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_routerospf_neighbor":                                {Path: "/api/v2/cmdb/router/ospf/neighbor", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_routerospf_network":                                 {Path: "/api/v2/cmdb/router/ospf/network", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_routerospf_ospfinterface":                           {Path: "/api/v2/cmdb/router/ospf/ospf-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_sctpfilter_profile":                                 {Path: "/api/v2/cmdb/sctp-filter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_spamfilter_bwl":                                     {Path: "/api/v2/cmdb/spamfilter/bwl", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_spamfilter_bword":                                   {Path: "/api/v2/cmdb/spamfilter/bword", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_spamfilter_dnsbl":                                   {Path: "/api/v2/cmdb/spamfilter/dnsbl", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_spamfilter_fortishield":                             {Path: "/api/v2/cmdb/spamfilter/fortishield", ID: "SpamfilterFortishield"},
+	"fortios_spamfilter_iptrust":                                 {Path: "/api/v2/cmdb/spamfilter/iptrust", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_spamfilter_mheader":                                 {Path: "/api/v2/cmdb/spamfilter/mheader", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_spamfilter_options":                                 {Path: "/api/v2/cmdb/spamfilter/options", ID: "SpamfilterOptions"},
+	"fortios_spamfilter_profile":                                 {Path: "/api/v2/cmdb/spamfilter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_sshfilter_profile":                                  {Path: "/api/v2/cmdb/ssh-filter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_8021Xsettings":                     {Path: "/api/v2/cmdb/switch-controller/802-1X-settings", ID: "SwitchController8021XSettings"},
+	"fortios_switchcontroller_customcommand":                     {Path: "/api/v2/cmdb/switch-controller/custom-command", Mkey: "command_name", MkeyType: "string"},
+	"fortios_switchcontroller_dynamicportpolicy":                 {Path: "/api/v2/cmdb/switch-controller/dynamic-port-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_flowtracking":                      {Path: "/api/v2/cmdb/switch-controller/flow-tracking", ID: "SwitchControllerFlowTracking"},
+	"fortios_switchcontroller_fortilinksettings":                 {Path: "/api/v2/cmdb/switch-controller/fortilink-settings", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_global":                            {Path: "/api/v2/cmdb/switch-controller/global", ID: "SwitchControllerGlobal"},
+	"fortios_switchcontroller_igmpsnooping":                      {Path: "/api/v2/cmdb/switch-controller/igmp-snooping", ID: "SwitchControllerIgmpSnooping"},
+	"fortios_switchcontroller_lldpprofile":                       {Path: "/api/v2/cmdb/switch-controller/lldp-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_lldpsettings":                      {Path: "/api/v2/cmdb/switch-controller/lldp-settings", ID: "SwitchControllerLldpSettings"},
+	"fortios_switchcontroller_location":                          {Path: "/api/v2/cmdb/switch-controller/location", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_macsyncsettings":                   {Path: "/api/v2/cmdb/switch-controller/mac-sync-settings", ID: "SwitchControllerMacSyncSettings"},
+	"fortios_switchcontroller_managedswitch":                     {Path: "/api/v2/cmdb/switch-controller/managed-switch", Mkey: "switch_id", MkeyType: "string"},
+	"fortios_switchcontroller_nacdevice":                         {Path: "/api/v2/cmdb/switch-controller/nac-device", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_switchcontroller_nacsettings":                       {Path: "/api/v2/cmdb/switch-controller/nac-settings", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_networkmonitorsettings":            {Path: "/api/v2/cmdb/switch-controller/network-monitor-settings", ID: "SwitchControllerNetworkMonitorSettings"},
+	"fortios_switchcontroller_portpolicy":                        {Path: "/api/v2/cmdb/switch-controller/port-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_quarantine":                        {Path: "/api/v2/cmdb/switch-controller/quarantine", ID: "SwitchControllerQuarantine"},
+	"fortios_switchcontroller_remotelog":                         {Path: "/api/v2/cmdb/switch-controller/remote-log", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_sflow":                             {Path: "/api/v2/cmdb/switch-controller/sflow", ID: "SwitchControllerSflow"},
+	"fortios_switchcontroller_snmpcommunity":                     {Path: "/api/v2/cmdb/switch-controller/snmp-community", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_switchcontroller_snmpsysinfo":                       {Path: "/api/v2/cmdb/switch-controller/snmp-sysinfo", ID: "SwitchControllerSnmpSysinfo"},
+	"fortios_switchcontroller_snmptrapthreshold":                 {Path: "/api/v2/cmdb/switch-controller/snmp-trap-threshold", ID: "SwitchControllerSnmpTrapThreshold"},
+	"fortios_switchcontroller_snmpuser":                          {Path: "/api/v2/cmdb/switch-controller/snmp-user", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_stormcontrol":                      {Path: "/api/v2/cmdb/switch-controller/storm-control", ID: "SwitchControllerStormControl"},
+	"fortios_switchcontroller_stormcontrolpolicy":                {Path: "/api/v2/cmdb/switch-controller/storm-control-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_stpinstance":                       {Path: "/api/v2/cmdb/switch-controller/stp-instance", Mkey: "fosid", MkeyType: "string"},
+	"fortios_switchcontroller_stpsettings":                       {Path: "/api/v2/cmdb/switch-controller/stp-settings", ID: "SwitchControllerStpSettings"},
+	"fortios_switchcontroller_switchgroup":                       {Path: "/api/v2/cmdb/switch-controller/switch-group", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_switchinterfacetag":                {Path: "/api/v2/cmdb/switch-controller/switch-interface-tag", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_switchlog":                         {Path: "/api/v2/cmdb/switch-controller/switch-log", ID: "SwitchControllerSwitchLog"},
+	"fortios_switchcontroller_switchprofile":                     {Path: "/api/v2/cmdb/switch-controller/switch-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_system":                            {Path: "/api/v2/cmdb/switch-controller/system", ID: "SwitchControllerSystem"},
+	"fortios_switchcontroller_trafficpolicy":                     {Path: "/api/v2/cmdb/switch-controller/traffic-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_trafficsniffer":                    {Path: "/api/v2/cmdb/switch-controller/traffic-sniffer", ID: "SwitchControllerTrafficSniffer"},
+	"fortios_switchcontroller_virtualportpool":                   {Path: "/api/v2/cmdb/switch-controller/virtual-port-pool", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_vlan":                              {Path: "/api/v2/cmdb/switch-controller/vlan", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontroller_vlanpolicy":                        {Path: "/api/v2/cmdb/switch-controller/vlan-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerautoconfig_custom":                  {Path: "/api/v2/cmdb/switch-controller.auto-config/custom", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerautoconfig_default":                 {Path: "/api/v2/cmdb/switch-controller.auto-config/default", ID: "SwitchControllerAutoConfigDefault"},
+	"fortios_switchcontrollerautoconfig_policy":                  {Path: "/api/v2/cmdb/switch-controller.auto-config/policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerinitialconfig_template":             {Path: "/api/v2/cmdb/switch-controller.initial-config/template", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerinitialconfig_vlans":                {Path: "/api/v2/cmdb/switch-controller.initial-config/vlans", ID: "SwitchControllerInitialConfigVlans"},
+	"fortios_switchcontrollerptp_policy":                         {Path: "/api/v2/cmdb/switch-controller.ptp/policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerptp_settings":                       {Path: "/api/v2/cmdb/switch-controller.ptp/settings", ID: "SwitchControllerPtpSettings"},
+	"fortios_switchcontrollerqos_dot1pmap":                       {Path: "/api/v2/cmdb/switch-controller.qos/dot1p-map", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerqos_ipdscpmap":                      {Path: "/api/v2/cmdb/switch-controller.qos/ip-dscp-map", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerqos_qospolicy":                      {Path: "/api/v2/cmdb/switch-controller.qos/qos-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollerqos_queuepolicy":                    {Path: "/api/v2/cmdb/switch-controller.qos/queue-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollersecuritypolicy_8021X":               {Path: "/api/v2/cmdb/switch-controller.security-policy/802-1X", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollersecuritypolicy_captiveportal":       {Path: "/api/v2/cmdb/switch-controller.security-policy/captive-portal", Mkey: "name", MkeyType: "string"},
+	"fortios_switchcontrollersecuritypolicy_localaccess":         {Path: "/api/v2/cmdb/switch-controller.security-policy/local-access", Mkey: "name", MkeyType: "string"},
+	"fortios_system3gmodem_custom":                               {Path: "/api/v2/cmdb/system.3g-modem/custom", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_accprofile":                                  {Path: "/api/v2/cmdb/system/accprofile", Mkey: "name", MkeyType: "string"},
+	"fortios_system_acme":                                        {Path: "/api/v2/cmdb/system/acme", ID: "SystemAcme"},
+	"fortios_system_admin":                                       {Path: "/api/v2/cmdb/system/admin", Mkey: "name", MkeyType: "string"},
+	"fortios_system_affinityinterrupt":                           {Path: "/api/v2/cmdb/system/affinity-interrupt", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_affinitypacketredistribution":                {Path: "/api/v2/cmdb/system/affinity-packet-redistribution", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_alarm":                                       {Path: "/api/v2/cmdb/system/alarm", ID: "SystemAlarm"},
+	"fortios_system_alias":                                       {Path: "/api/v2/cmdb/system/alias", Mkey: "name", MkeyType: "string"},
+	"fortios_system_apiuser":                                     {Path: "/api/v2/cmdb/system/api-user", Mkey: "name", MkeyType: "string"},
+	"fortios_system_arptable":                                    {Path: "/api/v2/cmdb/system/arp-table", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_autoinstall":                                 {Path: "/api/v2/cmdb/system/auto-install", ID: "SystemAutoInstall"},
+	"fortios_system_automationaction":                            {Path: "/api/v2/cmdb/system/automation-action", Mkey: "name", MkeyType: "string"},
+	"fortios_system_automationdestination":                       {Path: "/api/v2/cmdb/system/automation-destination", Mkey: "name", MkeyType: "string"},
+	"fortios_system_automationstitch":                            {Path: "/api/v2/cmdb/system/automation-stitch", Mkey: "name", MkeyType: "string"},
+	"fortios_system_automationtrigger":                           {Path: "/api/v2/cmdb/system/automation-trigger", Mkey: "name", MkeyType: "string"},
+	"fortios_system_autoscript":                                  {Path: "/api/v2/cmdb/system/auto-script", Mkey: "name", MkeyType: "string"},
+	"fortios_system_centralmanagement":                           {Path: "/api/v2/cmdb/system/central-management", ID: "SystemCentralManagement"},
+	"fortios_system_clustersync":                                 {Path: "/api/v2/cmdb/system/cluster-sync", Mkey: "sync_id", MkeyType: "integer"},
+	"fortios_system_console":                                     {Path: "/api/v2/cmdb/system/console", ID: "SystemConsole"},
+	"fortios_system_csf":                                         {Path: "/api/v2/cmdb/system/csf", ID: "SystemCsf"},
+	"fortios_system_customlanguage":                              {Path: "/api/v2/cmdb/system/custom-language", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ddns":                                        {Path: "/api/v2/cmdb/system/ddns", Mkey: "ddnsid", MkeyType: "integer"},
+	"fortios_system_dedicatedmgmt":                               {Path: "/api/v2/cmdb/system/dedicated-mgmt", ID: "SystemDedicatedMgmt"},
+	"fortios_system_dns":                                         {Path: "/api/v2/cmdb/system/dns", ID: "SystemDns"},
+	"fortios_system_dns64":                                       {Path: "/api/v2/cmdb/system/dns64", ID: "SystemDns64"},
+	"fortios_system_dnsdatabase":                                 {Path: "/api/v2/cmdb/system/dns-database", Mkey: "name", MkeyType: "string"},
+	"fortios_system_dnsserver":                                   {Path: "/api/v2/cmdb/system/dns-server", Mkey: "name", MkeyType: "string"},
+	"fortios_system_dscpbasedpriority":                           {Path: "/api/v2/cmdb/system/dscp-based-priority", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_emailserver":                                 {Path: "/api/v2/cmdb/system/email-server", ID: "SystemEmailServer"},
+	"fortios_system_externalresource":                            {Path: "/api/v2/cmdb/system/external-resource", Mkey: "name", MkeyType: "string"},
+	"fortios_system_federatedupgrade":                            {Path: "/api/v2/cmdb/system/federated-upgrade", ID: "SystemFederatedUpgrade"},
+	"fortios_system_fipscc":                                      {Path: "/api/v2/cmdb/system/fips-cc", ID: "SystemFipsCc"},
+	"fortios_system_fm":                                          {Path: "/api/v2/cmdb/system/fm", ID: "SystemFm"},
+	"fortios_system_fortiai":                                     {Path: "/api/v2/cmdb/system/fortiai", ID: "SystemFortiai"},
+	"fortios_system_fortiguard":                                  {Path: "/api/v2/cmdb/system/fortiguard", ID: "SystemFortiguard"},
+	"fortios_system_fortimanager":                                {Path: "/api/v2/cmdb/system/fortimanager", ID: "SystemFortimanager"},
+	"fortios_system_fortisandbox":                                {Path: "/api/v2/cmdb/system/fortisandbox", ID: "SystemFortisandbox"},
+	"fortios_system_fssopolling":                                 {Path: "/api/v2/cmdb/system/fsso-polling", ID: "SystemFssoPolling"},
+	"fortios_system_ftmpush":                                     {Path: "/api/v2/cmdb/system/ftm-push", ID: "SystemFtmPush"},
+	"fortios_system_geneve":                                      {Path: "/api/v2/cmdb/system/geneve", Mkey: "name", MkeyType: "string"},
+	"fortios_system_geoipcountry":                                {Path: "/api/v2/cmdb/system/geoip-country", Mkey: "fosid", MkeyType: "string"},
+	"fortios_system_geoipoverride":                               {Path: "/api/v2/cmdb/system/geoip-override", Mkey: "name", MkeyType: "string"},
+	"fortios_system_global":                                      {Path: "/api/v2/cmdb/system/global", ID: "SystemGlobal"},
+	"fortios_system_gretunnel":                                   {Path: "/api/v2/cmdb/system/gre-tunnel", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ha":                                          {Path: "/api/v2/cmdb/system/ha", ID: "SystemHa"},
+	"fortios_system_hamonitor":                                   {Path: "/api/v2/cmdb/system/ha-monitor", ID: "SystemHaMonitor"},
+	"fortios_system_ike":                                         {Path: "/api/v2/cmdb/system/ike", ID: "SystemIke"},
+	"fortios_system_interface":                                   {Path: "/api/v2/cmdb/system/interface", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ipam":                                        {Path: "/api/v2/cmdb/system/ipam", ID: "SystemIpam"},
+	"fortios_system_ipiptunnel":                                  {Path: "/api/v2/cmdb/system/ipip-tunnel", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ips":                                         {Path: "/api/v2/cmdb/system/ips", ID: "SystemIps"},
+	"fortios_system_ipsecaggregate":                              {Path: "/api/v2/cmdb/system/ipsec-aggregate", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ipsurlfilterdns":                             {Path: "/api/v2/cmdb/system/ips-urlfilter-dns", Mkey: "address", MkeyType: "string"},
+	"fortios_system_ipsurlfilterdns6":                            {Path: "/api/v2/cmdb/system/ips-urlfilter-dns6", Mkey: "address6", MkeyType: "string"},
+	"fortios_system_ipv6neighborcache":                           {Path: "/api/v2/cmdb/system/ipv6-neighbor-cache", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_ipv6tunnel":                                  {Path: "/api/v2/cmdb/system/ipv6-tunnel", Mkey: "name", MkeyType: "string"},
+	"fortios_system_linkmonitor":                                 {Path: "/api/v2/cmdb/system/link-monitor", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ltemodem":                                    {Path: "/api/v2/cmdb/system/lte-modem", ID: "SystemLteModem"},
+	"fortios_system_macaddresstable":                             {Path: "/api/v2/cmdb/system/mac-address-table", Mkey: "mac", MkeyType: "string"},
+	"fortios_system_managementtunnel":                            {Path: "/api/v2/cmdb/system/management-tunnel", ID: "SystemManagementTunnel"},
+	"fortios_system_mobiletunnel":                                {Path: "/api/v2/cmdb/system/mobile-tunnel", Mkey: "name", MkeyType: "string"},
+	"fortios_system_modem":                                       {Path: "/api/v2/cmdb/system/modem", ID: "SystemModem"},
+	"fortios_system_nat64":                                       {Path: "/api/v2/cmdb/system/nat64", ID: "SystemNat64"},
+	"fortios_system_ndproxy":                                     {Path: "/api/v2/cmdb/system/nd-proxy", ID: "SystemNdProxy"},
+	"fortios_system_netflow":                                     {Path: "/api/v2/cmdb/system/netflow", ID: "SystemNetflow"},
+	"fortios_system_networkvisibility":                           {Path: "/api/v2/cmdb/system/network-visibility", ID: "SystemNetworkVisibility"},
+	"fortios_system_npu":                                         {Path: "/api/v2/cmdb/system/npu", ID: "SystemNpu"},
+	"fortios_system_ntp":                                         {Path: "/api/v2/cmdb/system/ntp", ID: "SystemNtp"},
+	"fortios_system_objecttagging":                               {Path: "/api/v2/cmdb/system/object-tagging", Mkey: "category", MkeyType: "string"},
+	"fortios_system_passwordpolicy":                              {Path: "/api/v2/cmdb/system/password-policy", ID: "SystemPasswordPolicy"},
+	"fortios_system_passwordpolicyguestadmin":                    {Path: "/api/v2/cmdb/system/password-policy-guest-admin", ID: "SystemPasswordPolicyGuestAdmin"},
+	"fortios_system_physicalswitch":                              {Path: "/api/v2/cmdb/system/physical-switch", Mkey: "name", MkeyType: "string"},
+	"fortios_system_pppoeinterface":                              {Path: "/api/v2/cmdb/system/pppoe-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_system_proberesponse":                               {Path: "/api/v2/cmdb/system/probe-response", ID: "SystemProbeResponse"},
+	"fortios_system_proxyarp":                                    {Path: "/api/v2/cmdb/system/proxy-arp", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_ptp":                                         {Path: "/api/v2/cmdb/system/ptp", ID: "SystemPtp"},
+	"fortios_system_replacemsggroup":                             {Path: "/api/v2/cmdb/system/replacemsg-group", Mkey: "name", MkeyType: "string"},
+	"fortios_system_replacemsgimage":                             {Path: "/api/v2/cmdb/system/replacemsg-image", Mkey: "name", MkeyType: "string"},
+	"fortios_system_resourcelimits":                              {Path: "/api/v2/cmdb/system/resource-limits", ID: "SystemResourceLimits"},
+	"fortios_system_saml":                                        {Path: "/api/v2/cmdb/system/saml", ID: "SystemSaml"},
+	"fortios_system_sdnconnector":                                {Path: "/api/v2/cmdb/system/sdn-connector", Mkey: "name", MkeyType: "string"},
+	"fortios_system_sdwan":                                       {Path: "/api/v2/cmdb/system/sdwan", ID: "SystemSdwan"},
+	"fortios_system_sessionhelper":                               {Path: "/api/v2/cmdb/system/session-helper", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_sessionttl":                                  {Path: "/api/v2/cmdb/system/session-ttl", ID: "SystemSessionTtl"},
+	"fortios_system_settings":                                    {Path: "/api/v2/cmdb/system/settings", ID: "SystemSettings"},
+	"fortios_system_sflow":                                       {Path: "/api/v2/cmdb/system/sflow", ID: "SystemSflow"},
+	"fortios_system_sittunnel":                                   {Path: "/api/v2/cmdb/system/sit-tunnel", Mkey: "name", MkeyType: "string"},
+	"fortios_system_smsserver":                                   {Path: "/api/v2/cmdb/system/sms-server", Mkey: "name", MkeyType: "string"},
+	"fortios_system_speedtestschedule":                           {Path: "/api/v2/cmdb/system/speed-test-schedule", Mkey: "interface", MkeyType: "string"},
+	"fortios_system_speedtestserver":                             {Path: "/api/v2/cmdb/system/speed-test-server", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ssoadmin":                                    {Path: "/api/v2/cmdb/system/sso-admin", Mkey: "name", MkeyType: "string"},
+	"fortios_system_ssoforticloudadmin":                          {Path: "/api/v2/cmdb/system/sso-forticloud-admin", Mkey: "name", MkeyType: "string"},
+	"fortios_system_standalonecluster":                           {Path: "/api/v2/cmdb/system/standalone-cluster", ID: "SystemStandaloneCluster"},
+	"fortios_system_storage":                                     {Path: "/api/v2/cmdb/system/storage", Mkey: "name", MkeyType: "string"},
+	"fortios_system_stp":                                         {Path: "/api/v2/cmdb/system/stp", ID: "SystemStp"},
+	"fortios_system_switchinterface":                             {Path: "/api/v2/cmdb/system/switch-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_system_tosbasedpriority":                            {Path: "/api/v2/cmdb/system/tos-based-priority", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_vdom":                                        {Path: "/api/v2/cmdb/system/vdom", Mkey: "name", MkeyType: "string"},
+	"fortios_system_vdomdns":                                     {Path: "/api/v2/cmdb/system/vdom-dns", ID: "SystemVdomDns"},
+	"fortios_system_vdomexception":                               {Path: "/api/v2/cmdb/system/vdom-exception", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_system_vdomlink":                                    {Path: "/api/v2/cmdb/system/vdom-link", Mkey: "name", MkeyType: "string"},
+	"fortios_system_vdomnetflow":                                 {Path: "/api/v2/cmdb/system/vdom-netflow", ID: "SystemVdomNetflow"},
+	"fortios_system_vdomproperty":                                {Path: "/api/v2/cmdb/system/vdom-property", Mkey: "name", MkeyType: "string"},
+	"fortios_system_vdomradiusserver":                            {Path: "/api/v2/cmdb/system/vdom-radius-server", Mkey: "name", MkeyType: "string"},
+	"fortios_system_vdomsflow":                                   {Path: "/api/v2/cmdb/system/vdom-sflow", ID: "SystemVdomSflow"},
+	"fortios_system_virtualswitch":                               {Path: "/api/v2/cmdb/system/virtual-switch", Mkey: "name", MkeyType: "string"},
+	"fortios_system_virtualwanlink":                              {Path: "/api/v2/cmdb/system/virtual-wan-link", ID: "SystemVirtualWanLink"},
+	"fortios_system_virtualwirepair":                             {Path: "/api/v2/cmdb/system/virtual-wire-pair", Mkey: "name", MkeyType: "string"},
+	"fortios_system_vnetunnel":                                   {Path: "/api/v2/cmdb/system/vne-tunnel", ID: "SystemVneTunnel"},
+	"fortios_system_vxlan":                                       {Path: "/api/v2/cmdb/system/vxlan", Mkey: "name", MkeyType: "string"},
+	"fortios_system_wccp":                                        {Path: "/api/v2/cmdb/system/wccp", Mkey: "service_id", MkeyType: "string"},
+	"fortios_system_zone":                                        {Path: "/api/v2/cmdb/system/zone", Mkey: "name", MkeyType: "string"},
+	"fortios_systemautoupdate_pushupdate":                        {Path: "/api/v2/cmdb/system.autoupdate/push-update", ID: "SystemAutoupdatePushUpdate"},
+	"fortios_systemautoupdate_schedule":                          {Path: "/api/v2/cmdb/system.autoupdate/schedule", ID: "SystemAutoupdateSchedule"},
+	"fortios_systemautoupdate_tunneling":                         {Path: "/api/v2/cmdb/system.autoupdate/tunneling", ID: "SystemAutoupdateTunneling"},
+	"fortios_systemdhcp6_server":                                 {Path: "/api/v2/cmdb/system.dhcp6/server", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_systemdhcp_server":                                  {Path: "/api/v2/cmdb/system.dhcp/server", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_systemlldp_networkpolicy":                           {Path: "/api/v2/cmdb/system.lldp/network-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_systemreplacemsg_admin":                             {Path: "/api/v2/cmdb/system.replacemsg/admin", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_alertmail":                         {Path: "/api/v2/cmdb/system.replacemsg/alertmail", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_auth":                              {Path: "/api/v2/cmdb/system.replacemsg/auth", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_automation":                        {Path: "/api/v2/cmdb/system.replacemsg/automation", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_devicedetectionportal":             {Path: "/api/v2/cmdb/system.replacemsg/device-detection-portal", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_ec":                                {Path: "/api/v2/cmdb/system.replacemsg/ec", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_fortiguardwf":                      {Path: "/api/v2/cmdb/system.replacemsg/fortiguard-wf", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_ftp":                               {Path: "/api/v2/cmdb/system.replacemsg/ftp", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_http":                              {Path: "/api/v2/cmdb/system.replacemsg/http", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_icap":                              {Path: "/api/v2/cmdb/system.replacemsg/icap", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_mail":                              {Path: "/api/v2/cmdb/system.replacemsg/mail", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_nacquar":                           {Path: "/api/v2/cmdb/system.replacemsg/nac-quar", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_nntp":                              {Path: "/api/v2/cmdb/system.replacemsg/nntp", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_spam":                              {Path: "/api/v2/cmdb/system.replacemsg/spam", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_sslvpn":                            {Path: "/api/v2/cmdb/system.replacemsg/sslvpn", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_trafficquota":                      {Path: "/api/v2/cmdb/system.replacemsg/traffic-quota", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_utm":                               {Path: "/api/v2/cmdb/system.replacemsg/utm", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemreplacemsg_webproxy":                          {Path: "/api/v2/cmdb/system.replacemsg/webproxy", Mkey: "msg_type", MkeyType: "string"},
+	"fortios_systemsnmp_community":                               {Path: "/api/v2/cmdb/system.snmp/community", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_systemsnmp_sysinfo":                                 {Path: "/api/v2/cmdb/system.snmp/sysinfo", ID: "SystemSnmpSysinfo"},
+	"fortios_systemsnmp_user":                                    {Path: "/api/v2/cmdb/system.snmp/user", Mkey: "name", MkeyType: "string"},
+	"fortios_user_adgrp":                                         {Path: "/api/v2/cmdb/user/adgrp", Mkey: "name", MkeyType: "string"},
+	"fortios_user_certificate":                                   {Path: "/api/v2/cmdb/user/certificate", Mkey: "name", MkeyType: "string"},
+	"fortios_user_device":                                        {Path: "/api/v2/cmdb/user/device", Mkey: "alias", MkeyType: "string"},
+	"fortios_user_deviceaccesslist":                              {Path: "/api/v2/cmdb/user/device-access-list", Mkey: "name", MkeyType: "string"},
+	"fortios_user_devicecategory":                                {Path: "/api/v2/cmdb/user/device-category", Mkey: "name", MkeyType: "string"},
+	"fortios_user_devicegroup":                                   {Path: "/api/v2/cmdb/user/device-group", Mkey: "name", MkeyType: "string"},
+	"fortios_user_domaincontroller":                              {Path: "/api/v2/cmdb/user/domain-controller", Mkey: "name", MkeyType: "string"},
+	"fortios_user_exchange":                                      {Path: "/api/v2/cmdb/user/exchange", Mkey: "name", MkeyType: "string"},
+	"fortios_user_fortitoken":                                    {Path: "/api/v2/cmdb/user/fortitoken", Mkey: "serial_number", MkeyType: "string"},
+	"fortios_user_fsso":                                          {Path: "/api/v2/cmdb/user/fsso", Mkey: "name", MkeyType: "string"},
+	"fortios_user_fssopolling":                                   {Path: "/api/v2/cmdb/user/fsso-polling", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_user_group":                                         {Path: "/api/v2/cmdb/user/group", Mkey: "name", MkeyType: "string"},
+	"fortios_user_krbkeytab":                                     {Path: "/api/v2/cmdb/user/krb-keytab", Mkey: "name", MkeyType: "string"},
+	"fortios_user_ldap":                                          {Path: "/api/v2/cmdb/user/ldap", Mkey: "name", MkeyType: "string"},
+	"fortios_user_local":                                         {Path: "/api/v2/cmdb/user/local", Mkey: "name", MkeyType: "string"},
+	"fortios_user_nacpolicy":                                     {Path: "/api/v2/cmdb/user/nac-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_user_passwordpolicy":                                {Path: "/api/v2/cmdb/user/password-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_user_peer":                                          {Path: "/api/v2/cmdb/user/peer", Mkey: "name", MkeyType: "string"},
+	"fortios_user_peergrp":                                       {Path: "/api/v2/cmdb/user/peergrp", Mkey: "name", MkeyType: "string"},
+	"fortios_user_pop3":                                          {Path: "/api/v2/cmdb/user/pop3", Mkey: "name", MkeyType: "string"},
+	"fortios_user_quarantine":                                    {Path: "/api/v2/cmdb/user/quarantine", ID: "UserQuarantine"},
+	"fortios_user_radius":                                        {Path: "/api/v2/cmdb/user/radius", Mkey: "name", MkeyType: "string"},
+	"fortios_user_saml":                                          {Path: "/api/v2/cmdb/user/saml", Mkey: "name", MkeyType: "string"},
+	"fortios_user_securityexemptlist":                            {Path: "/api/v2/cmdb/user/security-exempt-list", Mkey: "name", MkeyType: "string"},
+	"fortios_user_setting":                                       {Path: "/api/v2/cmdb/user/setting", ID: "UserSetting"},
+	"fortios_user_tacacs":                                        {Path: "/api/v2/cmdb/user/tacacs+", Mkey: "name", MkeyType: "string"},
+	"fortios_videofilter_profile":                                {Path: "/api/v2/cmdb/videofilter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_videofilter_youtubechannelfilter":                   {Path: "/api/v2/cmdb/videofilter/youtube-channel-filter", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_videofilter_youtubekey":                             {Path: "/api/v2/cmdb/videofilter/youtube-key", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_voip_profile":                                       {Path: "/api/v2/cmdb/voip/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_vpn_l2tp":                                           {Path: "/api/v2/cmdb/vpn/l2tp", ID: "VpnL2Tp"},
+	"fortios_vpn_ocvpn":                                          {Path: "/api/v2/cmdb/vpn/ocvpn", ID: "VpnOcvpn"},
+	"fortios_vpn_pptp":                                           {Path: "/api/v2/cmdb/vpn/pptp", ID: "VpnPptp"},
+	"fortios_vpncertificate_ca":                                  {Path: "/api/v2/cmdb/vpn.certificate/ca", Mkey: "name", MkeyType: "string"},
+	"fortios_vpncertificate_crl":                                 {Path: "/api/v2/cmdb/vpn.certificate/crl", Mkey: "name", MkeyType: "string"},
+	"fortios_vpncertificate_local":                               {Path: "/api/v2/cmdb/vpn.certificate/local", Mkey: "name", MkeyType: "string"},
+	"fortios_vpncertificate_ocspserver":                          {Path: "/api/v2/cmdb/vpn.certificate/ocsp-server", Mkey: "name", MkeyType: "string"},
+	"fortios_vpncertificate_remote":                              {Path: "/api/v2/cmdb/vpn.certificate/remote", Mkey: "name", MkeyType: "string"},
+	"fortios_vpncertificate_setting":                             {Path: "/api/v2/cmdb/vpn.certificate/setting", ID: "VpnCertificateSetting"},
+	"fortios_vpnipsec_concentrator":                              {Path: "/api/v2/cmdb/vpn.ipsec/concentrator", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_fec":                                       {Path: "/api/v2/cmdb/vpn.ipsec/fec", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_forticlient":                               {Path: "/api/v2/cmdb/vpn.ipsec/forticlient", Mkey: "realm", MkeyType: "string"},
+	"fortios_vpnipsec_manualkey":                                 {Path: "/api/v2/cmdb/vpn.ipsec/manualkey", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_manualkeyinterface":                        {Path: "/api/v2/cmdb/vpn.ipsec/manualkey-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_phase1":                                    {Path: "/api/v2/cmdb/vpn.ipsec/phase1", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_phase1interface":                           {Path: "/api/v2/cmdb/vpn.ipsec/phase1-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_phase2":                                    {Path: "/api/v2/cmdb/vpn.ipsec/phase2", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnipsec_phase2interface":                           {Path: "/api/v2/cmdb/vpn.ipsec/phase2-interface", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnssl_client":                                      {Path: "/api/v2/cmdb/vpn.ssl/client", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnssl_settings":                                    {Path: "/api/v2/cmdb/vpn.ssl/settings", ID: "VpnSslSettings"},
+	"fortios_vpnsslweb_hostchecksoftware":                        {Path: "/api/v2/cmdb/vpn.ssl.web/host-check-software", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnsslweb_portal":                                   {Path: "/api/v2/cmdb/vpn.ssl.web/portal", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnsslweb_realm":                                    {Path: "/api/v2/cmdb/vpn.ssl.web/realm", Mkey: "url_path", MkeyType: "string"},
+	"fortios_vpnsslweb_userbookmark":                             {Path: "/api/v2/cmdb/vpn.ssl.web/user-bookmark", Mkey: "name", MkeyType: "string"},
+	"fortios_vpnsslweb_usergroupbookmark":                        {Path: "/api/v2/cmdb/vpn.ssl.web/user-group-bookmark", Mkey: "name", MkeyType: "string"},
+	"fortios_waf_mainclass":                                      {Path: "/api/v2/cmdb/waf/main-class", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_waf_profile":                                        {Path: "/api/v2/cmdb/waf/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_waf_signature":                                      {Path: "/api/v2/cmdb/waf/signature", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_waf_subclass":                                       {Path: "/api/v2/cmdb/waf/sub-class", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_wanopt_authgroup":                                   {Path: "/api/v2/cmdb/wanopt/auth-group", Mkey: "name", MkeyType: "string"},
+	"fortios_wanopt_cacheservice":                                {Path: "/api/v2/cmdb/wanopt/cache-service", ID: "WanoptCacheService"},
+	"fortios_wanopt_contentdeliverynetworkrule":                  {Path: "/api/v2/cmdb/wanopt/content-delivery-network-rule", Mkey: "name", MkeyType: "string"},
+	"fortios_wanopt_peer":                                        {Path: "/api/v2/cmdb/wanopt/peer", Mkey: "peer_host_id", MkeyType: "string"},
+	"fortios_wanopt_profile":                                     {Path: "/api/v2/cmdb/wanopt/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wanopt_remotestorage":                               {Path: "/api/v2/cmdb/wanopt/remote-storage", ID: "WanoptRemoteStorage"},
+	"fortios_wanopt_settings":                                    {Path: "/api/v2/cmdb/wanopt/settings", ID: "WanoptSettings"},
+	"fortios_wanopt_webcache":                                    {Path: "/api/v2/cmdb/wanopt/webcache", ID: "WanoptWebcache"},
+	"fortios_webfilter_content":                                  {Path: "/api/v2/cmdb/webfilter/content", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_webfilter_contentheader":                            {Path: "/api/v2/cmdb/webfilter/content-header", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_webfilter_fortiguard":                               {Path: "/api/v2/cmdb/webfilter/fortiguard", ID: "WebfilterFortiguard"},
+	"fortios_webfilter_ftgdlocalcat":                             {Path: "/api/v2/cmdb/webfilter/ftgd-local-cat", Mkey: "desc", MkeyType: "string"},
+	"fortios_webfilter_ftgdlocalrating":                          {Path: "/api/v2/cmdb/webfilter/ftgd-local-rating", Mkey: "url", MkeyType: "string"},
+	"fortios_webfilter_ipsurlfiltercachesetting":                 {Path: "/api/v2/cmdb/webfilter/ips-urlfilter-cache-setting", ID: "WebfilterIpsUrlfilterCacheSetting"},
+	"fortios_webfilter_ipsurlfiltersetting":                      {Path: "/api/v2/cmdb/webfilter/ips-urlfilter-setting", ID: "WebfilterIpsUrlfilterSetting"},
+	"fortios_webfilter_ipsurlfiltersetting6":                     {Path: "/api/v2/cmdb/webfilter/ips-urlfilter-setting6", ID: "WebfilterIpsUrlfilterSetting6"},
+	"fortios_webfilter_override":                                 {Path: "/api/v2/cmdb/webfilter/override", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_webfilter_profile":                                  {Path: "/api/v2/cmdb/webfilter/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_webfilter_searchengine":                             {Path: "/api/v2/cmdb/webfilter/search-engine", Mkey: "name", MkeyType: "string"},
+	"fortios_webfilter_urlfilter":                                {Path: "/api/v2/cmdb/webfilter/urlfilter", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_webproxy_debugurl":                                  {Path: "/api/v2/cmdb/web-proxy/debug-url", Mkey: "name", MkeyType: "string"},
+	"fortios_webproxy_explicit":                                  {Path: "/api/v2/cmdb/web-proxy/explicit", ID: "WebProxyExplicit"},
+	"fortios_webproxy_forwardserver":                             {Path: "/api/v2/cmdb/web-proxy/forward-server", Mkey: "name", MkeyType: "string"},
+	"fortios_webproxy_forwardservergroup":                        {Path: "/api/v2/cmdb/web-proxy/forward-server-group", Mkey: "name", MkeyType: "string"},
+	"fortios_webproxy_global":                                    {Path: "/api/v2/cmdb/web-proxy/global", ID: "WebProxyGlobal"},
+	"fortios_webproxy_profile":                                   {Path: "/api/v2/cmdb/web-proxy/profile", Mkey: "name", MkeyType: "string"},
+	"fortios_webproxy_urlmatch":                                  {Path: "/api/v2/cmdb/web-proxy/url-match", Mkey: "name", MkeyType: "string"},
+	"fortios_webproxy_wisp":                                      {Path: "/api/v2/cmdb/web-proxy/wisp", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_accesscontrollist":               {Path: "/api/v2/cmdb/wireless-controller/access-control-list", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_address":                         {Path: "/api/v2/cmdb/wireless-controller/address", Mkey: "fosid", MkeyType: "string"},
+	"fortios_wirelesscontroller_addrgrp":                         {Path: "/api/v2/cmdb/wireless-controller/addrgrp", Mkey: "fosid", MkeyType: "string"},
+	"fortios_wirelesscontroller_apcfgprofile":                    {Path: "/api/v2/cmdb/wireless-controller/apcfg-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_apstatus":                        {Path: "/api/v2/cmdb/wireless-controller/ap-status", Mkey: "fosid", MkeyType: "integer"},
+	"fortios_wirelesscontroller_arrpprofile":                     {Path: "/api/v2/cmdb/wireless-controller/arrp-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_bleprofile":                      {Path: "/api/v2/cmdb/wireless-controller/ble-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_bonjourprofile":                  {Path: "/api/v2/cmdb/wireless-controller/bonjour-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_global":                          {Path: "/api/v2/cmdb/wireless-controller/global", ID: "WirelessControllerGlobal"},
+	"fortios_wirelesscontroller_intercontroller":                 {Path: "/api/v2/cmdb/wireless-controller/inter-controller", ID: "WirelessControllerInterController"},
+	"fortios_wirelesscontroller_log":                             {Path: "/api/v2/cmdb/wireless-controller/log", ID: "WirelessControllerLog"},
+	"fortios_wirelesscontroller_mpskprofile":                     {Path: "/api/v2/cmdb/wireless-controller/mpsk-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_nacprofile":                      {Path: "/api/v2/cmdb/wireless-controller/nac-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_qosprofile":                      {Path: "/api/v2/cmdb/wireless-controller/qos-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_region":                          {Path: "/api/v2/cmdb/wireless-controller/region", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_setting":                         {Path: "/api/v2/cmdb/wireless-controller/setting", ID: "WirelessControllerSetting"},
+	"fortios_wirelesscontroller_snmp":                            {Path: "/api/v2/cmdb/wireless-controller/snmp", ID: "WirelessControllerSnmp"},
+	"fortios_wirelesscontroller_ssidpolicy":                      {Path: "/api/v2/cmdb/wireless-controller/ssid-policy", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_syslogprofile":                   {Path: "/api/v2/cmdb/wireless-controller/syslog-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_timers":                          {Path: "/api/v2/cmdb/wireless-controller/timers", ID: "WirelessControllerTimers"},
+	"fortios_wirelesscontroller_utmprofile":                      {Path: "/api/v2/cmdb/wireless-controller/utm-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_vap":                             {Path: "/api/v2/cmdb/wireless-controller/vap", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_vapgroup":                        {Path: "/api/v2/cmdb/wireless-controller/vap-group", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_wagprofile":                      {Path: "/api/v2/cmdb/wireless-controller/wag-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_widsprofile":                     {Path: "/api/v2/cmdb/wireless-controller/wids-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_wtp":                             {Path: "/api/v2/cmdb/wireless-controller/wtp", Mkey: "wtp_id", MkeyType: "string"},
+	"fortios_wirelesscontroller_wtpgroup":                        {Path: "/api/v2/cmdb/wireless-controller/wtp-group", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontroller_wtpprofile":                      {Path: "/api/v2/cmdb/wireless-controller/wtp-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqp3gppcellular":       {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-3gpp-cellular", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqpipaddresstype":      {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-ip-address-type", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqpnairealm":           {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-nai-realm", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype":    {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-network-auth-type", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqproamingconsortium":  {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-roaming-consortium", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqpvenuename":          {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-venue-name", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_anqpvenueurl":           {Path: "/api/v2/cmdb/wireless-controller.hotspot20/anqp-venue-url", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge":     {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-advice-of-charge", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qpconncapability":     {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-conn-capability", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qpoperatorname":       {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-operator-name", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qposuprovider":        {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-osu-provider", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qposuprovidernai":     {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-osu-provider-nai", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qptermsandconditions": {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-terms-and-conditions", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_h2qpwanmetric":          {Path: "/api/v2/cmdb/wireless-controller.hotspot20/h2qp-wan-metric", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_hsprofile":              {Path: "/api/v2/cmdb/wireless-controller.hotspot20/hs-profile", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_icon":                   {Path: "/api/v2/cmdb/wireless-controller.hotspot20/icon", Mkey: "name", MkeyType: "string"},
+	"fortios_wirelesscontrollerhotspot20_qosmap":                 {Path: "/api/v2/cmdb/wireless-controller.hotspot20/qos-map", Mkey: "name", MkeyType: "string"},
+}
+
+// MkeyAPI returns the name of the mkey field in the FortiOS API.
+func (t cmdbTable) MkeyAPI() string {
+	return fortiAPIKey(t.Mkey)
+}
+
+// IsSingle reports whether Path is a single object instead of a table.
+func (t cmdbTable) IsSingle() bool {
+	return t.Mkey == ""
+}
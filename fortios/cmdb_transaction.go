@@ -0,0 +1,64 @@
+package fortios
+
+import (
+	"fmt"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+)
+
+// cmdbTransactionTimeout is the number of seconds after which FortiOS aborts a transaction
+// which is not committed.
+const cmdbTransactionTimeout = 120
+
+// cmdbTransaction sends CMDB requests in a transaction: FortiOS applies them all on commit,
+// or none on abort. Without id, the requests are sent outside a transaction.
+type cmdbTransaction struct {
+	c         *forticlient.FortiSDKClient
+	vdomparam string
+	id        string
+}
+
+// cmdbTransactionStart starts a transaction, supported from FortiOS 6.4.
+func cmdbTransactionStart(c *forticlient.FortiSDKClient, vdomparam string) (*cmdbTransaction, error) {
+	res, err := fortiGenericRequest(c, "POST", "/api/v2/cmdb/", "action=transaction-start", map[string]interface{}{"timeout": cmdbTransactionTimeout}, vdomparam)
+	if err != nil {
+		return nil, err
+	}
+
+	results, _ := res["results"].(map[string]interface{})
+	if results["transaction-id"] == nil {
+		return nil, fmt.Errorf("no transaction-id in the results %v", res["results"])
+	}
+
+	return &cmdbTransaction{c: c, vdomparam: vdomparam, id: importMkeyString(results["transaction-id"])}, nil
+}
+
+func (t *cmdbTransaction) header() map[string]string {
+	if t.id == "" {
+		return nil
+	}
+	return map[string]string{"X-TRANSACTION-ID": t.id}
+}
+
+// request sends the request in the transaction.
+func (t *cmdbTransaction) request(method, path, params string, body interface{}) (map[string]interface{}, error) {
+	return fortiGenericRequestHeader(t.c, method, path, params, body, t.header(), t.vdomparam)
+}
+
+// commit applies the requests of the transaction.
+func (t *cmdbTransaction) commit() error {
+	if t.id == "" {
+		return nil
+	}
+	_, err := t.request("POST", "/api/v2/cmdb/", "action=transaction-commit", nil)
+	return err
+}
+
+// abort discards the requests of the transaction.
+func (t *cmdbTransaction) abort() error {
+	if t.id == "" {
+		return nil
+	}
+	_, err := t.request("POST", "/api/v2/cmdb/", "action=transaction-abort", nil)
+	return err
+}
@@ -43,6 +43,25 @@ func fortiIntValue(t interface{}) int {
 	}
 }
 
+// fortiAPIKey converts an argument name to the key FortiOS uses in its JSON objects.
+func fortiAPIKey(name string) string {
+	if name == "fosid" {
+		return "id"
+	}
+	return strings.ReplaceAll(name, "_", "-")
+}
+
+// fortiAttrSchema returns the schema of the argument for a key of the FortiOS JSON objects.
+// The id key is the fosid argument of a resource, and the id argument of its nested tables.
+func fortiAttrSchema(sch map[string]*schema.Schema, key string) *schema.Schema {
+	if key == "id" {
+		if s, ok := sch["fosid"]; ok {
+			return s
+		}
+	}
+	return sch[strings.ReplaceAll(key, "-", "_")]
+}
+
 func escapeFilter(filter string) string {
 	var rstSb strings.Builder
 	andSlice := strings.Split(filter, "&")
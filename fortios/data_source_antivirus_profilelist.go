@@ -0,0 +1,79 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAntivirusProfileList() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAntivirusProfileListRead,
+
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"namelist": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func dataSourceAntivirusProfileListRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	filter := d.Get("filter").(string)
+	if filter != "" {
+		filter = escapeFilter(filter)
+	}
+
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/antivirus/profile", "name", filter, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing AntivirusProfile: %v", err)
+	}
+
+	var tmps []string
+	if o != nil {
+		if len(o) == 0 || o[0] == nil {
+			return nil
+		}
+
+		for _, r := range o {
+			i := r.(map[string]interface{})
+
+			if _, ok := i["name"]; ok {
+				tmps = append(tmps, fortiStringValue(i["name"]))
+			}
+		}
+	}
+	d.Set("namelist", tmps)
+
+	d.SetId("DataSourceAntivirusProfileList" + filter)
+
+	return nil
+}
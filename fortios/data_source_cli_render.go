@@ -0,0 +1,158 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceCliRender() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCliRenderRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"path"},
+			},
+			"attributes": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"path"},
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mkey": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cli": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// cliRenderRead reads the object at the CMDB API path, the entry mkey of a table if mkey
+// is not "", and returns it as FortiOS returns it.
+func cliRenderRead(c *forticlient.FortiSDKClient, path, mkey, vdomparam string) (map[string]interface{}, error) {
+	p := "/api/v2/cmdb/" + path
+	if mkey != "" {
+		p += "/" + forticlient.EscapeURLString(mkey)
+	}
+
+	res, err := fortiGenericRequest(c, "GET", p, "", nil, vdomparam)
+	if err != nil {
+		if fortiGenericNotFound(res) {
+			return nil, fmt.Errorf("%s was not found", p)
+		}
+		return nil, err
+	}
+
+	switch t := res["results"].(type) {
+	case map[string]interface{}:
+		return t, nil
+	case []interface{}:
+		if mkey != "" && len(t) == 1 {
+			if o, ok := t[0].(map[string]interface{}); ok {
+				return o, nil
+			}
+		}
+		return nil, fmt.Errorf("%s is a table, the mkey of the entry is required", p)
+	}
+
+	return nil, fmt.Errorf("unexpected results of %s", p)
+}
+
+func dataSourceCliRenderRead(d *schema.ResourceData, m interface{}) error {
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	rtype := d.Get("resource_type").(string)
+	attrs := d.Get("attributes").(string)
+	path := strings.TrimPrefix(strings.Trim(d.Get("path").(string), "/"), "api/v2/cmdb/")
+	mkey := d.Get("mkey").(string)
+
+	if rtype != "" {
+		t, ok := cmdbTables[rtype]
+		if !ok {
+			return fmt.Errorf("Error rendering CLI: %s is not a resource of a CMDB table", rtype)
+		}
+		path = strings.TrimPrefix(t.Path, "/api/v2/cmdb/")
+	} else if path == "" {
+		return fmt.Errorf("Error rendering CLI: either resource_type or path is required")
+	}
+
+	r, mkeyAttr := cliConfigResource(path)
+
+	var obj map[string]interface{}
+
+	if attrs != "" {
+		if r == nil {
+			return fmt.Errorf("Error rendering CLI: attributes require resource_type")
+		}
+
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(attrs), &v); err != nil {
+			return fmt.Errorf("Error rendering CLI: attributes should be a JSON object: %v", err)
+		}
+
+		var err error
+		obj, err = cliAPIObject(r.Schema, v, "")
+		if err != nil {
+			return fmt.Errorf("Error rendering CLI: %v", err)
+		}
+
+		if mkeyAttr != "" {
+			if mkey != "" {
+				obj[fortiAPIKey(mkeyAttr)] = cliConfigScalar(r.Schema[mkeyAttr], mkey)
+			} else if obj[fortiAPIKey(mkeyAttr)] == nil {
+				return fmt.Errorf("Error rendering CLI: attributes have no %s and mkey is not set", mkeyAttr)
+			}
+		}
+	} else {
+		c := m.(*FortiClient).Client
+		if c == nil {
+			return fmt.Errorf("FortiOS connection did not initialize successfully!")
+		}
+		c.Retries = 1
+
+		var err error
+		obj, err = cliRenderRead(c, path, mkey, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error rendering CLI: %v", err)
+		}
+
+		if mkeyAttr == "" && mkey != "" {
+			// A table not managed by a resource, keyed by name or id
+			mkeyAttr = "name"
+			if obj["name"] == nil && obj["id"] != nil {
+				mkeyAttr = "fosid"
+			}
+		}
+	}
+
+	d.SetId("DataSourceCliRender" + path + "/" + mkey)
+	d.Set("cli", cliRender(path, r, mkeyAttr, obj))
+
+	return nil
+}
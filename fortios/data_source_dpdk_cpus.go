@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure CPUs enabled to run engines in each DPDK stage.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDpdkCpus() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceDpdkCpusRead,
+		Schema: dataSourceSchema(resourceDpdkCpus().Schema, ""),
+	}
+}
+
+func dataSourceDpdkCpusRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "DpdkCpus"
+
+	o, err := c.ReadDpdkCpus(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing DpdkCpus: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectDpdkCpus(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing DpdkCpus from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
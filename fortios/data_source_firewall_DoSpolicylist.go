@@ -16,7 +16,7 @@ func dataSourceFirewallDosPolicyList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallDosPolicyListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallDosPolicyList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallDosPolicyListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/DoS-policy", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/DoS-policy", "policyid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallDosPolicy: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceFirewallAddress6TemplateList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallAddress6TemplateListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallAddress6TemplateList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallAddress6TemplateListRead(d *schema.ResourceData, m interf
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/address6-template", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/address6-template", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddress6Template: %v", err)
 	}
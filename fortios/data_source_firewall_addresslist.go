@@ -16,7 +16,7 @@ func dataSourceFirewallAddressList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallAddressListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallAddressList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallAddressListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/address", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/address", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddress: %v", err)
 	}
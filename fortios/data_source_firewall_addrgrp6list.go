@@ -16,7 +16,7 @@ func dataSourceFirewallAddrgrp6List() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallAddrgrp6ListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallAddrgrp6List() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallAddrgrp6ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/addrgrp6", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/addrgrp6", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallAddrgrp6: %v", err)
 	}
@@ -0,0 +1,143 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFirewallAddrgrpResolved() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFirewallAddrgrpResolvedRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ranges": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unresolved": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFirewallAddrgrpResolvedRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	name := d.Get("name").(string)
+
+	tables := make(map[string][]interface{})
+	for _, path := range []string{"firewall/address", "firewall/addrgrp"} {
+		o, err := dataSourceListGroupRead(c, "/api/v2/cmdb/"+path, "", 0, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error reading %s: %v", path, err)
+		}
+		tables[path] = o
+	}
+
+	objects := &analysisObjects{
+		addresses: analysisByName(tables["firewall/address"]),
+		addrgrps:  analysisByName(tables["firewall/addrgrp"]),
+	}
+
+	if _, ok := objects.addrgrps[name]; !ok {
+		return fmt.Errorf("Error resolving address group %s: not found", name)
+	}
+
+	var unresolvedAddresses []analysisUnresolved
+	memberNames := make(map[string]bool)
+
+	res := objects.addressResolve(name, make(map[string]bool), &unresolvedAddresses, memberNames)
+
+	var cidrs, ranges []interface{}
+	for _, r := range res {
+		for _, s := range r.ipv4CIDRs() {
+			cidrs = append(cidrs, s)
+		}
+		ranges = append(ranges, ipv4String(r.Lo)+"-"+ipv4String(r.Hi))
+	}
+
+	names := make([]string, 0, len(memberNames))
+	for n := range memberNames {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var members []interface{}
+	for _, n := range names {
+		members = append(members, n)
+	}
+
+	// an address in several groups is reported once
+	var unresolved []interface{}
+	seen := make(map[analysisUnresolved]bool)
+	for _, u := range unresolvedAddresses {
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		unresolved = append(unresolved, map[string]interface{}{"name": u.Name, "type": u.Type})
+	}
+
+	d.SetId("FirewallAddrgrpResolved" + name)
+
+	for k, v := range map[string][]interface{}{
+		"cidrs":      cidrs,
+		"ranges":     ranges,
+		"members":    members,
+		"unresolved": unresolved,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error reading %s: %v", k, err)
+		}
+	}
+
+	return nil
+}
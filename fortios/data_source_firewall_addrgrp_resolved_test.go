@@ -0,0 +1,61 @@
+package fortios
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestDataSourceFirewallAddrgrpResolvedRead(t *testing.T) {
+	m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		switch path {
+		case "/api/v2/cmdb/firewall/address":
+			return http.StatusOK, []interface{}{
+				map[string]interface{}{"name": "net1", "type": "ipmask", "subnet": "10.0.0.0 255.255.255.0"},
+				map[string]interface{}{"name": "net2", "type": "ipmask", "subnet": "10.0.1.0 255.255.255.0"},
+				map[string]interface{}{"name": "host", "type": "ipmask", "subnet": "10.0.0.7 255.255.255.255"},
+				map[string]interface{}{"name": "range", "type": "iprange", "start-ip": "10.0.3.1", "end-ip": "10.0.3.6"},
+				map[string]interface{}{"name": "web", "type": "fqdn", "fqdn": "example.com"},
+			}
+		case "/api/v2/cmdb/firewall/addrgrp":
+			return http.StatusOK, []interface{}{
+				map[string]interface{}{"name": "inner", "member": testAnalysisList("net2", "web")},
+				map[string]interface{}{
+					"name":           "outer",
+					"member":         testAnalysisList("net1", "inner", "range", "web"),
+					"exclude":        "enable",
+					"exclude-member": testAnalysisList("host"),
+				},
+			}
+		}
+		return http.StatusNotFound, nil
+	})
+
+	r := dataSourceFirewallAddrgrpResolved()
+	d := r.TestResourceData()
+	d.Set("name", "outer")
+	if err := dataSourceFirewallAddrgrpResolvedRead(d, m); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	want := map[string]interface{}{
+		"ranges":  []interface{}{"10.0.0.0-10.0.0.6", "10.0.0.8-10.0.1.255", "10.0.3.1-10.0.3.6"},
+		"cidrs":   []interface{}{"10.0.0.0/30", "10.0.0.4/31", "10.0.0.6/32", "10.0.0.8/29", "10.0.0.16/28", "10.0.0.32/27", "10.0.0.64/26", "10.0.0.128/25", "10.0.1.0/24", "10.0.3.1/32", "10.0.3.2/31", "10.0.3.4/31", "10.0.3.6/32"},
+		"members": []interface{}{"net1", "net2", "range"},
+		"unresolved": []interface{}{
+			map[string]interface{}{"name": "web", "type": "fqdn"},
+		},
+	}
+	for k, v := range want {
+		if got := d.Get(k); !reflect.DeepEqual(got, v) {
+			t.Errorf("%s: got %v, want %v", k, got, v)
+		}
+	}
+
+	d = r.TestResourceData()
+	d.Set("name", "net1")
+	if err := dataSourceFirewallAddrgrpResolvedRead(d, m); err == nil {
+		t.Errorf("address net1: no error")
+	}
+}
@@ -16,7 +16,7 @@ func dataSourceFirewallCentralSnatMapList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallCentralSnatMapListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallCentralSnatMapList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallCentralSnatMapListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/central-snat-map", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/central-snat-map", "policyid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallCentralSnatMap: %v", err)
 	}
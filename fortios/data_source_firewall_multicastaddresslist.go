@@ -16,7 +16,7 @@ func dataSourceFirewallMulticastAddressList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallMulticastAddressListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallMulticastAddressList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallMulticastAddressListRead(d *schema.ResourceData, m interf
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/multicast-address", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/multicast-address", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallMulticastAddress: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceFirewallPolicy46List() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallPolicy46ListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallPolicy46List() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallPolicy46ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy46", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/policy46", "policyid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy46: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceFirewallPolicy64List() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallPolicy64ListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallPolicy64List() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallPolicy64ListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/policy64", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/policy64", "policyid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallPolicy64: %v", err)
 	}
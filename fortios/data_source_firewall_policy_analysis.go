@@ -0,0 +1,132 @@
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFirewallPolicyAnalysis() *schema.Resource {
+	finding := func(related string) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policyid": &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					related: &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceFirewallPolicyAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"shadowed":  finding("by_policyid"),
+			"redundant": finding("by_policyid"),
+			"conflicts": finding("with_policyid"),
+			"unresolved": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policyid": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFirewallPolicyAnalysisRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	tables := make(map[string][]interface{})
+	for _, path := range []string{"firewall/policy", "firewall/address", "firewall/addrgrp", "firewall.service/custom", "firewall.service/group"} {
+		o, err := dataSourceListGroupRead(c, "/api/v2/cmdb/"+path, "", 0, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error reading %s: %v", path, err)
+		}
+		tables[path] = o
+	}
+
+	objects := &analysisObjects{
+		addresses: analysisByName(tables["firewall/address"]),
+		addrgrps:  analysisByName(tables["firewall/addrgrp"]),
+		services:  analysisByName(tables["firewall.service/custom"]),
+		svcgrps:   analysisByName(tables["firewall.service/group"]),
+	}
+
+	var policies []analysisPolicy
+	for _, e := range tables["firewall/policy"] {
+		p, ok := e.(map[string]interface{})
+		if !ok || cmdbObjectString(p["status"]) == "disable" {
+			continue
+		}
+		policies = append(policies, objects.policy(p))
+	}
+
+	res := analysisRun(policies)
+
+	findings := func(l []analysisFinding, related string) []interface{} {
+		r := make([]interface{}, 0, len(l))
+		for _, f := range l {
+			r = append(r, map[string]interface{}{"policyid": f.ID, related: f.Related})
+		}
+		return r
+	}
+
+	var unresolved []interface{}
+	for _, p := range policies {
+		if reason, ok := res.Unresolved[p.ID]; ok {
+			unresolved = append(unresolved, map[string]interface{}{"policyid": p.ID, "reason": reason})
+		}
+	}
+
+	d.SetId("FirewallPolicyAnalysis" + vdomparam)
+
+	for k, v := range map[string][]interface{}{
+		"shadowed":   findings(res.Shadowed, "by_policyid"),
+		"redundant":  findings(res.Redundant, "by_policyid"),
+		"conflicts":  findings(res.Conflicts, "with_policyid"),
+		"unresolved": unresolved,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error reading %s: %v", k, err)
+		}
+	}
+
+	return nil
+}
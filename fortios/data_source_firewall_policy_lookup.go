@@ -0,0 +1,214 @@
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// policyLookupProtocols maps the protocol names accepted by the lookup to their numbers.
+var policyLookupProtocols = map[string]string{
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmp6":  "58",
+	"icmpv6": "58",
+	"sctp":   "132",
+}
+
+func dataSourceFirewallPolicyLookup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFirewallPolicyLookupRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"srcintf": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sourceip": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dest": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sourceport": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"destport": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"icmptype": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"icmpcode": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"ipv6": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"matched": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policyid": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"policy_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nat": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ippool": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"poolname": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// policyLookupParams returns the query parameters of the lookup of the flow.
+func policyLookupParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	protocol := strings.ToLower(d.Get("protocol").(string))
+	if n, ok := policyLookupProtocols[protocol]; ok {
+		protocol = n
+	} else if n, err := strconv.Atoi(protocol); err != nil || n < 0 || n > 255 {
+		return nil, fmt.Errorf("unsupported protocol %s, expected tcp, udp, sctp, icmp, icmp6 or a protocol number", protocol)
+	}
+
+	params := map[string]interface{}{
+		"srcintf":  d.Get("srcintf").(string),
+		"sourceip": d.Get("sourceip").(string),
+		"dest":     d.Get("dest").(string),
+		"protocol": protocol,
+	}
+
+	for _, k := range []string{"sourceport", "destport", "icmptype", "icmpcode"} {
+		if v, ok := d.GetOkExists(k); ok {
+			params[k] = v
+		}
+	}
+	if d.Get("ipv6").(bool) {
+		params["ipv6"] = "true"
+	}
+
+	return params, nil
+}
+
+func dataSourceFirewallPolicyLookupRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	params, err := policyLookupParams(d)
+	if err != nil {
+		return fmt.Errorf("Error looking up policy: %v", err)
+	}
+
+	res, err := fortiGenericRequest(c, "GET", "/api/v2/monitor/firewall/policy-lookup", monitorParams(params, ""), nil, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error looking up policy: %v", err)
+	}
+
+	results, _ := res["results"].(map[string]interface{})
+	if results == nil {
+		return fmt.Errorf("Error looking up policy: unexpected results %v", res["results"])
+	}
+	if results["success"] == false {
+		return fmt.Errorf("Error looking up policy: %s", cmdbObjectString(results["error_code"]))
+	}
+
+	flattened := make(map[string]interface{})
+	monitorFlatten("", results, flattened)
+
+	policyid := fortiIntValue(results["policy_id"])
+	name, action, nat, ippool := "", "", "", ""
+	var poolname []interface{}
+
+	if policyid > 0 {
+		path := "firewall/policy"
+		if d.Get("ipv6").(bool) && cmdbObjectString(results["matched_policy_type"]) == "policy6" {
+			path = "firewall/policy6"
+		}
+
+		o, err := cmdbObjectRead(c, path, strconv.Itoa(policyid), vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error reading policy %d: %v", policyid, err)
+		}
+		if o != nil {
+			name = cmdbObjectString(o["name"])
+			action = cmdbObjectString(o["action"])
+			nat = cmdbObjectString(o["nat"])
+			ippool = cmdbObjectString(o["ippool"])
+			for _, n := range analysisNames(o["poolname"]) {
+				poolname = append(poolname, n)
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("FirewallPolicyLookup%s:%s>%s", d.Get("srcintf").(string), params["sourceip"], params["dest"]))
+	d.Set("matched", policyid > 0)
+	d.Set("policyid", policyid)
+	d.Set("policy_name", name)
+	d.Set("action", action)
+	d.Set("nat", nat)
+	d.Set("ippool", ippool)
+	if err := d.Set("poolname", poolname); err != nil {
+		return fmt.Errorf("Error reading poolname: %v", err)
+	}
+	if err := d.Set("results", flattened); err != nil {
+		return fmt.Errorf("Error reading results: %v", err)
+	}
+
+	return nil
+}
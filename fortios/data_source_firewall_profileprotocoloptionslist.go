@@ -16,7 +16,7 @@ func dataSourceFirewallProfileProtocolOptionsList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallProfileProtocolOptionsListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallProfileProtocolOptionsList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallProfileProtocolOptionsListRead(d *schema.ResourceData, m
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall/profile-protocol-options", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall/profile-protocol-options", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallProfileProtocolOptions: %v", err)
 	}
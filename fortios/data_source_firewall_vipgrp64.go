@@ -0,0 +1,66 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure IPv6 to IPv4 virtual IP groups.
+
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFirewallVipgrp64() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceFirewallVipgrp64Read,
+		Schema: dataSourceSchema(resourceFirewallVipgrp64().Schema, "name"),
+	}
+}
+
+func dataSourceFirewallVipgrp64Read(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := ""
+
+	t := d.Get("name")
+	if v, ok := t.(string); ok {
+		mkey = v
+	} else if v, ok := t.(int); ok {
+		mkey = strconv.Itoa(v)
+	} else {
+		return fmt.Errorf("Error describing FirewallVipgrp64: type error")
+	}
+
+	o, err := c.ReadFirewallVipgrp64(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing FirewallVipgrp64: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectFirewallVipgrp64(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing FirewallVipgrp64 from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -16,7 +16,7 @@ func dataSourceFirewallConsolidatedPolicyList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallConsolidatedPolicyListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallConsolidatedPolicyList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallConsolidatedPolicyListRead(d *schema.ResourceData, m inte
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.consolidated/policy", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.consolidated/policy", "policyid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallConsolidatedPolicy: %v", err)
 	}
@@ -0,0 +1,148 @@
+package fortios
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFirewallScheduleExpired() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFirewallScheduleExpiredRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"timezone": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateScheduleTimezone,
+				Required:     true,
+			},
+			"include_unreferenced": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"schedules": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"references": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mkey": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"attribute": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFirewallScheduleExpiredRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	tz := d.Get("timezone").(string)
+	all := d.Get("include_unreferenced").(bool)
+
+	l, err := dataSourceListGroupRead(c, "/api/v2/cmdb/firewall.schedule/onetime", "", 0, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading one-time schedules: %v", err)
+	}
+
+	now := time.Now()
+
+	var schedules []interface{}
+	for _, e := range l {
+		o, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := cmdbObjectString(o["name"])
+		start, end := cmdbObjectString(o["start"]), cmdbObjectString(o["end"])
+
+		expired, _, err := scheduleStatus(start, end, tz, now)
+		if err != nil {
+			return fmt.Errorf("Error reading schedule %s: %v", name, err)
+		}
+		if !expired {
+			continue
+		}
+
+		refs, err := objectUsage(c, "firewall.schedule/onetime", name, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error reading the references to schedule %s: %v", name, err)
+		}
+		if len(refs) == 0 && !all {
+			continue
+		}
+
+		references := make([]interface{}, 0, len(refs))
+		for _, r := range refs {
+			references = append(references, map[string]interface{}{
+				"path":      r.Path,
+				"mkey":      r.Mkey,
+				"attribute": r.Attribute,
+			})
+		}
+
+		schedules = append(schedules, map[string]interface{}{
+			"name":       name,
+			"start":      start,
+			"end":        end,
+			"references": references,
+		})
+	}
+
+	d.SetId("FirewallScheduleExpired" + vdomparam)
+
+	if err := d.Set("schedules", schedules); err != nil {
+		return fmt.Errorf("Error reading schedules: %v", err)
+	}
+
+	return nil
+}
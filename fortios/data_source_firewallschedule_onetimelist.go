@@ -16,7 +16,7 @@ func dataSourceFirewallScheduleOnetimeList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallScheduleOnetimeListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallScheduleOnetimeList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallScheduleOnetimeListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.schedule/onetime", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.schedule/onetime", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallScheduleOnetime: %v", err)
 	}
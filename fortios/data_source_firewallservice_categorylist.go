@@ -16,7 +16,7 @@ func dataSourceFirewallServiceCategoryList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallServiceCategoryListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallServiceCategoryList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallServiceCategoryListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.service/category", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.service/category", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallServiceCategory: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceFirewallServiceCustomList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallServiceCustomListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallServiceCustomList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallServiceCustomListRead(d *schema.ResourceData, m interface
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.service/custom", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.service/custom", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallServiceCustom: %v", err)
 	}
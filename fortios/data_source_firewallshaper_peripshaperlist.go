@@ -16,7 +16,7 @@ func dataSourceFirewallShaperPerIpShaperList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallShaperPerIpShaperListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallShaperPerIpShaperList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallShaperPerIpShaperListRead(d *schema.ResourceData, m inter
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.shaper/per-ip-shaper", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.shaper/per-ip-shaper", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallShaperPerIpShaper: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceFirewallWildcardFqdnCustomList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceFirewallWildcardFqdnCustomListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceFirewallWildcardFqdnCustomList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceFirewallWildcardFqdnCustomListRead(d *schema.ResourceData, m inte
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/firewall.wildcard-fqdn/custom", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/firewall.wildcard-fqdn/custom", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing FirewallWildcardFqdnCustom: %v", err)
 	}
@@ -33,15 +33,75 @@ func dataSourceIPMaskCIDR() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			"range": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"range_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"wildcard": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"wildcard_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"aggregate": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"aggregated": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"overlap_check": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"overlaps": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"second": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func convIpmask2CIDR(v string) string {
+	if strings.Contains(v, "/") {
+		return strings.TrimSpace(v)
+	}
+
 	line := strings.Split(v, " ")
 	if len(line) >= 2 {
 		ip := line[0]
 		mask := line[1]
+		if strings.Contains(ip, ":") {
+			prefixSize, err := ipMathPrefix(mask, 128)
+			if err != nil {
+				return ""
+			}
+			return ip + "/" + strconv.Itoa(prefixSize)
+		}
 		prefixSize, _ := net.IPMask(net.ParseIP(mask).To4()).Size()
 
 		return ip + "/" + strconv.Itoa(prefixSize)
@@ -50,10 +110,21 @@ func convIpmask2CIDR(v string) string {
 	return ""
 }
 
-func dataSourceIPMaskCIDRRead(d *schema.ResourceData, m interface{}) error {
-	c := m.(*FortiClient).Client
-	c.Retries = 1
+// ipMaskCIDRBlocks parses the subnets, ranges or addresses of the list l.
+func ipMaskCIDRBlocks(l []interface{}) ([]ipBlock, error) {
+	res := make([]ipBlock, 0, len(l))
+	for _, e := range l {
+		s, _ := e.(string)
+		b, err := ipMathParse(s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, b)
+	}
+	return res, nil
+}
 
+func dataSourceIPMaskCIDRRead(d *schema.ResourceData, m interface{}) error {
 	t := d.Get("ipmask").(string)
 
 	tid := ""
@@ -78,6 +149,66 @@ func dataSourceIPMaskCIDRRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if t := d.Get("range").(string); t != "" {
+		b, err := ipMathParse(t)
+		if err != nil {
+			return fmt.Errorf("Error converting range: %v", err)
+		}
+		d.Set("range_cidrs", b.cidrs())
+
+		tid += t
+	}
+
+	if t := d.Get("wildcard").(string); t != "" {
+		l, err := ipMathWildcard(t)
+		if err != nil {
+			return fmt.Errorf("Error converting wildcard: %v", err)
+		}
+		d.Set("wildcard_cidrs", l)
+
+		tid += t
+	}
+
+	if l := d.Get("aggregate").([]interface{}); len(l) != 0 {
+		blocks, err := ipMaskCIDRBlocks(l)
+		if err != nil {
+			return fmt.Errorf("Error aggregating: %v", err)
+		}
+
+		result := make([]string, 0, len(l))
+		for _, b := range ipMathAggregate(blocks) {
+			result = append(result, b.cidrs()...)
+		}
+		d.Set("aggregated", result)
+
+		for _, r := range l {
+			tid += r.(string)
+		}
+	}
+
+	if l := d.Get("overlap_check").([]interface{}); len(l) != 0 {
+		blocks, err := ipMaskCIDRBlocks(l)
+		if err != nil {
+			return fmt.Errorf("Error checking overlaps: %v", err)
+		}
+
+		var result []interface{}
+		for i := range blocks {
+			for j := i + 1; j < len(blocks); j++ {
+				if blocks[i].overlaps(blocks[j]) {
+					result = append(result, map[string]interface{}{"first": l[i], "second": l[j]})
+				}
+			}
+		}
+		if err := d.Set("overlaps", result); err != nil {
+			return fmt.Errorf("Error reading overlaps: %v", err)
+		}
+
+		for _, r := range l {
+			tid += r.(string)
+		}
+	}
+
 	if tid != "" {
 		h := md5.New()
 		io.WriteString(h, tid)
@@ -0,0 +1,334 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// dataSourceListPageSize is the number of entries read per request by default.
+const dataSourceListPageSize = 1000
+
+// dataSourceListSchema adds the arguments and attributes shared by the list data sources,
+// besides filter and namelist, to sch.
+func dataSourceListSchema(sch map[string]*schema.Schema) map[string]*schema.Schema {
+	sch["fields"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		ConflictsWith: []string{"with_objects"},
+	}
+	sch["with_objects"] = &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"fields"},
+	}
+	sch["filter_by"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"operator": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "eq",
+					ValidateFunc: validation.StringInSlice(dataSourceListOperators, false),
+				},
+				"values": &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+	sch["page_size"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  dataSourceListPageSize,
+	}
+	sch["objects"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return sch
+}
+
+// dataSourceListFilterOperators maps the operators of filter_by to those of FortiOS.
+// FortiOS has no prefix operator, the entries containing the value are read and those
+// which do not start with it are left out by dataSourceListPrefix.
+var dataSourceListFilterOperators = map[string]string{
+	"eq":           "==",
+	"neq":          "!=",
+	"contains":     "=@",
+	"not_contains": "!@",
+	"lt":           "<",
+	"le":           "<=",
+	"gt":           ">",
+	"ge":           ">=",
+	"prefix":       "=@",
+}
+
+var dataSourceListOperators = []string{"eq", "neq", "contains", "not_contains", "lt", "le", "gt", "ge", "prefix"}
+
+// dataSourceListPrefix is a filter_by block with the prefix operator.
+type dataSourceListPrefix struct {
+	key    string
+	values []string
+}
+
+// match reports whether the value of key in the entry starts with one of the values.
+func (p dataSourceListPrefix) match(e interface{}) bool {
+	m, ok := e.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	v := cmdbObjectString(m[p.key])
+	for _, s := range p.values {
+		if strings.HasPrefix(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataSourceListFilterValue escapes the characters FortiOS gives a meaning in a filter.
+func dataSourceListFilterValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	return strings.ReplaceAll(v, ",", `\,`)
+}
+
+// dataSourceListFilter converts the filter_by blocks to FortiOS filter parameters, one per
+// block so that blocks are combined with AND while the values of a block are combined
+// with OR. It also returns the blocks with the prefix operator.
+func dataSourceListFilter(d *schema.ResourceData) (string, []dataSourceListPrefix) {
+	var params []string
+	var prefixes []dataSourceListPrefix
+
+	for _, b := range d.Get("filter_by").([]interface{}) {
+		f, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := fortiAPIKey(f["name"].(string))
+		op := f["operator"].(string)
+
+		var terms, values []string
+		for _, v := range f["values"].([]interface{}) {
+			s := fmt.Sprintf("%v", v)
+			values = append(values, s)
+			terms = append(terms, forticlient.EscapeURLString(key+dataSourceListFilterOperators[op]+dataSourceListFilterValue(s)))
+		}
+
+		params = append(params, "filter="+strings.Join(terms, ","))
+		if op == "prefix" {
+			prefixes = append(prefixes, dataSourceListPrefix{key: key, values: values})
+		}
+	}
+
+	return strings.Join(params, "&"), prefixes
+}
+
+// dataSourceListFormat returns the format parameter selecting the fields read: the mkey
+// and the keys of prefixes only unless fields are set, and all of them if with_objects
+// is set.
+func dataSourceListFormat(d *schema.ResourceData, mkey string, prefixes []dataSourceListPrefix) string {
+	if d.Get("with_objects").(bool) {
+		return ""
+	}
+
+	keys := []string{mkey}
+	seen := map[string]bool{mkey: true}
+	for _, f := range d.Get("fields").([]interface{}) {
+		if k := fortiAPIKey(fmt.Sprintf("%v", f)); !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+	for _, p := range prefixes {
+		if !seen[p.key] {
+			keys = append(keys, p.key)
+			seen[p.key] = true
+		}
+	}
+
+	return "format=" + strings.Join(keys, "|")
+}
+
+// dataSourceListGroupRead reads all the entries of the table at path matching filter, in
+// pages of pageSize entries so that large tables do not time out. It returns nil if the
+// table does not exist, as GenericGroupRead does.
+func dataSourceListGroupRead(c *forticlient.FortiSDKClient, path, params string, pageSize int, vdomparam string) ([]interface{}, error) {
+	if pageSize <= 0 {
+		pageSize = dataSourceListPageSize
+	}
+
+	var entries []interface{}
+
+	for start := 0; ; {
+		p := "start=" + strconv.Itoa(start) + "&count=" + strconv.Itoa(pageSize)
+		if params != "" {
+			p = params + "&" + p
+		}
+
+		res, err := fortiGenericRequest(c, "GET", path, p, nil, vdomparam)
+		if err != nil {
+			if fortiGenericNotFound(res) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		l, ok := res["results"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected results of %s", path)
+		}
+		entries = append(entries, l...)
+
+		// FortiOS filters the entries of a page, so a page can be short before the end of
+		// the table. next_idx and size give the position in the table when returned.
+		next := start + pageSize
+		if v, ok := res["next_idx"].(float64); ok {
+			next = int(v) + 1
+		}
+		if v, ok := res["size"].(float64); ok {
+			if next >= int(v) {
+				break
+			}
+		} else if len(l) != pageSize {
+			break
+		}
+		if next <= start {
+			break
+		}
+		start = next
+	}
+
+	if entries == nil {
+		entries = []interface{}{}
+	}
+
+	return entries, nil
+}
+
+// dataSourceListObject converts an entry as FortiOS returns it to the arguments of the
+// resource of the table: FortiOS keys to argument names, and objects nested in a list as
+// for the arguments limited to one item.
+func dataSourceListObject(sch map[string]*schema.Schema, obj map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(obj))
+
+	for k, v := range obj {
+		s := fortiAttrSchema(sch, k)
+		if s == nil {
+			continue
+		}
+
+		name := strings.ReplaceAll(k, "-", "_")
+		if k == "id" && sch["fosid"] != nil {
+			name = "fosid"
+		}
+
+		elem := cliConfigElem(s)
+		if elem == nil {
+			res[name] = v
+			continue
+		}
+
+		switch t := v.(type) {
+		case map[string]interface{}:
+			res[name] = []interface{}{dataSourceListObject(elem.Schema, t)}
+		case []interface{}:
+			l := make([]interface{}, 0, len(t))
+			for _, e := range t {
+				if m, ok := e.(map[string]interface{}); ok {
+					l = append(l, dataSourceListObject(elem.Schema, m))
+				}
+			}
+			res[name] = l
+		default:
+			res[name] = v
+		}
+	}
+
+	return res
+}
+
+// dataSourceListRead reads the entries of the list data source of the table at path,
+// keyed by mkey, matching both filter and the filter_by blocks, and sets objects when
+// fields or with_objects is set.
+func dataSourceListRead(d *schema.ResourceData, c *forticlient.FortiSDKClient, path, mkey, filter, vdomparam string) ([]interface{}, error) {
+	filterBy, prefixes := dataSourceListFilter(d)
+
+	params := dataSourceListFormat(d, mkey, prefixes)
+	for _, f := range []string{filter, filterBy} {
+		if f == "" {
+			continue
+		}
+		if params != "" {
+			params += "&"
+		}
+		params += f
+	}
+
+	o, err := dataSourceListGroupRead(c, path, params, d.Get("page_size").(int), vdomparam)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prefixes) > 0 && o != nil {
+		l := make([]interface{}, 0, len(o))
+		for _, e := range o {
+			match := true
+			for _, p := range prefixes {
+				if !p.match(e) {
+					match = false
+					break
+				}
+			}
+			if match {
+				l = append(l, e)
+			}
+		}
+		o = l
+	}
+
+	objects := ""
+	if d.Get("with_objects").(bool) || len(d.Get("fields").([]interface{})) > 0 {
+		r, _ := cliConfigResource(strings.TrimPrefix(path, "/api/v2/cmdb/"))
+
+		l := make([]interface{}, 0, len(o))
+		for _, e := range o {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if r != nil {
+				m = dataSourceListObject(r.Schema, m)
+			}
+			l = append(l, m)
+		}
+
+		b, err := json.Marshal(l)
+		if err != nil {
+			return nil, err
+		}
+		objects = string(b)
+	}
+	d.Set("objects", objects)
+
+	return o, nil
+}
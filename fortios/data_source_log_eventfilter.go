@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure log event filters.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogEventfilter() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogEventfilterRead,
+		Schema: dataSourceSchema(resourceLogEventfilter().Schema, ""),
+	}
+}
+
+func dataSourceLogEventfilterRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogEventfilter"
+
+	o, err := c.ReadLogEventfilter(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogEventfilter: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogEventfilter(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogEventfilter from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure filters for local disk logging. Use these filters to determine the log messages to record according to severity and type.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogDiskFilter() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogDiskFilterRead,
+		Schema: dataSourceSchema(resourceLogDiskFilter().Schema, ""),
+	}
+}
+
+func dataSourceLogDiskFilterRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogDiskFilter"
+
+	o, err := c.ReadLogDiskFilter(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogDiskFilter: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogDiskFilter(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogDiskFilter from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
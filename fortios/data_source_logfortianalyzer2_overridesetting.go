@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Override FortiAnalyzer settings.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogFortianalyzer2OverrideSetting() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogFortianalyzer2OverrideSettingRead,
+		Schema: dataSourceSchema(resourceLogFortianalyzer2OverrideSetting().Schema, ""),
+	}
+}
+
+func dataSourceLogFortianalyzer2OverrideSettingRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogFortianalyzer2OverrideSetting"
+
+	o, err := c.ReadLogFortianalyzer2OverrideSetting(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzer2OverrideSetting: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogFortianalyzer2OverrideSetting(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzer2OverrideSetting from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
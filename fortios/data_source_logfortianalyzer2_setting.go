@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Global FortiAnalyzer settings.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogFortianalyzer2Setting() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogFortianalyzer2SettingRead,
+		Schema: dataSourceSchema(resourceLogFortianalyzer2Setting().Schema, ""),
+	}
+}
+
+func dataSourceLogFortianalyzer2SettingRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogFortianalyzer2Setting"
+
+	o, err := c.ReadLogFortianalyzer2Setting(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzer2Setting: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogFortianalyzer2Setting(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzer2Setting from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Override filters for FortiAnalyzer Cloud.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogFortianalyzerCloudOverrideFilter() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogFortianalyzerCloudOverrideFilterRead,
+		Schema: dataSourceSchema(resourceLogFortianalyzerCloudOverrideFilter().Schema, ""),
+	}
+}
+
+func dataSourceLogFortianalyzerCloudOverrideFilterRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogFortianalyzerCloudOverrideFilter"
+
+	o, err := c.ReadLogFortianalyzerCloudOverrideFilter(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzerCloudOverrideFilter: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogFortianalyzerCloudOverrideFilter(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogFortianalyzerCloudOverrideFilter from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
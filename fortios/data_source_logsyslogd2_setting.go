@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Global settings for remote syslog server.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogSyslogd2Setting() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogSyslogd2SettingRead,
+		Schema: dataSourceSchema(resourceLogSyslogd2Setting().Schema, ""),
+	}
+}
+
+func dataSourceLogSyslogd2SettingRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogSyslogd2Setting"
+
+	o, err := c.ReadLogSyslogd2Setting(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogSyslogd2Setting: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogSyslogd2Setting(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogSyslogd2Setting from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Settings for TACACS+ accounting events filter.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLogTacacsAccounting3Filter() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceLogTacacsAccounting3FilterRead,
+		Schema: dataSourceSchema(resourceLogTacacsAccounting3Filter().Schema, ""),
+	}
+}
+
+func dataSourceLogTacacsAccounting3FilterRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "LogTacacsAccounting3Filter"
+
+	o, err := c.ReadLogTacacsAccounting3Filter(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing LogTacacsAccounting3Filter: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectLogTacacsAccounting3Filter(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing LogTacacsAccounting3Filter from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
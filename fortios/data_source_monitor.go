@@ -0,0 +1,137 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMonitor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMonitorRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("?&"),
+			},
+			"params": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"vdom", "global"}, false),
+			},
+			"results": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flattened": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// monitorParams returns the query parameters of the request, sorted by name.
+func monitorParams(params map[string]interface{}, scope string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	l := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		l = append(l, forticlient.EscapeURLString(k)+"="+forticlient.EscapeURLString(fmt.Sprintf("%v", params[k])))
+	}
+	if scope != "" {
+		l = append(l, "scope="+scope)
+	}
+
+	return strings.Join(l, "&")
+}
+
+// monitorFlatten adds the values of v to res, keyed by their path of object keys and list
+// indexes joined with ".", such as "0.name".
+func monitorFlatten(prefix string, v interface{}, res map[string]interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			monitorFlatten(monitorFlattenKey(prefix, k), e, res)
+		}
+	case []interface{}:
+		for i, e := range t {
+			monitorFlatten(monitorFlattenKey(prefix, strconv.Itoa(i)), e, res)
+		}
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		res[prefix] = cmdbObjectString(t)
+	}
+}
+
+func monitorFlattenKey(prefix, k string) string {
+	if prefix == "" {
+		return k
+	}
+	return prefix + "." + k
+}
+
+func dataSourceMonitorRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path := strings.TrimPrefix(strings.Trim(d.Get("path").(string), "/"), "api/v2/monitor/")
+	params := monitorParams(d.Get("params").(map[string]interface{}), d.Get("scope").(string))
+
+	res, err := fortiGenericRequest(c, "GET", "/api/v2/monitor/"+path, params, nil, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading monitor %s: %v", path, err)
+	}
+
+	b, err := json.Marshal(res["results"])
+	if err != nil {
+		return fmt.Errorf("Error reading monitor %s: %v", path, err)
+	}
+
+	flattened := make(map[string]interface{})
+	monitorFlatten("", res["results"], flattened)
+
+	d.SetId("DataSourceMonitor" + path)
+	d.Set("results", string(b))
+	if err := d.Set("flattened", flattened); err != nil {
+		return fmt.Errorf("Error reading flattened: %v", err)
+	}
+
+	return nil
+}
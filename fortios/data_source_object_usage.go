@@ -0,0 +1,95 @@
+package fortios
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceObjectUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mkey": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"in_use": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"references": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mkey": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attribute": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObjectUsageRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path := strings.Trim(strings.TrimPrefix(d.Get("path").(string), "/api/v2/cmdb/"), "/")
+	mkey := d.Get("mkey").(string)
+
+	refs, err := objectUsage(c, path, mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading usage of %s %s: %v", path, mkey, err)
+	}
+
+	l := make([]interface{}, 0, len(refs))
+	for _, r := range refs {
+		l = append(l, map[string]interface{}{
+			"path":      r.Path,
+			"mkey":      r.Mkey,
+			"attribute": r.Attribute,
+		})
+	}
+
+	d.SetId("ObjectUsage" + path + "/" + mkey)
+	d.Set("in_use", len(refs) > 0)
+	if err := d.Set("references", l); err != nil {
+		return fmt.Errorf("Error reading references: %v", err)
+	}
+
+	return nil
+}
@@ -16,7 +16,7 @@ func dataSourceRouterAccessList6List() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterAccessList6ListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterAccessList6List() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterAccessList6ListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/access-list6", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/access-list6", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAccessList6: %v", err)
 	}
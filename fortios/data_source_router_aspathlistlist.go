@@ -16,7 +16,7 @@ func dataSourceRouterAspathListList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterAspathListListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterAspathListList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterAspathListListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/aspath-list", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/aspath-list", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAspathList: %v", err)
 	}
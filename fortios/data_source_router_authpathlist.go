@@ -16,7 +16,7 @@ func dataSourceRouterAuthPathList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterAuthPathListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterAuthPathList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterAuthPathListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/auth-path", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/auth-path", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterAuthPath: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceRouterMulticastFlowList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterMulticastFlowListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterMulticastFlowList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterMulticastFlowListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/multicast-flow", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/multicast-flow", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterMulticastFlow: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceRouterPolicy6List() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterPolicy6ListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterPolicy6List() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterPolicy6ListRead(d *schema.ResourceData, m interface{}) erro
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/policy6", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/policy6", "seq-num", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterPolicy6: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceRouterPolicyList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterPolicyListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterPolicyList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterPolicyListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/policy", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/policy", "seq-num", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterPolicy: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceRouterPrefixListList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterPrefixListListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterPrefixListList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterPrefixListListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/prefix-list", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/prefix-list", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterPrefixList: %v", err)
 	}
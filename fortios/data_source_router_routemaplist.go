@@ -16,7 +16,7 @@ func dataSourceRouterRouteMapList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterRouteMapListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterRouteMapList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterRouteMapListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/route-map", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/route-map", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterRouteMap: %v", err)
 	}
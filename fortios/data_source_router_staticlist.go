@@ -16,7 +16,7 @@ func dataSourceRouterStaticList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterStaticListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterStaticList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterStaticListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/static", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/static", "seq-num", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterStatic: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceRouterbgpNeighborList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceRouterbgpNeighborListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceRouterbgpNeighborList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceRouterbgpNeighborListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/router/bgp/neighbor", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/router/bgp/neighbor", "ip", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing RouterbgpNeighbor: %v", err)
 	}
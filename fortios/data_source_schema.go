@@ -0,0 +1,55 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceSchema converts the schema of a resource to the schema of the data source
+// reading its objects, so that the data source can be refreshed by the flatten functions
+// of the resource. The argument mkey is required, "" for a single object, and the others
+// are computed.
+func dataSourceSchema(sch map[string]*schema.Schema, mkey string) map[string]*schema.Schema {
+	res := dataSourceComputedSchema(sch)
+
+	res["vdomparam"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	}
+
+	if s, ok := res[mkey]; ok {
+		s.Computed = false
+		s.Required = true
+	}
+
+	return res
+}
+
+func dataSourceComputedSchema(sch map[string]*schema.Schema) map[string]*schema.Schema {
+	res := make(map[string]*schema.Schema, len(sch))
+
+	for k, s := range sch {
+		if k == "vdomparam" || k == "dynamic_sort_subtable" {
+			continue
+		}
+
+		c := &schema.Schema{
+			Type:      s.Type,
+			Computed:  true,
+			Sensitive: s.Sensitive,
+		}
+
+		switch e := s.Elem.(type) {
+		case *schema.Resource:
+			c.Elem = &schema.Resource{
+				Schema: dataSourceComputedSchema(e.Schema),
+			}
+		case *schema.Schema:
+			c.Elem = &schema.Schema{Type: e.Type}
+		}
+
+		res[k] = c
+	}
+
+	return res
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure global 802.1X settings.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSwitchController8021XSettings() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSwitchController8021XSettingsRead,
+		Schema: dataSourceSchema(resourceSwitchController8021XSettings().Schema, ""),
+	}
+}
+
+func dataSourceSwitchController8021XSettingsRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "SwitchController8021XSettings"
+
+	o, err := c.ReadSwitchController8021XSettings(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchController8021XSettings: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSwitchController8021XSettings(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchController8021XSettings from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure Dynamic port policy to be applied on the managed FortiSwitch ports through DPP device.
+
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSwitchControllerDynamicPortPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSwitchControllerDynamicPortPolicyRead,
+		Schema: dataSourceSchema(resourceSwitchControllerDynamicPortPolicy().Schema, "name"),
+	}
+}
+
+func dataSourceSwitchControllerDynamicPortPolicyRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := ""
+
+	t := d.Get("name")
+	if v, ok := t.(string); ok {
+		mkey = v
+	} else if v, ok := t.(int); ok {
+		mkey = strconv.Itoa(v)
+	} else {
+		return fmt.Errorf("Error describing SwitchControllerDynamicPortPolicy: type error")
+	}
+
+	o, err := c.ReadSwitchControllerDynamicPortPolicy(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchControllerDynamicPortPolicy: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSwitchControllerDynamicPortPolicy(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchControllerDynamicPortPolicy from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
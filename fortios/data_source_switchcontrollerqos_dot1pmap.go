@@ -0,0 +1,66 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure FortiSwitch QoS 802.1p.
+
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSwitchControllerQosDot1PMap() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSwitchControllerQosDot1PMapRead,
+		Schema: dataSourceSchema(resourceSwitchControllerQosDot1PMap().Schema, "name"),
+	}
+}
+
+func dataSourceSwitchControllerQosDot1PMapRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := ""
+
+	t := d.Get("name")
+	if v, ok := t.(string); ok {
+		mkey = v
+	} else if v, ok := t.(int); ok {
+		mkey = strconv.Itoa(v)
+	} else {
+		return fmt.Errorf("Error describing SwitchControllerQosDot1PMap: type error")
+	}
+
+	o, err := c.ReadSwitchControllerQosDot1PMap(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchControllerQosDot1PMap: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSwitchControllerQosDot1PMap(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing SwitchControllerQosDot1PMap from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: 3G MODEM custom.
+
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSystem3GModemCustom() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSystem3GModemCustomRead,
+		Schema: dataSourceSchema(resourceSystem3GModemCustom().Schema, "fosid"),
+	}
+}
+
+func dataSourceSystem3GModemCustomRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := ""
+
+	t := d.Get("fosid")
+	if v, ok := t.(string); ok {
+		mkey = v
+	} else if v, ok := t.(int); ok {
+		mkey = strconv.Itoa(v)
+	} else {
+		return fmt.Errorf("Error describing System3GModemCustom: type error")
+	}
+
+	o, err := c.ReadSystem3GModemCustom(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing System3GModemCustom: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSystem3GModemCustom(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing System3GModemCustom from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
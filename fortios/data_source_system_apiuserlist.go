@@ -16,7 +16,7 @@ func dataSourceSystemApiUserList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemApiUserListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemApiUserList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemApiUserListRead(d *schema.ResourceData, m interface{}) erro
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/api-user", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/api-user", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemApiUser: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemArpTableList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemArpTableListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemArpTableList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemArpTableListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/arp-table", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/arp-table", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemArpTable: %v", err)
 	}
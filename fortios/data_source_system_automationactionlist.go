@@ -16,7 +16,7 @@ func dataSourceSystemAutomationActionList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemAutomationActionListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemAutomationActionList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemAutomationActionListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/automation-action", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/automation-action", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutomationAction: %v", err)
 	}
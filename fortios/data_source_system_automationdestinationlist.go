@@ -16,7 +16,7 @@ func dataSourceSystemAutomationDestinationList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemAutomationDestinationListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemAutomationDestinationList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemAutomationDestinationListRead(d *schema.ResourceData, m int
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/automation-destination", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/automation-destination", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemAutomationDestination: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemDdnsList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemDdnsListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemDdnsList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemDdnsListRead(d *schema.ResourceData, m interface{}) error {
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ddns", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/ddns", "ddnsid", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDdns: %v", err)
 	}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure DNS64.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSystemDns64() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSystemDns64Read,
+		Schema: dataSourceSchema(resourceSystemDns64().Schema, ""),
+	}
+}
+
+func dataSourceSystemDns64Read(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "SystemDns64"
+
+	o, err := c.ReadSystemDns64(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemDns64: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSystemDns64(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemDns64 from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
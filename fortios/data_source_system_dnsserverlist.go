@@ -16,7 +16,7 @@ func dataSourceSystemDnsServerList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemDnsServerListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemDnsServerList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemDnsServerListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/dns-server", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/dns-server", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDnsServer: %v", err)
 	}
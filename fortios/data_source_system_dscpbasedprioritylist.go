@@ -16,7 +16,7 @@ func dataSourceSystemDscpBasedPriorityList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemDscpBasedPriorityListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemDscpBasedPriorityList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemDscpBasedPriorityListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/dscp-based-priority", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/dscp-based-priority", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemDscpBasedPriority: %v", err)
 	}
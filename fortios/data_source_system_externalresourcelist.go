@@ -16,7 +16,7 @@ func dataSourceSystemExternalResourceList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemExternalResourceListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemExternalResourceList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemExternalResourceListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/external-resource", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/external-resource", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemExternalResource: %v", err)
 	}
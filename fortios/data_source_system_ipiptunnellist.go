@@ -16,7 +16,7 @@ func dataSourceSystemIpipTunnelList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemIpipTunnelListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemIpipTunnelList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemIpipTunnelListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipip-tunnel", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/ipip-tunnel", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpipTunnel: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemIpv6NeighborCacheList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemIpv6NeighborCacheListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemIpv6NeighborCacheList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemIpv6NeighborCacheListRead(d *schema.ResourceData, m interfa
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipv6-neighbor-cache", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/ipv6-neighbor-cache", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpv6NeighborCache: %v", err)
 	}
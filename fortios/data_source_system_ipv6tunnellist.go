@@ -16,7 +16,7 @@ func dataSourceSystemIpv6TunnelList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemIpv6TunnelListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemIpv6TunnelList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemIpv6TunnelListRead(d *schema.ResourceData, m interface{}) e
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/ipv6-tunnel", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/ipv6-tunnel", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemIpv6Tunnel: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemLinkMonitorList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemLinkMonitorListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemLinkMonitorList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemLinkMonitorListRead(d *schema.ResourceData, m interface{})
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/link-monitor", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/link-monitor", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemLinkMonitor: %v", err)
 	}
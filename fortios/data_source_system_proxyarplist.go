@@ -16,7 +16,7 @@ func dataSourceSystemProxyArpList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemProxyArpListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemProxyArpList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemProxyArpListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/proxy-arp", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/proxy-arp", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemProxyArp: %v", err)
 	}
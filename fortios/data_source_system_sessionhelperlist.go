@@ -16,7 +16,7 @@ func dataSourceSystemSessionHelperList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemSessionHelperListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemSessionHelperList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemSessionHelperListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/session-helper", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/session-helper", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSessionHelper: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemSmsServerList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemSmsServerListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemSmsServerList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemSmsServerListRead(d *schema.ResourceData, m interface{}) er
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/sms-server", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/sms-server", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSmsServer: %v", err)
 	}
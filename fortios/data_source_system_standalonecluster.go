@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure FortiGate Session Life Support Protocol (FGSP) cluster attributes.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSystemStandaloneCluster() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceSystemStandaloneClusterRead,
+		Schema: dataSourceSchema(resourceSystemStandaloneCluster().Schema, ""),
+	}
+}
+
+func dataSourceSystemStandaloneClusterRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "SystemStandaloneCluster"
+
+	o, err := c.ReadSystemStandaloneCluster(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemStandaloneCluster: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectSystemStandaloneCluster(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing SystemStandaloneCluster from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
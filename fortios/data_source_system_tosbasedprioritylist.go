@@ -16,7 +16,7 @@ func dataSourceSystemTosBasedPriorityList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemTosBasedPriorityListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemTosBasedPriorityList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemTosBasedPriorityListRead(d *schema.ResourceData, m interfac
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/tos-based-priority", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/tos-based-priority", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemTosBasedPriority: %v", err)
 	}
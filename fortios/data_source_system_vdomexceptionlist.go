@@ -16,7 +16,7 @@ func dataSourceSystemVdomExceptionList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemVdomExceptionListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemVdomExceptionList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemVdomExceptionListRead(d *schema.ResourceData, m interface{}
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/vdom-exception", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/vdom-exception", "id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemVdomException: %v", err)
 	}
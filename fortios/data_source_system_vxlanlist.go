@@ -16,7 +16,7 @@ func dataSourceSystemVxlanList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemVxlanListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemVxlanList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemVxlanListRead(d *schema.ResourceData, m interface{}) error
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/vxlan", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/vxlan", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemVxlan: %v", err)
 	}
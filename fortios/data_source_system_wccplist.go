@@ -16,7 +16,7 @@ func dataSourceSystemWccpList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemWccpListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemWccpList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemWccpListRead(d *schema.ResourceData, m interface{}) error {
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/wccp", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/wccp", "service-id", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemWccp: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemZoneList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemZoneListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemZoneList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemZoneListRead(d *schema.ResourceData, m interface{}) error {
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system/zone", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system/zone", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemZone: %v", err)
 	}
@@ -16,7 +16,7 @@ func dataSourceSystemSnmpUserList() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceSystemSnmpUserListRead,
 
-		Schema: map[string]*schema.Schema{
+		Schema: dataSourceListSchema(map[string]*schema.Schema{
 			"vdomparam": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -31,7 +31,7 @@ func dataSourceSystemSnmpUserList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-		},
+		}),
 	}
 }
 
@@ -52,7 +52,7 @@ func dataSourceSystemSnmpUserListRead(d *schema.ResourceData, m interface{}) err
 		filter = escapeFilter(filter)
 	}
 
-	o, err := c.GenericGroupRead("/api/v2/cmdb/system.snmp/user", filter, vdomparam)
+	o, err := dataSourceListRead(d, c, "/api/v2/cmdb/system.snmp/user", "name", filter, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error describing SystemSnmpUser: %v", err)
 	}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure L2TP.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceVpnL2Tp() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceVpnL2TpRead,
+		Schema: dataSourceSchema(resourceVpnL2Tp().Schema, ""),
+	}
+}
+
+func dataSourceVpnL2TpRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "VpnL2Tp"
+
+	o, err := c.ReadVpnL2Tp(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing VpnL2Tp: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectVpnL2Tp(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing VpnL2Tp from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
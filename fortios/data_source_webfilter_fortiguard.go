@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure FortiGuard Web Filter service.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceWebfilterFortiguard() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceWebfilterFortiguardRead,
+		Schema: dataSourceSchema(resourceWebfilterFortiguard().Schema, ""),
+	}
+}
+
+func dataSourceWebfilterFortiguardRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "WebfilterFortiguard"
+
+	o, err := c.ReadWebfilterFortiguard(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing WebfilterFortiguard: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectWebfilterFortiguard(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing WebfilterFortiguard from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
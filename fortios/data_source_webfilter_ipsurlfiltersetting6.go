@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure IPS URL filter settings for IPv6.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceWebfilterIpsUrlfilterSetting6() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceWebfilterIpsUrlfilterSetting6Read,
+		Schema: dataSourceSchema(resourceWebfilterIpsUrlfilterSetting6().Schema, ""),
+	}
+}
+
+func dataSourceWebfilterIpsUrlfilterSetting6Read(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "WebfilterIpsUrlfilterSetting6"
+
+	o, err := c.ReadWebfilterIpsUrlfilterSetting6(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing WebfilterIpsUrlfilterSetting6: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectWebfilterIpsUrlfilterSetting6(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing WebfilterIpsUrlfilterSetting6 from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
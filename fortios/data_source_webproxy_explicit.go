@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure explicit Web proxy settings.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceWebProxyExplicit() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceWebProxyExplicitRead,
+		Schema: dataSourceSchema(resourceWebProxyExplicit().Schema, ""),
+	}
+}
+
+func dataSourceWebProxyExplicitRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "WebProxyExplicit"
+
+	o, err := c.ReadWebProxyExplicit(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing WebProxyExplicit: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectWebProxyExplicit(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing WebProxyExplicit from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Fortinet, Inc. All rights reserved.
+// Author: Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu)
+// Documentation:
+// Frank Shen (@frankshen01), Hongbin Lu (@fgtdev-hblu),
+// Xing Li (@lix-fortinet), Yue Wang (@yuew-ftnt), Yuffie Zhu (@yuffiezhu)
+
+// Description: Configure inter wireless controller operation.
+
+package fortios
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceWirelessControllerInterController() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceWirelessControllerInterControllerRead,
+		Schema: dataSourceSchema(resourceWirelessControllerInterController().Schema, ""),
+	}
+}
+
+func dataSourceWirelessControllerInterControllerRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mkey := "WirelessControllerInterController"
+
+	o, err := c.ReadWirelessControllerInterController(mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error describing WirelessControllerInterController: %v", err)
+	}
+
+	if o == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = refreshObjectWirelessControllerInterController(d, o, c.Fv)
+	if err != nil {
+		return fmt.Errorf("Error describing WirelessControllerInterController from API: %v", err)
+	}
+
+	d.SetId(mkey)
+
+	return nil
+}
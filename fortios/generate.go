@@ -0,0 +1,356 @@
+package fortios
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// generatedObject is an object read from FortiOS for which configuration is generated.
+type generatedObject struct {
+	rtype  string
+	name   string
+	id     string
+	mkey   string
+	values map[string]interface{}
+}
+
+// configGenerator reads the objects of the CMDB tables managed by the resources
+// and writes them as Terraform configuration with import blocks.
+type configGenerator struct {
+	client   *FortiClient
+	provider *schema.Provider
+	vdom     string
+	types    []string
+
+	objects []*generatedObject
+	names   map[string]bool
+	// byMkey indexes the objects of the tables keyed by name, for references
+	byMkey map[string][]*generatedObject
+	edges  map[*generatedObject]map[*generatedObject]bool
+}
+
+func newConfigGenerator(c *FortiClient, vdom string, types []string) *configGenerator {
+	return &configGenerator{
+		client:   c,
+		provider: Provider().(*schema.Provider),
+		vdom:     vdom,
+		types:    types,
+		names:    make(map[string]bool),
+		byMkey:   make(map[string][]*generatedObject),
+		edges:    make(map[*generatedObject]map[*generatedObject]bool),
+	}
+}
+
+// selectedTypes returns the resource types to generate, all types of cmdbTables if
+// none is given. A type ending with "*" selects all types with that prefix.
+func (g *configGenerator) selectedTypes() []string {
+	var res []string
+
+	for rtype := range cmdbTables {
+		if len(g.types) == 0 {
+			res = append(res, rtype)
+			continue
+		}
+		for _, t := range g.types {
+			if t == rtype || strings.HasSuffix(t, "*") && strings.HasPrefix(rtype, strings.TrimSuffix(t, "*")) {
+				res = append(res, rtype)
+				break
+			}
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+func (g *configGenerator) importID(id string) string {
+	if g.vdom != "" {
+		return g.vdom + "/" + id
+	}
+	return id
+}
+
+// listMkeys returns the mkeys of all entries of the table.
+func (g *configGenerator) listMkeys(t cmdbTable) ([]string, error) {
+	c := g.client.Client
+	c.Retries = 1
+
+	o, err := c.GenericGroupRead(t.Path, "format="+t.MkeyAPI(), g.vdom)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, r := range o {
+		if i, ok := r.(map[string]interface{}); ok && i[t.MkeyAPI()] != nil {
+			res = append(res, importMkeyString(i[t.MkeyAPI()]))
+		}
+	}
+
+	return res, nil
+}
+
+// readObject reads one object through the Read function of the resource, so
+// that the values are flattened the same way as in the state.
+func (g *configGenerator) readObject(rtype, id string) (map[string]interface{}, error) {
+	r := g.provider.ResourcesMap[rtype]
+
+	d := r.Data(nil)
+	d.SetId(id)
+	if g.vdom != "" {
+		d.Set("vdomparam", g.vdom)
+	}
+
+	if err := r.Read(d, g.client); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	return resourceDataValues(r.Schema, d), nil
+}
+
+func (g *configGenerator) add(rtype string, t cmdbTable, id string, values map[string]interface{}) {
+	o := &generatedObject{
+		rtype:  rtype,
+		id:     id,
+		values: values,
+	}
+
+	if t.IsSingle() {
+		o.name = "this"
+	} else {
+		o.mkey = id
+		o.name = hclLabel(id)
+	}
+
+	// Keep the resource names unique within the type
+	for n, i := o.name, 2; g.names[rtype+"."+o.name]; i++ {
+		o.name = fmt.Sprintf("%s_%d", n, i)
+	}
+	g.names[rtype+"."+o.name] = true
+
+	g.objects = append(g.objects, o)
+	if t.MkeyType == "string" {
+		g.byMkey[id] = append(g.byMkey[id], o)
+	}
+}
+
+// read reads the objects of all selected resource types.
+func (g *configGenerator) read() error {
+	for _, rtype := range g.selectedTypes() {
+		t := cmdbTables[rtype]
+
+		ids := []string{t.ID}
+		if !t.IsSingle() {
+			var err error
+			ids, err = g.listMkeys(t)
+			if err != nil {
+				log.Printf("[WARN] skip %s, cannot read %s: %v", rtype, t.Path, err)
+				continue
+			}
+		}
+
+		for _, id := range ids {
+			v, err := g.readObject(rtype, id)
+			if err != nil {
+				log.Printf("[WARN] skip %s %s: %v", rtype, id, err)
+				continue
+			}
+			if v != nil {
+				g.add(rtype, t, id, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *configGenerator) reaches(from, to *generatedObject) bool {
+	if from == to {
+		return true
+	}
+
+	seen := map[*generatedObject]bool{from: true}
+	stack := []*generatedObject{from}
+	for len(stack) > 0 {
+		o := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for n := range g.edges[o] {
+			if n == to {
+				return true
+			}
+			if !seen[n] {
+				seen[n] = true
+				stack = append(stack, n)
+			}
+		}
+	}
+
+	return false
+}
+
+// reference returns the expression referring to the only other object named v,
+// unless the reference would create a dependency cycle.
+func (g *configGenerator) reference(from *generatedObject, path []string, v string) string {
+	if len(path) == 1 && (path[0] == "vdomparam" || from.mkey != "" && path[0] == cmdbTables[from.rtype].Mkey) {
+		return ""
+	}
+
+	l := g.byMkey[v]
+	if len(l) != 1 || l[0] == from || g.reaches(l[0], from) {
+		return ""
+	}
+
+	to := l[0]
+	if g.edges[from] == nil {
+		g.edges[from] = make(map[*generatedObject]bool)
+	}
+	g.edges[from][to] = true
+
+	return fmt.Sprintf("%s.%s.%s", to.rtype, to.name, cmdbTables[to.rtype].Mkey)
+}
+
+// render returns the configuration of each resource type.
+func (g *configGenerator) render() map[string]string {
+	res := make(map[string]string)
+
+	var w *hclWriter
+	rtype := ""
+	for _, o := range g.objects {
+		if o.rtype != rtype {
+			if w != nil {
+				res[rtype] = w.String()
+			}
+			rtype = o.rtype
+			w = &hclWriter{}
+		} else {
+			w.b.WriteString("\n")
+		}
+
+		obj := o
+		w.ref = func(path []string, v string) string {
+			return g.reference(obj, path, v)
+		}
+
+		w.importBlock(o.rtype, o.name, g.importID(o.id))
+		w.b.WriteString("\n")
+		w.resource(o.rtype, o.name, g.provider.ResourcesMap[o.rtype].Schema, o.values)
+	}
+	if w != nil {
+		res[rtype] = w.String()
+	}
+
+	return res
+}
+
+// writeFiles writes the configuration of each resource type to <dir>/<type>.tf.
+func (g *configGenerator) writeFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for rtype, s := range g.render() {
+		f := filepath.Join(dir, rtype+".tf")
+		if err := ioutil.WriteFile(f, []byte(s), 0644); err != nil {
+			return fmt.Errorf("Error writing %s: %v", f, err)
+		}
+	}
+
+	return nil
+}
+
+func generateClientConfig(fs *flag.FlagSet) func() (*FortiClient, error) {
+	hostname := fs.String("hostname", "", "hostname/IP address of the FortiOS, or FORTIOS_ACCESS_HOSTNAME")
+	token := fs.String("token", "", "API token of the FortiOS, or FORTIOS_ACCESS_TOKEN")
+	insecure := fs.String("insecure", "", "true to skip the verification of the certificate, or FORTIOS_INSECURE")
+	cabundle := fs.String("cabundlefile", "", "CA bundle file, or FORTIOS_CA_CABUNDLE")
+
+	return func() (*FortiClient, error) {
+		config := Config{
+			Hostname: *hostname,
+			Token:    *token,
+			CABundle: *cabundle,
+		}
+
+		if *insecure != "" {
+			b := *insecure == "true"
+			config.Insecure = &b
+		}
+
+		c, err := config.CreateClient()
+		if err != nil {
+			return nil, err
+		}
+
+		fc := c.(*FortiClient)
+		if fc.Client == nil {
+			return nil, fmt.Errorf("FortiOS hostname is not set")
+		}
+
+		return fc, nil
+	}
+}
+
+func generateCliConfigClient(file string) (*FortiClient, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c, err := parseCliConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s: %v", file, err)
+	}
+
+	return newCliConfigClient(c)
+}
+
+// Generate implements the generate command of the provider binary, which writes
+// Terraform configuration and import blocks for the objects on a FortiGate, or in
+// a FortiOS CLI configuration file.
+func Generate(args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	output := fs.String("output", ".", "directory to write the configuration to")
+	vdom := fs.String("vdom", "", "vdom to read the objects from")
+	types := fs.String("resources", "", "comma separated resource types to generate, such as fortios_firewall_*, all types by default")
+	config := fs.String("config", "", "FortiOS CLI configuration file, such as a backup, to read the objects from instead of a FortiGate")
+	client := generateClientConfig(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var c *FortiClient
+	var err error
+	if *config != "" {
+		c, err = generateCliConfigClient(*config)
+	} else {
+		c, err = client()
+	}
+	if err != nil {
+		return err
+	}
+
+	var tl []string
+	if *types != "" {
+		tl = strings.Split(*types, ",")
+	}
+
+	g := newConfigGenerator(c, *vdom, tl)
+	if err := g.read(); err != nil {
+		return err
+	}
+
+	return g.writeFiles(*output)
+}
@@ -0,0 +1,93 @@
+package fortios
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+)
+
+// fortiGenericRequest sends a request to the FortiOS API and returns the decoded response.
+// body is encoded as the JSON of the request unless nil. An error is returned if FortiOS
+// does not answer with status success, the response is returned anyway when decoded.
+func fortiGenericRequest(c *forticlient.FortiSDKClient, method, path, params string, body interface{}, vdomparam string) (map[string]interface{}, error) {
+	i := &forticlient.JSONJSONGenericAPI{
+		Path:          path,
+		Method:        method,
+		Specialparams: params,
+	}
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		i.Json = string(b)
+	}
+
+	res, err := c.CreateJSONGenericAPI(i, vdomparam)
+	if err != nil {
+		return nil, err
+	}
+
+	return fortiGenericResult(method, path, []byte(res))
+}
+
+// fortiGenericRequestHeader is fortiGenericRequest with the HTTP headers of header, such as
+// the transaction the request belongs to.
+func fortiGenericRequestHeader(c *forticlient.FortiSDKClient, method, path, params string, body interface{}, header map[string]string, vdomparam string) (map[string]interface{}, error) {
+	if len(header) == 0 {
+		return fortiGenericRequest(c, method, path, params, body, vdomparam)
+	}
+
+	var data *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		data = bytes.NewBuffer(b)
+	}
+
+	req := c.NewRequest(method, path, nil, data)
+	for k, v := range header {
+		req.HTTPRequest.Header.Set(k, v)
+	}
+
+	err := req.SendWithSpecialParams(params, vdomparam)
+	if err != nil || req.HTTPResponse == nil {
+		return nil, fmt.Errorf("cannot send request %v", err)
+	}
+
+	res, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	req.HTTPResponse.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get response body %v", err)
+	}
+
+	return fortiGenericResult(method, path, res)
+}
+
+// fortiGenericResult decodes the response of a request to the FortiOS API.
+func fortiGenericResult(method, path string, res []byte) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return nil, fmt.Errorf("cannot decode the response of %s %s: %v", method, path, err)
+	}
+
+	if result["status"] != "success" {
+		if result["error"] != nil {
+			return result, fmt.Errorf("%s %s failed with HTTP status %v, error %v", method, path, result["http_status"], result["error"])
+		}
+		return result, fmt.Errorf("%s %s failed with HTTP status %v", method, path, result["http_status"])
+	}
+
+	return result, nil
+}
+
+// fortiGenericNotFound reports whether the response of fortiGenericRequest is HTTP 404.
+func fortiGenericNotFound(result map[string]interface{}) bool {
+	return result != nil && fortiIntValue(result["http_status"]) == 404
+}
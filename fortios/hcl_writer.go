@@ -0,0 +1,228 @@
+package fortios
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// hclWriter renders resource arguments as Terraform configuration.
+type hclWriter struct {
+	b strings.Builder
+
+	// ref returns the expression to use instead of the string value v of the argument
+	// at path, or "" to write v as it is.
+	ref func(path []string, v string) string
+}
+
+func hclQuote(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$', '%':
+			// Escape template sequences
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteRune(r)
+			}
+			b.WriteRune(r)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+var hclLabelInvalid = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// hclLabel converts a name to a valid resource name.
+func hclLabel(s string) string {
+	s = strings.Trim(hclLabelInvalid.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if s == "" {
+		return "_"
+	}
+	if s[0] >= '0' && s[0] <= '9' || s[0] == '-' {
+		s = "_" + s
+	}
+	return s
+}
+
+func hclIsBlock(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+	_, ok := s.Elem.(*schema.Resource)
+	return ok
+}
+
+func hclList(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case *schema.Set:
+		return t.List()
+	case []interface{}:
+		return t
+	case []map[string]interface{}:
+		l := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			l = append(l, e)
+		}
+		return l
+	}
+	return nil
+}
+
+// hclSkip reports whether the argument is left out of the configuration.
+func hclSkip(s *schema.Schema, v interface{}) bool {
+	if v == nil || s.Sensitive {
+		return true
+	}
+	if s.Computed && !s.Optional && !s.Required {
+		return true
+	}
+	if s.Default != nil && reflect.DeepEqual(s.Default, v) {
+		return true
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t == ""
+	case *schema.Set, []interface{}, []map[string]interface{}:
+		return len(hclList(t)) == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	}
+
+	return false
+}
+
+func (w *hclWriter) value(path []string, v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		if w.ref != nil {
+			if e := w.ref(path, t); e != "" {
+				return e
+			}
+		}
+		return hclQuote(t)
+	case int:
+		return strconv.Itoa(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		l := make([]string, 0, len(keys))
+		for _, k := range keys {
+			l = append(l, hclQuote(k)+" = "+w.value(append(path, k), t[k]))
+		}
+		return "{ " + strings.Join(l, ", ") + " }"
+	}
+
+	if l := hclList(v); l != nil {
+		s := make([]string, 0, len(l))
+		for _, e := range l {
+			s = append(s, w.value(path, e))
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	}
+
+	return hclQuote(fmt.Sprintf("%v", v))
+}
+
+// body writes the arguments in v described by sch, the arguments first and then
+// the blocks, each in alphabetical order.
+func (w *hclWriter) body(indent string, sch map[string]*schema.Schema, v map[string]interface{}, path []string) {
+	var attrs, blocks []string
+
+	for k, s := range sch {
+		if hclSkip(s, v[k]) {
+			continue
+		}
+		if hclIsBlock(s) {
+			blocks = append(blocks, k)
+		} else {
+			attrs = append(attrs, k)
+		}
+	}
+	sort.Strings(attrs)
+	sort.Strings(blocks)
+
+	width := 0
+	for _, k := range attrs {
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+
+	for _, k := range attrs {
+		fmt.Fprintf(&w.b, "%s%-*s = %s\n", indent, width, k, w.value(append(path, k), v[k]))
+	}
+
+	for _, k := range blocks {
+		elem := sch[k].Elem.(*schema.Resource)
+		for _, e := range hclList(v[k]) {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&w.b, "\n%s%s {\n", indent, k)
+			w.body(indent+"  ", elem.Schema, m, append(path, k))
+			fmt.Fprintf(&w.b, "%s}\n", indent)
+		}
+	}
+}
+
+// resource writes a resource block with the arguments in v.
+func (w *hclWriter) resource(rtype, name string, sch map[string]*schema.Schema, v map[string]interface{}) {
+	fmt.Fprintf(&w.b, "resource %q %q {\n", rtype, name)
+	w.body("  ", sch, v, nil)
+	w.b.WriteString("}\n")
+}
+
+// importBlock writes an import block for the resource.
+func (w *hclWriter) importBlock(rtype, name, id string) {
+	fmt.Fprintf(&w.b, "import {\n  to = %s.%s\n  id = %s\n}\n", rtype, name, hclQuote(id))
+}
+
+func (w *hclWriter) String() string {
+	return w.b.String()
+}
+
+// resourceDataValues returns the values of the top level arguments which are set in d.
+func resourceDataValues(sch map[string]*schema.Schema, d *schema.ResourceData) map[string]interface{} {
+	v := make(map[string]interface{}, len(sch))
+	for k := range sch {
+		if t, ok := d.GetOkExists(k); ok {
+			v[k] = t
+		}
+	}
+	return v
+}
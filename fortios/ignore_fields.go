@@ -0,0 +1,301 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// parseIgnoreFields converts the ignore_fields argument, which maps a resource type
+// to a comma separated list of attribute paths, into a list of paths per resource type.
+// The paths are checked against the schemas of resources.
+func parseIgnoreFields(v map[string]interface{}, resources map[string]*schema.Resource) (map[string][]string, error) {
+	res := make(map[string][]string)
+
+	for rtype, paths := range v {
+		s, ok := paths.(string)
+		if !ok {
+			return nil, fmt.Errorf("ignore_fields for %s should be a string", rtype)
+		}
+
+		r, ok := resources[rtype]
+		if !ok {
+			return nil, fmt.Errorf("unknown resource type %s in ignore_fields", rtype)
+		}
+
+		for _, p := range strings.Split(s, ",") {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			if strings.HasPrefix(p, ".") || strings.HasSuffix(p, ".") || strings.Contains(p, "..") {
+				return nil, fmt.Errorf("invalid attribute path %q in ignore_fields for %s", p, rtype)
+			}
+			if err := ignoreFieldsCheckPath(r.Schema, strings.Split(p, ".")); err != nil {
+				return nil, fmt.Errorf("invalid attribute path %q in ignore_fields for %s: %v", p, rtype, err)
+			}
+			res[rtype] = append(res[rtype], p)
+		}
+	}
+
+	return res, nil
+}
+
+// ignoreFieldsCheckPath checks that the path addresses an attribute of the schema which is
+// computed, as only computed attributes can be left out of the plan.
+func ignoreFieldsCheckPath(sch map[string]*schema.Schema, segs []string) error {
+	var s *schema.Schema
+
+	for i, seg := range segs {
+		if _, ok := ignoreFieldsSegIndex(seg); ok && s != nil && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			continue
+		}
+
+		if sch == nil {
+			return fmt.Errorf("%s has no nested attributes", strings.Join(segs[:i], "."))
+		}
+		if s = sch[seg]; s == nil {
+			return fmt.Errorf("unknown attribute %s", strings.Join(segs[:i+1], "."))
+		}
+
+		sch = nil
+		if r, ok := s.Elem.(*schema.Resource); ok {
+			sch = r.Schema
+		}
+	}
+
+	if s == nil {
+		return fmt.Errorf("no attribute")
+	}
+	if !s.Computed {
+		return fmt.Errorf("%s is not computed", strings.Join(segs, "."))
+	}
+	return nil
+}
+
+func ignoredFields(m interface{}, rtype string) []string {
+	if c, ok := m.(*FortiClient); ok && c != nil {
+		return c.IgnoreFields[rtype]
+	}
+
+	return nil
+}
+
+func ignoreFieldsSegIndex(seg string) (int, bool) {
+	i, err := strconv.Atoi(seg)
+	if err != nil || i < 0 {
+		return 0, false
+	}
+	return i, true
+}
+
+// removeIgnoredFields deletes the attributes listed in ignore_fields for rtype from the
+// object built by getObject*, so that they are never sent to FortiOS.
+func removeIgnoredFields(m interface{}, rtype string, obj *map[string]interface{}) {
+	if obj == nil {
+		return
+	}
+
+	for _, p := range ignoredFields(m, rtype) {
+		removeIgnoredFieldsPath(*obj, strings.Split(p, "."))
+	}
+}
+
+func removeIgnoredFieldsPath(v interface{}, segs []string) {
+	if len(segs) == 0 {
+		return
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		key := fortiAPIKey(segs[0])
+		if len(segs) == 1 {
+			delete(t, key)
+			return
+		}
+		removeIgnoredFieldsPath(t[key], segs[1:])
+	case []map[string]interface{}:
+		if i, ok := ignoreFieldsSegIndex(segs[0]); ok {
+			if i < len(t) {
+				removeIgnoredFieldsPath(t[i], segs[1:])
+			}
+			return
+		}
+		for _, e := range t {
+			removeIgnoredFieldsPath(e, segs)
+		}
+	case []interface{}:
+		if i, ok := ignoreFieldsSegIndex(segs[0]); ok {
+			if i < len(t) {
+				removeIgnoredFieldsPath(t[i], segs[1:])
+			}
+			return
+		}
+		for _, e := range t {
+			removeIgnoredFieldsPath(e, segs)
+		}
+	}
+}
+
+// mergeIgnoredValue returns cur with the value addressed by segs replaced by the one in prev.
+func mergeIgnoredValue(prev, cur interface{}, segs []string) interface{} {
+	if len(segs) == 0 {
+		return prev
+	}
+
+	if s, ok := cur.(*schema.Set); ok {
+		cur = s.List()
+	}
+	if s, ok := prev.(*schema.Set); ok {
+		prev = s.List()
+	}
+
+	switch t := cur.(type) {
+	case []interface{}:
+		p, _ := prev.([]interface{})
+		if i, ok := ignoreFieldsSegIndex(segs[0]); ok {
+			if i < len(t) && i < len(p) {
+				t[i] = mergeIgnoredValue(p[i], t[i], segs[1:])
+			}
+			return t
+		}
+		for i := range t {
+			if i < len(p) {
+				t[i] = mergeIgnoredValue(p[i], t[i], segs)
+			}
+		}
+		return t
+	case map[string]interface{}:
+		p, _ := prev.(map[string]interface{})
+		if p == nil {
+			return t
+		}
+		if pv, ok := p[segs[0]]; ok {
+			t[segs[0]] = mergeIgnoredValue(pv, t[segs[0]], segs[1:])
+		}
+		return t
+	}
+
+	return cur
+}
+
+// ignoreFieldsKeyMatch returns the prefix of the flatmap key of a diff which addresses
+// the ignored path, if the key falls under it. List indexes in the key are skipped unless
+// the path names them.
+func ignoreFieldsKeyMatch(path, key []string) (string, bool) {
+	i := 0
+	for n, k := range key {
+		if i == len(path) {
+			return strings.Join(key[:n], "."), true
+		}
+		if k == path[i] {
+			i++
+			continue
+		}
+		if _, ok := ignoreFieldsSegIndex(k); ok {
+			if _, ok := ignoreFieldsSegIndex(path[i]); !ok {
+				continue
+			}
+		}
+		if k == "#" || k == "%" {
+			continue
+		}
+		return "", false
+	}
+
+	if i < len(path) {
+		return "", false
+	}
+	return strings.Join(key, "."), true
+}
+
+func ignoreFieldsRead(rtype string, read schema.ReadFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		fields := ignoredFields(m, rtype)
+		if len(fields) == 0 || d.Id() == "" {
+			return read(d, m)
+		}
+
+		prev := make(map[string]interface{})
+		for _, p := range fields {
+			top := strings.Split(p, ".")[0]
+			if v, ok := d.GetOk(top); ok {
+				prev[top] = v
+			}
+		}
+
+		if err := read(d, m); err != nil || d.Id() == "" {
+			return err
+		}
+
+		for _, p := range fields {
+			segs := strings.Split(p, ".")
+			v, ok := prev[segs[0]]
+			if !ok {
+				continue
+			}
+
+			if err := d.Set(segs[0], mergeIgnoredValue(v, d.Get(segs[0]), segs[1:])); err != nil {
+				return fmt.Errorf("Error keeping ignored field %s: %v", p, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func ignoreFieldsCustomizeDiff(rtype string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, m); err != nil {
+				return err
+			}
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		for _, p := range ignoredFields(m, rtype) {
+			path := strings.Split(p, ".")
+
+			// The attribute addressed by the path is cleared with all the keys under it,
+			// counts and list elements included, which have no computed schema of their own
+			prefixes := make(map[string]bool)
+			for _, k := range d.GetChangedKeysPrefix(path[0]) {
+				if prefix, ok := ignoreFieldsKeyMatch(path, strings.Split(k, ".")); ok {
+					prefixes[prefix] = true
+				}
+			}
+
+			keys := make([]string, 0, len(prefixes))
+			for k := range prefixes {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				// Only computed attributes can be cleared from the diff
+				if err := d.Clear(k); err != nil {
+					return fmt.Errorf("Error ignoring field %s: %v", p, err)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// setIgnoreFieldsHooks makes the resource keep the state value of the fields listed in
+// ignore_fields on refresh and drop their changes from the plan.
+func setIgnoreFieldsHooks(rtype string, r *schema.Resource) {
+	if r.Read == nil {
+		return
+	}
+
+	r.Read = ignoreFieldsRead(rtype, r.Read)
+	r.CustomizeDiff = ignoreFieldsCustomizeDiff(rtype, r.CustomizeDiff)
+}
@@ -0,0 +1,193 @@
+package fortios
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func testIgnoreFieldsResource() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"plain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"uuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tagging": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"category": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestParseIgnoreFields(t *testing.T) {
+	resources := map[string]*schema.Resource{"fortios_test": testIgnoreFieldsResource()}
+
+	cases := []struct {
+		paths string
+		want  []string
+		err   string
+	}{
+		{paths: "uuid", want: []string{"uuid"}},
+		{paths: " uuid , tagging ,", want: []string{"uuid", "tagging"}},
+		{paths: "tagging.category", want: []string{"tagging.category"}},
+		{paths: "tagging.0.category", want: []string{"tagging.0.category"}},
+		{paths: "plain", err: "plain is not computed"},
+		{paths: "tagging.name", err: "tagging.name is not computed"},
+		{paths: "missing", err: "unknown attribute missing"},
+		{paths: "tagging.missing", err: "unknown attribute tagging.missing"},
+		{paths: "uuid.x", err: "uuid has no nested attributes"},
+		{paths: ".uuid", err: "invalid attribute path"},
+		{paths: "tagging..category", err: "invalid attribute path"},
+	}
+
+	for _, c := range cases {
+		res, err := parseIgnoreFields(map[string]interface{}{"fortios_test": c.paths}, resources)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%q: got error %v, want %q", c.paths, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.paths, err)
+			continue
+		}
+		if strings.Join(res["fortios_test"], ",") != strings.Join(c.want, ",") {
+			t.Errorf("%q: got %v, want %v", c.paths, res["fortios_test"], c.want)
+		}
+	}
+
+	if _, err := parseIgnoreFields(map[string]interface{}{"fortios_missing": "uuid"}, resources); err == nil {
+		t.Errorf("unknown resource type: no error")
+	}
+}
+
+func TestIgnoreFieldsKeyMatch(t *testing.T) {
+	cases := []struct {
+		path, key string
+		prefix    string
+		ok        bool
+	}{
+		{"uuid", "uuid", "uuid", true},
+		{"uuid", "uuid2", "", false},
+		{"tagging", "tagging.#", "tagging", true},
+		{"tagging", "tagging.1.category", "tagging", true},
+		{"tagging.category", "tagging.0.category", "tagging.0.category", true},
+		{"tagging.category", "tagging.#", "", false},
+		{"tagging.category", "tagging.0.name", "", false},
+		{"tagging.1.category", "tagging.1.category", "tagging.1.category", true},
+		{"tagging.1.category", "tagging.0.category", "", false},
+		{"tagging.tags", "tagging.0.tags.#", "tagging.0.tags", true},
+		{"tagging.tags", "tagging.0.tags.2.name", "tagging.0.tags", true},
+	}
+
+	for _, c := range cases {
+		prefix, ok := ignoreFieldsKeyMatch(strings.Split(c.path, "."), strings.Split(c.key, "."))
+		if prefix != c.prefix || ok != c.ok {
+			t.Errorf("%s, %s: got %q, %v, want %q, %v", c.path, c.key, prefix, ok, c.prefix, c.ok)
+		}
+	}
+}
+
+func TestIgnoreFieldsCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "x",
+		Attributes: map[string]string{
+			"id":                 "x",
+			"uuid":               "u1",
+			"tagging.#":          "1",
+			"tagging.0.name":     "n",
+			"tagging.0.category": "a",
+		},
+	}
+
+	cases := []struct {
+		ignore string
+		config map[string]interface{}
+		want   []string
+	}{
+		{
+			// The count of the list changes, which has no computed schema of its own
+			ignore: "uuid,tagging",
+			config: map[string]interface{}{
+				"uuid": "u2",
+				"tagging": []interface{}{
+					map[string]interface{}{"name": "n", "category": "b"},
+					map[string]interface{}{"name": "m", "category": "c"},
+				},
+			},
+		},
+		{
+			ignore: "tagging.category",
+			config: map[string]interface{}{
+				"uuid": "u1",
+				"tagging": []interface{}{
+					map[string]interface{}{"name": "n", "category": "b"},
+				},
+			},
+		},
+		{
+			ignore: "tagging.category",
+			config: map[string]interface{}{
+				"uuid":  "u1",
+				"plain": "p",
+				"tagging": []interface{}{
+					map[string]interface{}{"name": "m", "category": "b"},
+				},
+			},
+			want: []string{"plain", "tagging.0.name"},
+		},
+	}
+
+	for _, c := range cases {
+		r := testIgnoreFieldsResource()
+		setIgnoreFieldsHooks("fortios_test", r)
+
+		fields, err := parseIgnoreFields(map[string]interface{}{"fortios_test": c.ignore}, map[string]*schema.Resource{"fortios_test": r})
+		if err != nil {
+			t.Fatalf("%s: %v", c.ignore, err)
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfigRaw(c.config), &FortiClient{IgnoreFields: fields})
+		if err != nil {
+			t.Errorf("%s: %v", c.ignore, err)
+			continue
+		}
+
+		var got []string
+		if diff != nil {
+			for k := range diff.Attributes {
+				got = append(got, k)
+			}
+		}
+		sort.Strings(got)
+		if strings.Join(got, ",") != strings.Join(c.want, ",") {
+			t.Errorf("%s: got diff of %v, want %v", c.ignore, got, c.want)
+		}
+	}
+}
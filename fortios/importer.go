@@ -0,0 +1,209 @@
+package fortios
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// splitImportVdom splits an import ID of the form <vdom>/<mkey>. The leading segment is
+// only taken as the vdom if such a vdom exists on the FortiGate, so that mkeys which
+// contain "/", such as "10.0.0.0/8", can still be imported as they are.
+func splitImportVdom(id string, m interface{}) (vdom string, mkey string, err error) {
+	i := strings.Index(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", id, nil
+	}
+
+	vdom = id[:i]
+	mkey = id[i+1:]
+
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return "", "", fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	o, err := c.ReadSystemVdom(vdom, "")
+	if err != nil {
+		return "", "", fmt.Errorf("Error checking vdom %s of import ID %s: %v", vdom, id, err)
+	}
+
+	if o == nil {
+		return "", id, nil
+	}
+
+	return vdom, mkey, nil
+}
+
+func setImportVdom(d *schema.ResourceData, vdom string) error {
+	if vdom == "" {
+		return nil
+	}
+
+	if err := d.Set("vdomparam", vdom); err != nil {
+		return fmt.Errorf("Error setting vdomparam: %v", err)
+	}
+
+	return nil
+}
+
+// fortiImportStateVdom imports a resource with an ID of <mkey> or <vdom>/<mkey>.
+// When the vdom is given it is saved to vdomparam.
+func fortiImportStateVdom(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	vdom, mkey, err := splitImportVdom(d.Id(), m)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setImportVdom(d, vdom); err != nil {
+		return nil, err
+	}
+	d.SetId(mkey)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// fortiImportStateVdomChild imports a resource of a table nested in the parent object,
+// such as router bgp neighbor. Besides the formats of fortiImportStateVdom, the ID can be
+// <parent>/<mkey> or <vdom>/<parent>/<mkey>.
+func fortiImportStateVdomChild(parent string) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		id := d.Id()
+		vdom := ""
+
+		if !strings.HasPrefix(id, parent+"/") {
+			var err error
+			vdom, id, err = splitImportVdom(id, m)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		id = strings.TrimPrefix(id, parent+"/")
+		if id == "" {
+			return nil, fmt.Errorf("Error importing %s: the import ID has no mkey", d.Id())
+		}
+
+		if err := setImportVdom(d, vdom); err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func importMkeyString(v interface{}) string {
+	if _, ok := v.(float64); ok {
+		return strconv.Itoa(fortiIntValue(v))
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+var importFilterOperator = regexp.MustCompile(`[=*!@><]`)
+
+// importLookupFilter converts the import ID of a table keyed by a numeric id to the filter
+// used to look the entry up. It returns "" if the ID is the mkey itself.
+func importLookupFilter(id string) (string, error) {
+	if _, err := strconv.Atoi(id); err == nil {
+		return "", nil
+	}
+
+	// name=@web and name=!web are filters, name==<value> is the name even if it starts with @ or !
+	name, ok := "", false
+	if strings.HasPrefix(id, "name==") {
+		name, ok = strings.TrimPrefix(id, "name=="), true
+	} else if strings.HasPrefix(id, "name=") && !strings.HasPrefix(id, "name=@") && !strings.HasPrefix(id, "name=!") {
+		name, ok = strings.TrimPrefix(id, "name="), true
+	}
+	if ok {
+		if name == "" {
+			return "", fmt.Errorf("the name in import ID %s is empty", id)
+		}
+		return "filter=name==" + forticlient.EscapeURLString(name), nil
+	}
+
+	if importFilterOperator.MatchString(id) {
+		return escapeFilter(id), nil
+	}
+
+	return "", fmt.Errorf("import ID %s should be the id, name=<value> or a filter", id)
+}
+
+// fortiImportStateVdomLookup imports a resource of a table keyed by a numeric id. Besides
+// the id, the import ID can be name=<value> or a filter with the syntax of the filter
+// argument of the list data sources, optionally prefixed with <vdom>/ as in
+// fortiImportStateVdom. The filter must match exactly one entry of the table at path,
+// whose mkey field is then used as the ID.
+func fortiImportStateVdomLookup(path, mkey string) schema.StateFunc {
+	parent := ""
+	if l := strings.Split(strings.TrimPrefix(path, "/api/v2/cmdb/"), "/"); len(l) > 2 {
+		parent = l[1]
+	}
+
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		id := d.Id()
+		vdom := ""
+
+		if parent == "" || !strings.HasPrefix(id, parent+"/") {
+			var err error
+			vdom, id, err = splitImportVdom(id, m)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if parent != "" {
+			id = strings.TrimPrefix(id, parent+"/")
+		}
+
+		filter, err := importLookupFilter(id)
+		if err != nil {
+			return nil, fmt.Errorf("Error importing %s: %v", d.Id(), err)
+		}
+
+		if filter != "" {
+			c := m.(*FortiClient).Client
+			if c == nil {
+				return nil, fmt.Errorf("FortiOS connection did not initialize successfully!")
+			}
+			c.Retries = 1
+
+			o, err := c.GenericGroupRead(path, filter+"&format="+mkey, vdom)
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %s: %v", d.Id(), err)
+			}
+
+			switch len(o) {
+			case 0:
+				return nil, fmt.Errorf("Error importing %s: no entry of %s matches", d.Id(), path)
+			case 1:
+				i, ok := o[0].(map[string]interface{})
+				if !ok || i[mkey] == nil {
+					return nil, fmt.Errorf("Error importing %s: the matched entry has no %s", d.Id(), mkey)
+				}
+				id = importMkeyString(i[mkey])
+			default:
+				var ids []string
+				for _, r := range o {
+					if i, ok := r.(map[string]interface{}); ok {
+						ids = append(ids, importMkeyString(i[mkey]))
+					}
+				}
+				return nil, fmt.Errorf("Error importing %s: %d entries of %s match, %s %s", d.Id(), len(o), path, mkey, strings.Join(ids, ", "))
+			}
+		}
+
+		if err := setImportVdom(d, vdom); err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
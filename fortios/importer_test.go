@@ -0,0 +1,150 @@
+package fortios
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/fortinetdev/forti-sdk-go/fortios/auth"
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+)
+
+// testFortiHandler answers a request of the FortiOS API with the HTTP status and the
+// results of the response, nil for none.
+type testFortiHandler func(method, path string, query url.Values, body map[string]interface{}) (int, interface{})
+
+type testFortiTransport struct {
+	handler testFortiHandler
+}
+
+func (t *testFortiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body map[string]interface{}
+	if req.Body != nil {
+		b, _ := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		json.Unmarshal(b, &body)
+	}
+
+	status, results := http.StatusOK, interface{}(nil)
+	if req.URL.Path == "/api/v2/cmdb/system/global" {
+		// Queried for the version by the client
+		results = map[string]interface{}{}
+	} else {
+		status, results = t.handler(req.Method, req.URL.Path, req.URL.Query(), body)
+	}
+
+	res := map[string]interface{}{
+		"http_method": req.Method,
+		"http_status": status,
+		"version":     "v7.0.0",
+		"status":      "success",
+	}
+	if status != http.StatusOK {
+		res["status"] = "error"
+	}
+	if results != nil {
+		res["results"] = results
+	}
+
+	w := httptest.NewRecorder()
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(res)
+	return w.Result(), nil
+}
+
+// testFortiClient returns a client whose requests are answered by handler.
+func testFortiClient(t *testing.T, handler testFortiHandler) *FortiClient {
+	c, err := forticlient.NewClient(auth.NewAuth("fortigate", "", "", "", "", "", "", ""), &http.Client{Transport: &testFortiTransport{handler: handler}})
+	if err != nil {
+		t.Fatalf("creating the client: %v", err)
+	}
+	return &FortiClient{Client: c}
+}
+
+func TestSplitImportVdom(t *testing.T) {
+	// vdom root exists, vdom denied cannot be read and vdom broken fails
+	m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		switch path {
+		case "/api/v2/cmdb/system/vdom/root":
+			return http.StatusOK, []interface{}{map[string]interface{}{"name": "root"}}
+		case "/api/v2/cmdb/system/vdom/denied":
+			return http.StatusForbidden, nil
+		case "/api/v2/cmdb/system/vdom/broken":
+			return http.StatusInternalServerError, nil
+		}
+		return http.StatusNotFound, nil
+	})
+
+	cases := []struct {
+		id         string
+		vdom, mkey string
+	}{
+		{"addr1", "", "addr1"},
+		{"root/addr1", "root", "addr1"},
+		{"root/10.0.0.0/8", "root", "10.0.0.0/8"},
+		{"10.0.0.0/8", "", "10.0.0.0/8"},
+		{"firewall/address:foo", "", "firewall/address:foo"},
+		{"/addr1", "", "/addr1"},
+		{"root/", "", "root/"},
+	}
+
+	for _, c := range cases {
+		vdom, mkey, err := splitImportVdom(c.id, m)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.id, err)
+			continue
+		}
+		if vdom != c.vdom || mkey != c.mkey {
+			t.Errorf("%s: got %q, %q, want %q, %q", c.id, vdom, mkey, c.vdom, c.mkey)
+		}
+	}
+
+	// the failures other than a vdom not found are not hidden
+	for _, id := range []string{"denied/addr1", "broken/addr1"} {
+		if _, _, err := splitImportVdom(id, m); err == nil || !strings.Contains(err.Error(), id) {
+			t.Errorf("%s: got error %v, want an error naming the import ID", id, err)
+		}
+	}
+}
+
+func TestImportLookupFilter(t *testing.T) {
+	cases := []struct {
+		id     string
+		filter string
+		err    bool
+	}{
+		{id: "12", filter: ""},
+		{id: "name=web", filter: "filter=name==web"},
+		{id: "name=a b", filter: "filter=name==a%20b"},
+		{id: "name==web", filter: "filter=name==web"},
+		{id: "name==@web", filter: "filter=name==%40web"},
+		{id: "name=@web", filter: "filter=name=@web"},
+		{id: "name=!web", filter: "filter=name=!web"},
+		{id: "name!=web", filter: "filter=name!=web"},
+		{id: "comments=@lab&status==enable", filter: "filter=comments=@lab&filter=status==enable"},
+		{id: "name=", err: true},
+		{id: "name==", err: true},
+		{id: "web", err: true},
+	}
+
+	for _, c := range cases {
+		filter, err := importLookupFilter(c.id)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s: no error", c.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.id, err)
+			continue
+		}
+		if filter != c.filter {
+			t.Errorf("%s: got %q, want %q", c.id, filter, c.filter)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package fortios
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ipMathMaxWildcardCIDRs is the most CIDR blocks a wildcard mask is expanded to.
+const ipMathMaxWildcardCIDRs = 4096
+
+// ipBlock is the closed interval of addresses from Lo to Hi, of IPv4 addresses when Bits
+// is 32 and of IPv6 addresses when it is 128.
+type ipBlock struct {
+	Lo   *big.Int
+	Hi   *big.Int
+	Bits int
+}
+
+// ipMathParseIP parses an IPv4 or IPv6 address, returning it with its number of bits.
+func ipMathParseIP(s string) (*big.Int, int, bool) {
+	ip := net.ParseIP(strings.TrimSpace(s))
+	if ip == nil {
+		return nil, 0, false
+	}
+	if !strings.Contains(s, ":") {
+		return new(big.Int).SetBytes(ip.To4()), 32, true
+	}
+	return new(big.Int).SetBytes(ip.To16()), 128, true
+}
+
+// ipMathString formats v as an address of the number of bits. IPv4-mapped IPv6 addresses
+// keep their ::ffff: prefix, which net.IP drops.
+func ipMathString(v *big.Int, n int) string {
+	b := v.Bytes()
+	ip := append(make(net.IP, n/8-len(b), n/8), b...)
+	if n == 128 && ip.To4() != nil {
+		return "::ffff:" + ip.To4().String()
+	}
+	return ip.String()
+}
+
+// ipMathMask returns the mask of the prefix length n of an address of the number of bits.
+func ipMathMask(n, bits int) *big.Int {
+	all := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits)), big.NewInt(1))
+	host := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits-n)), big.NewInt(1))
+	return all.Xor(all, host)
+}
+
+// ipMathPrefix parses the mask of a subnet, a prefix length or a contiguous netmask such
+// as 255.255.255.0 or ffff:ffff::, returning the prefix length.
+func ipMathPrefix(s string, bits int) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 || n > bits {
+			return 0, fmt.Errorf("invalid prefix length %d", n)
+		}
+		return n, nil
+	}
+
+	ip := net.ParseIP(s)
+	if bits == 32 {
+		ip = ip.To4()
+	}
+	if ip == nil || len(ip)*8 != bits {
+		return 0, fmt.Errorf("invalid netmask %s", s)
+	}
+
+	n, b := net.IPMask(ip).Size()
+	if b == 0 {
+		return 0, fmt.Errorf("netmask %s is not contiguous, use a wildcard", s)
+	}
+	return n, nil
+}
+
+// ipMathParse parses a subnet, "10.0.0.0/24", "10.0.0.0 255.255.255.0" or
+// "2001:db8::/32", a range, "10.0.0.1-10.0.0.9", or an address, to its block.
+func ipMathParse(s string) (ipBlock, error) {
+	s = strings.TrimSpace(s)
+
+	if i := strings.Index(s, "-"); i >= 0 {
+		lo, n, ok1 := ipMathParseIP(s[:i])
+		hi, m, ok2 := ipMathParseIP(s[i+1:])
+		if !ok1 || !ok2 || n != m || lo.Cmp(hi) > 0 {
+			return ipBlock{}, fmt.Errorf("invalid range %q", s)
+		}
+		return ipBlock{Lo: lo, Hi: hi, Bits: n}, nil
+	}
+
+	f := strings.Fields(strings.Replace(s, "/", " ", 1))
+	if len(f) == 0 || len(f) > 2 {
+		return ipBlock{}, fmt.Errorf("invalid subnet %q", s)
+	}
+
+	ip, n, ok := ipMathParseIP(f[0])
+	if !ok {
+		return ipBlock{}, fmt.Errorf("invalid address %q", f[0])
+	}
+
+	prefix := n
+	if len(f) == 2 {
+		var err error
+		if prefix, err = ipMathPrefix(f[1], n); err != nil {
+			return ipBlock{}, fmt.Errorf("invalid subnet %q: %v", s, err)
+		}
+	}
+
+	mask := ipMathMask(prefix, n)
+	lo := new(big.Int).And(ip, mask)
+	hi := new(big.Int).Or(lo, new(big.Int).Xor(mask, ipMathMask(n, n)))
+
+	return ipBlock{Lo: lo, Hi: hi, Bits: n}, nil
+}
+
+// cidrs returns the fewest CIDR blocks covering the addresses of b.
+func (b ipBlock) cidrs() []string {
+	var res []string
+
+	one := big.NewInt(1)
+	lo := new(big.Int).Set(b.Lo)
+	for lo.Cmp(b.Hi) <= 0 {
+		// widen the block while lo stays aligned on it and it stays within Hi
+		n := b.Bits
+		for n > 0 {
+			size := new(big.Int).Lsh(one, uint(b.Bits-n+1))
+			last := new(big.Int).Add(lo, size)
+			if new(big.Int).Mod(lo, size).Sign() != 0 || last.Sub(last, one).Cmp(b.Hi) > 0 {
+				break
+			}
+			n--
+		}
+		res = append(res, fmt.Sprintf("%s/%d", ipMathString(lo, b.Bits), n))
+		lo.Add(lo, new(big.Int).Lsh(one, uint(b.Bits-n)))
+	}
+
+	return res
+}
+
+// ipMathAggregate returns the blocks sorted, IPv4 first, with the overlapping and adjacent
+// ones merged.
+func ipMathAggregate(l []ipBlock) []ipBlock {
+	if len(l) == 0 {
+		return nil
+	}
+
+	l = append([]ipBlock{}, l...)
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Bits != l[j].Bits {
+			return l[i].Bits < l[j].Bits
+		}
+		return l[i].Lo.Cmp(l[j].Lo) < 0
+	})
+
+	res := []ipBlock{{Lo: l[0].Lo, Hi: l[0].Hi, Bits: l[0].Bits}}
+	for _, b := range l[1:] {
+		last := &res[len(res)-1]
+		if last.Bits == b.Bits && b.Lo.Cmp(new(big.Int).Add(last.Hi, big.NewInt(1))) <= 0 {
+			if b.Hi.Cmp(last.Hi) > 0 {
+				last.Hi = b.Hi
+			}
+			continue
+		}
+		res = append(res, ipBlock{Lo: b.Lo, Hi: b.Hi, Bits: b.Bits})
+	}
+
+	return res
+}
+
+// overlaps reports whether b and o have an address in common.
+func (b ipBlock) overlaps(o ipBlock) bool {
+	return b.Bits == o.Bits && b.Lo.Cmp(o.Hi) <= 0 && o.Lo.Cmp(b.Hi) <= 0
+}
+
+// ipMathWildcard expands an IPv4 address and wildcard mask, as firewall addresses of type
+// wildcard use them, "10.0.1.0 255.255.0.255", to the CIDR blocks it matches. The bits of
+// the address set in the mask must match, the others can be anything.
+func ipMathWildcard(s string) ([]string, error) {
+	f := strings.Fields(strings.Replace(s, "/", " ", 1))
+	if len(f) != 2 {
+		return nil, fmt.Errorf("invalid wildcard %q, expected an address and a mask", s)
+	}
+
+	ip, ok1 := analysisIPv4(f[0])
+	mask, ok2 := analysisIPv4(f[1])
+	if !ok1 || !ok2 || strings.Contains(s, ":") {
+		return nil, fmt.Errorf("invalid wildcard %q", s)
+	}
+
+	// the free bits below the lowest bit set in the mask make the CIDR blocks, the
+	// others are enumerated
+	host := bits.TrailingZeros32(mask)
+	free := ^mask &^ (uint32(1)<<uint(host) - 1)
+
+	count := 1 << uint(bits.OnesCount32(free))
+	if count > ipMathMaxWildcardCIDRs {
+		return nil, fmt.Errorf("wildcard %q matches %d CIDR blocks, more than %d", s, count, ipMathMaxWildcardCIDRs)
+	}
+
+	// enumerate the subsets of the free bits, in increasing order
+	var los []uint32
+	for sub := uint32(0); ; sub = (sub - free) & free {
+		los = append(los, ip&mask|sub)
+		if sub == free {
+			break
+		}
+	}
+
+	res := make([]string, 0, len(los))
+	for _, lo := range los {
+		res = append(res, fmt.Sprintf("%s/%d", ipv4String(lo), 32-host))
+	}
+	return res, nil
+}
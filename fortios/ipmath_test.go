@@ -0,0 +1,187 @@
+package fortios
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIPMathParse(t *testing.T) {
+	cases := []struct {
+		s      string
+		lo, hi string
+		bits   int
+		err    bool
+	}{
+		{s: "10.0.0.0/24", lo: "10.0.0.0", hi: "10.0.0.255", bits: 32},
+		{s: "10.0.0.9/24", lo: "10.0.0.0", hi: "10.0.0.255", bits: 32},
+		{s: "10.0.0.0 255.255.255.0", lo: "10.0.0.0", hi: "10.0.0.255", bits: 32},
+		{s: " 10.0.0.1 ", lo: "10.0.0.1", hi: "10.0.0.1", bits: 32},
+		{s: "10.0.0.1/32", lo: "10.0.0.1", hi: "10.0.0.1", bits: 32},
+		{s: "10.0.0.1 255.255.255.255", lo: "10.0.0.1", hi: "10.0.0.1", bits: 32},
+		{s: "0.0.0.0/0", lo: "0.0.0.0", hi: "255.255.255.255", bits: 32},
+		{s: "0.0.0.0 0.0.0.0", lo: "0.0.0.0", hi: "255.255.255.255", bits: 32},
+		{s: "10.0.0.1-10.0.0.9", lo: "10.0.0.1", hi: "10.0.0.9", bits: 32},
+		{s: "2001:db8::/32", lo: "2001:db8::", hi: "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", bits: 128},
+		{s: "2001:db8::1 ffff:ffff::", lo: "2001:db8::", hi: "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", bits: 128},
+		{s: "::", lo: "::", hi: "::", bits: 128},
+		{s: "::/0", lo: "::", hi: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", bits: 128},
+		{s: "::1/128", lo: "::1", hi: "::1", bits: 128},
+		{s: "2001:db8::1-2001:db8::ff", lo: "2001:db8::1", hi: "2001:db8::ff", bits: 128},
+		{s: "::ffff:10.0.0.1", lo: "::ffff:10.0.0.1", hi: "::ffff:10.0.0.1", bits: 128},
+		{s: "10.0.0.0/33", err: true},
+		{s: "10.0.0.0 0.0.255.255", err: true},
+		{s: "10.0.0.0 ffff::", err: true},
+		{s: "10.0.0.9-10.0.0.1", err: true},
+		{s: "10.0.0.1-2001:db8::1", err: true},
+		{s: "10.0.0", err: true},
+		{s: "", err: true},
+		{s: "10.0.0.0 255.255.255.0 x", err: true},
+	}
+
+	for _, c := range cases {
+		b, err := ipMathParse(c.s)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q: no error", c.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.s, err)
+			continue
+		}
+		lo, hi := ipMathString(b.Lo, b.Bits), ipMathString(b.Hi, b.Bits)
+		if lo != c.lo || hi != c.hi || b.Bits != c.bits {
+			t.Errorf("%q: got %s-%s of %d bits, want %s-%s of %d bits", c.s, lo, hi, b.Bits, c.lo, c.hi, c.bits)
+		}
+	}
+}
+
+func TestIPBlockCIDRs(t *testing.T) {
+	cases := []struct {
+		s    string
+		want string
+	}{
+		{"10.0.0.1", "10.0.0.1/32"},
+		{"10.0.0.0/24", "10.0.0.0/24"},
+		{"10.0.0.1-10.0.0.6", "10.0.0.1/32,10.0.0.2/31,10.0.0.4/31,10.0.0.6/32"},
+		{"10.0.0.0-10.0.2.255", "10.0.0.0/23,10.0.2.0/24"},
+		{"0.0.0.0/0", "0.0.0.0/0"},
+		{"255.255.255.255", "255.255.255.255/32"},
+		{"::", "::/128"},
+		{"::/0", "::/0"},
+		{"::ffff:10.0.0.0/120", "::ffff:10.0.0.0/120"},
+		{"2001:db8::1-2001:db8::4", "2001:db8::1/128,2001:db8::2/127,2001:db8::4/128"},
+		{"2001:db8::/48", "2001:db8::/48"},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe-ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe/127"},
+	}
+
+	for _, c := range cases {
+		b, err := ipMathParse(c.s)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.s, err)
+			continue
+		}
+		if got := strings.Join(b.cidrs(), ","); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.s, got, c.want)
+		}
+	}
+}
+
+func TestIPMathAggregate(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"10.0.0.0/24", "10.0.0.0/24"},
+		{"10.0.1.0/24,10.0.0.0/24", "10.0.0.0/23"},
+		{"10.0.0.0/24,10.0.0.128/25", "10.0.0.0/24"},
+		{"10.0.0.0/24,10.0.2.0/24", "10.0.0.0/24,10.0.2.0/24"},
+		{"10.0.0.0-10.0.0.9,10.0.0.10-10.0.0.20", "10.0.0.0/28,10.0.0.16/30,10.0.0.20/32"},
+		{"2001:db8::/33,10.0.0.0/24,2001:db8:8000::/33", "10.0.0.0/24,2001:db8::/32"},
+		{"::/0,0.0.0.0/0", "0.0.0.0/0,::/0"},
+	}
+
+	for _, c := range cases {
+		var l []ipBlock
+		for _, s := range strings.Split(c.in, ",") {
+			if s == "" {
+				continue
+			}
+			b, err := ipMathParse(s)
+			if err != nil {
+				t.Fatalf("%s: %v", s, err)
+			}
+			l = append(l, b)
+		}
+
+		var got []string
+		for _, b := range ipMathAggregate(l) {
+			got = append(got, b.cidrs()...)
+		}
+		if strings.Join(got, ",") != c.want {
+			t.Errorf("%s: got %s, want %s", c.in, strings.Join(got, ","), c.want)
+		}
+	}
+}
+
+func TestIPBlockOverlaps(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"10.0.0.0/24", "10.0.0.128/25", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"10.0.0.0-10.0.0.10", "10.0.0.10-10.0.0.20", true},
+		{"0.0.0.0/0", "::/0", false},
+		{"::/0", "2001:db8::1", true},
+	}
+
+	for _, c := range cases {
+		a, err1 := ipMathParse(c.a)
+		b, err2 := ipMathParse(c.b)
+		if err1 != nil || err2 != nil {
+			t.Fatalf("%s, %s: %v, %v", c.a, c.b, err1, err2)
+		}
+		if got := a.overlaps(b); got != c.want {
+			t.Errorf("%s overlaps %s: got %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestIPMathWildcard(t *testing.T) {
+	cases := []struct {
+		s    string
+		want string
+		err  bool
+	}{
+		{s: "10.0.0.0 255.255.255.0", want: "10.0.0.0/24"},
+		{s: "10.0.1.7 255.255.252.255", want: "10.0.0.7/32,10.0.1.7/32,10.0.2.7/32,10.0.3.7/32"},
+		{s: "10.0.1.0 255.255.254.0", want: "10.0.0.0/23"},
+		{s: "10.1.0.5 255.254.255.255", want: "10.0.0.5/32,10.1.0.5/32"},
+		{s: "10.0.0.0 0.0.255.255", err: true},
+		{s: "10.0.1.0 255.254.255.0", want: "10.0.1.0/24,10.1.1.0/24"},
+		{s: "10.0.0.0 0.0.0.0", want: "0.0.0.0/0"},
+		{s: "10.0.0.1 255.255.255.255", want: "10.0.0.1/32"},
+		{s: "10.0.0.1", err: true},
+		{s: "2001:db8:: ffff::", err: true},
+	}
+
+	for _, c := range cases {
+		got, err := ipMathWildcard(c.s)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q: no error", c.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.s, err)
+			continue
+		}
+		if strings.Join(got, ",") != c.want {
+			t.Errorf("%q: got %s, want %s", c.s, strings.Join(got, ","), c.want)
+		}
+	}
+}
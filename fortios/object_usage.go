@@ -0,0 +1,309 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// objectReference is an attribute of a table entry or object referencing another object.
+type objectReference struct {
+	// Path is the CMDB path of the table or object, such as firewall/addrgrp
+	Path string
+	// Mkey is the key of the entry, "" for an object
+	Mkey string
+	// Attribute is the FortiOS name of the referencing attribute, such as member
+	Attribute string
+}
+
+func (r objectReference) String() string {
+	s := r.Path
+	if r.Mkey != "" {
+		s += " " + r.Mkey
+	}
+	if r.Attribute != "" {
+		s += " (" + r.Attribute + ")"
+	}
+	return s
+}
+
+// objectUsage returns the references to the entry mkey of the table at the CMDB path,
+// such as firewall/address, from the monitor object usage endpoint.
+func objectUsage(c *forticlient.FortiSDKClient, path, mkey, vdomparam string) ([]objectReference, error) {
+	path = strings.Trim(strings.TrimPrefix(path, "/api/v2/cmdb/"), "/")
+
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid path %s, expected a path such as firewall/address", path)
+	}
+
+	params := monitorParams(map[string]interface{}{
+		"q_path": path[:i],
+		"q_name": path[i+1:],
+		"mkey":   mkey,
+	}, "")
+
+	res, err := fortiGenericRequest(c, "GET", "/api/v2/monitor/system/object/usage", params, nil, vdomparam)
+	if err != nil {
+		return nil, err
+	}
+
+	results, _ := res["results"].(map[string]interface{})
+	l, _ := results["currently_using"].([]interface{})
+
+	refs := make([]objectReference, 0, len(l))
+	for _, e := range l {
+		u, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		r := objectReference{
+			Path:      cmdbObjectString(u["path"]),
+			Attribute: cmdbObjectString(u["attribute"]),
+		}
+		if n := cmdbObjectString(u["name"]); n != "" {
+			r.Path += "/" + n
+		}
+		if u["mkey"] != nil {
+			r.Mkey = importMkeyString(u["mkey"])
+		}
+		refs = append(refs, r)
+	}
+
+	return refs, nil
+}
+
+// objectUsageFailedDependency reports whether err is the error FortiOS returns when an
+// object still referenced is deleted.
+func objectUsageFailedDependency(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "(424)")
+}
+
+// objectUsageList lists the references one per line.
+func objectUsageList(refs []objectReference) string {
+	l := make([]string, 0, len(refs))
+	for _, r := range refs {
+		l = append(l, "  "+r.String())
+	}
+	return strings.Join(l, "\n")
+}
+
+// objectDestroyTables are the tables of the objects referenced by other objects, such as
+// addresses, services, schedules and profiles, whose resources have on_destroy_referenced.
+var objectDestroyTables = map[string]bool{
+	"antivirus/profile":                      true,
+	"application/list":                       true,
+	"cifs/profile":                           true,
+	"dlp/sensor":                             true,
+	"dnsfilter/profile":                      true,
+	"emailfilter/profile":                    true,
+	"file-filter/profile":                    true,
+	"firewall/address":                       true,
+	"firewall/address6":                      true,
+	"firewall/addrgrp":                       true,
+	"firewall/addrgrp6":                      true,
+	"firewall/internet-service-custom":       true,
+	"firewall/internet-service-custom-group": true,
+	"firewall/ippool":                        true,
+	"firewall/ippool6":                       true,
+	"firewall/multicast-address":             true,
+	"firewall/multicast-address6":            true,
+	"firewall/profile-group":                 true,
+	"firewall/profile-protocol-options":      true,
+	"firewall/proxy-address":                 true,
+	"firewall/proxy-addrgrp":                 true,
+	"firewall/ssl-ssh-profile":               true,
+	"firewall/vip":                           true,
+	"firewall/vip6":                          true,
+	"firewall/vipgrp":                        true,
+	"firewall/vipgrp6":                       true,
+	"firewall.schedule/group":                true,
+	"firewall.schedule/onetime":              true,
+	"firewall.schedule/recurring":            true,
+	"firewall.service/custom":                true,
+	"firewall.service/group":                 true,
+	"icap/profile":                           true,
+	"ips/sensor":                             true,
+	"sctp-filter/profile":                    true,
+	"spamfilter/profile":                     true,
+	"ssh-filter/profile":                     true,
+	"videofilter/profile":                    true,
+	"voip/profile":                           true,
+	"waf/profile":                            true,
+	"wanopt/profile":                         true,
+	"web-proxy/profile":                      true,
+	"webfilter/profile":                      true,
+}
+
+// objectDestroyRetryTimeout is how long on_destroy_referenced "retry" waits for the
+// references to be released.
+const objectDestroyRetryTimeout = 5 * time.Minute
+
+// objectDetachValue returns the attribute attr of the object obj without the entry mkey. Only
+// the member lists keeping other entries, such as the members of an address group, are
+// detached from. A single reference, such as the schedule or a profile of a policy, is not
+// reset to empty: FortiOS requires some, and the object would change meaning without others.
+func objectDetachValue(obj map[string]interface{}, attr, mkey string) (interface{}, error) {
+	switch t := obj[attr].(type) {
+	case []interface{}:
+		kept := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			if m, ok := e.(map[string]interface{}); ok && cmdbObjectString(m["name"]) == mkey {
+				continue
+			}
+			kept = append(kept, e)
+		}
+		if len(kept) == len(t) {
+			return nil, fmt.Errorf("%s is not in %s", mkey, attr)
+		}
+		if len(kept) == 0 {
+			return nil, fmt.Errorf("%s is the only entry of %s", mkey, attr)
+		}
+		return kept, nil
+	case string:
+		// Some member lists are names separated by spaces
+		names := strings.Fields(t)
+		kept := make([]string, 0, len(names))
+		for _, n := range names {
+			if n != mkey {
+				kept = append(kept, n)
+			}
+		}
+		if len(kept) == len(names) {
+			return nil, fmt.Errorf("%s is not in %s", mkey, attr)
+		}
+		if len(kept) == 0 {
+			return nil, fmt.Errorf("%s is a single reference, which is not detached", attr)
+		}
+		return strings.Join(kept, " "), nil
+	}
+	return nil, fmt.Errorf("%s is not a member list which can be detached from", attr)
+}
+
+// objectDetach removes the entry mkey from the attributes of the references. Nothing is
+// changed unless it can be removed from all of them.
+func objectDetach(c *forticlient.FortiSDKClient, refs []objectReference, mkey, vdomparam string) error {
+	type update struct {
+		ref   objectReference
+		value interface{}
+	}
+
+	var updates []update
+	var failed []string
+	for _, ref := range refs {
+		obj, err := cmdbObjectRead(c, ref.Path, ref.Mkey, vdomparam)
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %v", ref, err)
+		}
+		if obj == nil {
+			continue
+		}
+
+		v, err := objectDetachValue(obj, ref.Attribute, mkey)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("  %s: %v", ref, err))
+			continue
+		}
+		updates = append(updates, update{ref: ref, value: v})
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("it cannot be detached from:\n%s\nRemove these references first", strings.Join(failed, "\n"))
+	}
+
+	for _, u := range updates {
+		log.Printf("[INFO] Detaching %s from %s", mkey, u.ref)
+		if _, err := fortiGenericRequest(c, "PUT", cmdbObjectURL(u.ref.Path, u.ref.Mkey), "", map[string]interface{}{u.ref.Attribute: u.value}, vdomparam); err != nil {
+			return fmt.Errorf("cannot detach it from %s: %v", u.ref, err)
+		}
+	}
+
+	return nil
+}
+
+// setUsageHooks makes the delete of a table entry which is still referenced list the
+// references in the error, instead of the generic Failed Dependency error. The resources
+// of objectDestroyTables get on_destroy_referenced to detach the entry from the objects
+// referencing it, or to wait for them to be deleted, instead.
+func setUsageHooks(rtype string, r *schema.Resource) {
+	t, ok := cmdbTables[rtype]
+	if !ok || t.Mkey == "" || r.Delete == nil {
+		return
+	}
+
+	onDestroy := objectDestroyTables[strings.TrimPrefix(t.Path, "/api/v2/cmdb/")]
+	if onDestroy {
+		r.Schema["on_destroy_referenced"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"fail", "detach", "retry"}, false),
+		}
+	}
+
+	del := r.Delete
+
+	r.Delete = func(d *schema.ResourceData, m interface{}) error {
+		err := del(d, m)
+		if !objectUsageFailedDependency(err) {
+			return err
+		}
+
+		c := m.(*FortiClient).Client
+		if c == nil {
+			return err
+		}
+
+		vdomparam := ""
+
+		if v, ok := d.GetOk("vdomparam"); ok {
+			if s, ok := v.(string); ok {
+				vdomparam = s
+			}
+		}
+
+		mode := ""
+		if onDestroy {
+			mode = d.Get("on_destroy_referenced").(string)
+		}
+
+		switch mode {
+		case "retry":
+			log.Printf("[INFO] Waiting for the references to %s %s to be released", rtype, d.Id())
+			err = resource.Retry(objectDestroyRetryTimeout, func() *resource.RetryError {
+				err := del(d, m)
+				if objectUsageFailedDependency(err) {
+					return resource.RetryableError(err)
+				}
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+		case "detach":
+			refs, uerr := objectUsage(c, t.Path, d.Id(), vdomparam)
+			if uerr != nil {
+				return fmt.Errorf("Error deleting %s %s: cannot read its references: %v", rtype, d.Id(), uerr)
+			}
+			if derr := objectDetach(c, refs, d.Id(), vdomparam); derr != nil {
+				return fmt.Errorf("Error deleting %s %s: %v", rtype, d.Id(), derr)
+			}
+			err = del(d, m)
+		}
+		if !objectUsageFailedDependency(err) {
+			return err
+		}
+
+		refs, uerr := objectUsage(c, t.Path, d.Id(), vdomparam)
+		if uerr != nil || len(refs) == 0 {
+			return err
+		}
+
+		return fmt.Errorf("Error deleting %s %s: it is still referenced by:\n%s", rtype, d.Id(), objectUsageList(refs))
+	}
+}
@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestObjectDetach(t *testing.T) {
+	objects := map[string]interface{}{
+		"/api/v2/cmdb/firewall/addrgrp/grp": map[string]interface{}{
+			"name":   "grp",
+			"member": testAnalysisList("a", "b"),
+		},
+		"/api/v2/cmdb/firewall/addrgrp/single": map[string]interface{}{
+			"name":   "single",
+			"member": testAnalysisList("a"),
+		},
+		"/api/v2/cmdb/firewall/policy/1": map[string]interface{}{
+			"policyid":   1.0,
+			"av-profile": "a",
+			"users":      "a b",
+			"status":     1.0,
+		},
+	}
+
+	var puts []string
+	m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		o, ok := objects[path]
+		if !ok {
+			return http.StatusNotFound, nil
+		}
+		if method == "PUT" {
+			puts = append(puts, fmt.Sprintf("%s %v", path, body))
+			return http.StatusOK, nil
+		}
+		return http.StatusOK, []interface{}{o}
+	})
+
+	grp := objectReference{Path: "firewall/addrgrp", Mkey: "grp", Attribute: "member"}
+	users := objectReference{Path: "firewall/policy", Mkey: "1", Attribute: "users"}
+
+	cases := []struct {
+		refs []objectReference
+		want []string
+		err  string
+	}{
+		{
+			refs: []objectReference{grp, users},
+			want: []string{
+				fmt.Sprintf("/api/v2/cmdb/firewall/addrgrp/grp %v", map[string]interface{}{"member": testAnalysisList("b")}),
+				"/api/v2/cmdb/firewall/policy/1 map[users:b]",
+			},
+		},
+		{refs: []objectReference{{Path: "firewall/addrgrp", Mkey: "missing", Attribute: "member"}}},
+		// nothing is changed when one of the references cannot be detached
+		{
+			refs: []objectReference{grp, {Path: "firewall/policy", Mkey: "1", Attribute: "av-profile"}},
+			err:  "av-profile is a single reference",
+		},
+		{
+			refs: []objectReference{grp, {Path: "firewall/addrgrp", Mkey: "single", Attribute: "member"}},
+			err:  "a is the only entry of member",
+		},
+		{
+			refs: []objectReference{{Path: "firewall/policy", Mkey: "1", Attribute: "comments"}},
+			err:  "comments is not a member list",
+		},
+		{
+			refs: []objectReference{{Path: "firewall/policy", Mkey: "1", Attribute: "status"}},
+			err:  "status is not a member list",
+		},
+	}
+
+	for _, c := range cases {
+		puts = nil
+		err := objectDetach(m.Client, c.refs, "a", "")
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%v: got error %v, want %q", c.refs, err, c.err)
+			}
+			if puts != nil {
+				t.Errorf("%v: updated %q", c.refs, puts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", c.refs, err)
+			continue
+		}
+		if !reflect.DeepEqual(puts, c.want) {
+			t.Errorf("%v: got updates %q, want %q", c.refs, puts, c.want)
+		}
+	}
+}
@@ -0,0 +1,597 @@
+package fortios
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	analysisMaxIP   = ^uint32(0)
+	analysisMaxPort = 65535
+	analysisMaxProt = 255
+)
+
+// analysisObjects holds the address and service objects of a vdom by name.
+type analysisObjects struct {
+	addresses map[string]map[string]interface{}
+	addrgrps  map[string]map[string]interface{}
+	services  map[string]map[string]interface{}
+	svcgrps   map[string]map[string]interface{}
+}
+
+// analysisServices is a set of flows as the ports matched for each IP protocol. ICMP
+// types are the ports of ICMP and ICMP6.
+type analysisServices map[int]valueRanges
+
+// analysisIntfs is a set of interfaces, or any interface.
+type analysisIntfs struct {
+	any   bool
+	names map[string]bool
+}
+
+// analysisPolicy is the traffic a policy matches, as far as it can be resolved.
+type analysisPolicy struct {
+	ID      int
+	Action  string
+	SrcIntf analysisIntfs
+	DstIntf analysisIntfs
+	Src     valueRanges
+	Dst     valueRanges
+	Service analysisServices
+	// Narrowed is set if the policy matches less than Src, Dst and Service, because of
+	// users, schedules or source ports for example.
+	Narrowed bool
+	// Reason is why the traffic of the policy cannot be resolved, "" if it is.
+	Reason string
+}
+
+// analysisFinding relates a policy to another one.
+type analysisFinding struct {
+	ID      int
+	Related int
+}
+
+// analysisResult is the result of analysisRun.
+type analysisResult struct {
+	Shadowed   []analysisFinding
+	Redundant  []analysisFinding
+	Conflicts  []analysisFinding
+	Unresolved map[int]string
+}
+
+func analysisByName(l []interface{}) map[string]map[string]interface{} {
+	res := make(map[string]map[string]interface{}, len(l))
+	for _, e := range l {
+		if m, ok := e.(map[string]interface{}); ok {
+			res[cmdbObjectString(m["name"])] = m
+		}
+	}
+	return res
+}
+
+// analysisNames returns the names of the entries of a table of an object, such as srcaddr.
+func analysisNames(v interface{}) []string {
+	l, _ := v.([]interface{})
+
+	res := make([]string, 0, len(l))
+	for _, e := range l {
+		if m, ok := e.(map[string]interface{}); ok {
+			res = append(res, cmdbObjectString(m["name"]))
+		}
+	}
+	return res
+}
+
+func analysisIPv4(s string) (uint32, bool) {
+	ip := net.ParseIP(strings.TrimSpace(s)).To4()
+	if ip == nil {
+		return 0, false
+	}
+	return uint32(ip[0])<<24 | uint32(ip[1])<<16 | uint32(ip[2])<<8 | uint32(ip[3]), true
+}
+
+// analysisSubnet converts a subnet as FortiOS returns it, "10.0.0.0 255.255.255.0", or in
+// CIDR notation, to its range of addresses.
+func analysisSubnet(s string) (valueRange, bool) {
+	f := strings.Fields(strings.Replace(s, "/", " ", 1))
+	if len(f) != 2 {
+		return valueRange{}, false
+	}
+
+	ip, ok := analysisIPv4(f[0])
+	if !ok {
+		return valueRange{}, false
+	}
+
+	var mask uint32
+	if n, err := strconv.Atoi(f[1]); err == nil {
+		if n < 0 || n > 32 {
+			return valueRange{}, false
+		}
+		mask = ^uint32(0) << uint(32-n)
+		if n == 0 {
+			mask = 0
+		}
+	} else if mask, ok = analysisIPv4(f[1]); !ok {
+		return valueRange{}, false
+	}
+
+	return valueRange{Lo: ip & mask, Hi: ip | ^mask}, true
+}
+
+// analysisUnresolved is an address which cannot be resolved to IPv4 ranges.
+type analysisUnresolved struct {
+	Name string
+	// Type is the type of the address, such as fqdn, or why it cannot be resolved
+	Type string
+}
+
+func (u analysisUnresolved) Error() string {
+	switch u.Type {
+	case "not found":
+		return fmt.Sprintf("address %s not found", u.Name)
+	case "loop":
+		return fmt.Sprintf("address group %s contains itself", u.Name)
+	case "invalid subnet", "invalid range":
+		return fmt.Sprintf("address %s has an %s", u.Name, u.Type)
+	}
+	return fmt.Sprintf("address %s of type %s cannot be resolved", u.Name, u.Type)
+}
+
+// address resolves the address or address group name to its range of IPv4 addresses.
+func (o *analysisObjects) address(name string, seen map[string]bool) (valueRanges, error) {
+	var unresolved []analysisUnresolved
+
+	r := o.addressResolve(name, seen, &unresolved, nil)
+	if len(unresolved) > 0 {
+		return nil, unresolved[0]
+	}
+
+	return r, nil
+}
+
+// addressResolve resolves the address or address group name to the ranges of the IPv4
+// addresses it can resolve. The addresses it cannot resolve are added to unresolved, and
+// those it can to members if not nil. The members of an exclusion which cannot be
+// resolved are not excluded.
+func (o *analysisObjects) addressResolve(name string, seen map[string]bool, unresolved *[]analysisUnresolved, members map[string]bool) valueRanges {
+	if seen[name] {
+		*unresolved = append(*unresolved, analysisUnresolved{Name: name, Type: "loop"})
+		return nil
+	}
+
+	if a, ok := o.addresses[name]; ok {
+		var r valueRange
+		switch t := cmdbObjectString(a["type"]); t {
+		case "ipmask", "interface-subnet", "":
+			if r, ok = analysisSubnet(cmdbObjectString(a["subnet"])); !ok {
+				*unresolved = append(*unresolved, analysisUnresolved{Name: name, Type: "invalid subnet"})
+				return nil
+			}
+		case "iprange":
+			lo, ok1 := analysisIPv4(cmdbObjectString(a["start-ip"]))
+			hi, ok2 := analysisIPv4(cmdbObjectString(a["end-ip"]))
+			if !ok1 || !ok2 || lo > hi {
+				*unresolved = append(*unresolved, analysisUnresolved{Name: name, Type: "invalid range"})
+				return nil
+			}
+			r = valueRange{Lo: lo, Hi: hi}
+		default:
+			*unresolved = append(*unresolved, analysisUnresolved{Name: name, Type: t})
+			return nil
+		}
+
+		if members != nil {
+			members[name] = true
+		}
+		return valueRanges{r}
+	}
+
+	g, ok := o.addrgrps[name]
+	if !ok {
+		*unresolved = append(*unresolved, analysisUnresolved{Name: name, Type: "not found"})
+		return nil
+	}
+
+	seen[name] = true
+	defer delete(seen, name)
+
+	var res valueRanges
+	for _, m := range analysisNames(g["member"]) {
+		res = append(res, o.addressResolve(m, seen, unresolved, members)...)
+	}
+	res = res.normalize()
+
+	if cmdbObjectString(g["exclude"]) == "enable" {
+		var ex valueRanges
+		for _, m := range analysisNames(g["exclude-member"]) {
+			ex = append(ex, o.addressResolve(m, seen, unresolved, nil)...)
+		}
+		res = res.intersect(ex.normalize().complement(analysisMaxIP))
+	}
+
+	return res
+}
+
+// addressesOf resolves the addresses of a policy, negated if negate is "enable".
+func (o *analysisObjects) addressesOf(names []string, negate string) (valueRanges, error) {
+	var res valueRanges
+	for _, n := range names {
+		r, err := o.address(n, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r...)
+	}
+	res = res.normalize()
+
+	if negate == "enable" {
+		res = res.complement(analysisMaxIP)
+	}
+
+	return res, nil
+}
+
+// analysisPortRanges parses a port range of a custom service, "80 443 8000-8080:1024-65535",
+// to the destination ports. It also reports whether source ports are restricted.
+func analysisPortRanges(s string) (valueRanges, bool, error) {
+	var res valueRanges
+	narrowed := false
+
+	for _, f := range strings.Fields(s) {
+		dst := f
+		if i := strings.Index(f, ":"); i >= 0 {
+			dst = f[:i]
+			if src, err := analysisPortRange(f[i+1:]); err != nil {
+				return nil, false, err
+			} else if src.Lo > 1 || src.Hi < analysisMaxPort {
+				narrowed = true
+			}
+		}
+
+		r, err := analysisPortRange(dst)
+		if err != nil {
+			return nil, false, err
+		}
+		res = append(res, r)
+	}
+
+	return res.normalize(), narrowed, nil
+}
+
+func analysisPortRange(s string) (valueRange, error) {
+	lo, hi := s, s
+	if i := strings.Index(s, "-"); i >= 0 {
+		lo, hi = s[:i], s[i+1:]
+	}
+
+	l, err1 := strconv.ParseUint(lo, 10, 16)
+	h, err2 := strconv.ParseUint(hi, 10, 16)
+	if err1 != nil || err2 != nil || l > h {
+		return valueRange{}, fmt.Errorf("invalid port range %q", s)
+	}
+
+	return valueRange{Lo: uint32(l), Hi: uint32(h)}, nil
+}
+
+// add adds the ports r of protocol p to s.
+func (s analysisServices) add(p int, r valueRanges) {
+	s[p] = append(s[p], r...).normalize()
+}
+
+func (s analysisServices) addAll() {
+	for p := 0; p <= analysisMaxProt; p++ {
+		s[p] = valueRanges{{Lo: 0, Hi: analysisMaxPort}}
+	}
+}
+
+// service resolves the service or service group name. It also reports whether the
+// service matches less than the returned ports, because of its source ports or
+// destination addresses.
+func (o *analysisObjects) service(name string, res analysisServices, seen map[string]bool) (bool, error) {
+	if seen[name] {
+		return false, fmt.Errorf("service group %s contains itself", name)
+	}
+
+	if s, ok := o.services[name]; ok {
+		narrowed := cmdbObjectString(s["fqdn"]) != "" ||
+			!(cmdbObjectString(s["iprange"]) == "" || cmdbObjectString(s["iprange"]) == "0.0.0.0")
+
+		switch p := cmdbObjectString(s["protocol"]); p {
+		case "TCP/UDP/SCTP", "":
+			for k, proto := range map[string]int{"tcp-portrange": 6, "udp-portrange": 17, "sctp-portrange": 132} {
+				r, n, err := analysisPortRanges(cmdbObjectString(s[k]))
+				if err != nil {
+					return false, fmt.Errorf("service %s: %v", name, err)
+				}
+				res.add(proto, r)
+				narrowed = narrowed || n
+			}
+		case "ICMP", "ICMP6":
+			proto := 1
+			if p == "ICMP6" {
+				proto = 58
+			}
+			r := valueRanges{{Lo: 0, Hi: analysisMaxPort}}
+			if t, err := strconv.Atoi(cmdbObjectString(s["icmptype"])); err == nil && t >= 0 && t <= 255 {
+				r = valueRanges{{Lo: uint32(t), Hi: uint32(t)}}
+			}
+			if c, err := strconv.Atoi(cmdbObjectString(s["icmpcode"])); err == nil && c >= 0 && c <= 255 {
+				narrowed = true
+			}
+			res.add(proto, r)
+		case "IP", "ALL":
+			n, _ := strconv.Atoi(cmdbObjectString(s["protocol-number"]))
+			if n <= 0 || n > analysisMaxProt {
+				res.addAll()
+			} else {
+				res.add(n, valueRanges{{Lo: 0, Hi: analysisMaxPort}})
+			}
+		default:
+			return false, fmt.Errorf("service %s of protocol %s cannot be resolved", name, p)
+		}
+
+		return narrowed, nil
+	}
+
+	g, ok := o.svcgrps[name]
+	if !ok {
+		return false, fmt.Errorf("service %s not found", name)
+	}
+
+	seen[name] = true
+	defer delete(seen, name)
+
+	narrowed := false
+	for _, m := range analysisNames(g["member"]) {
+		n, err := o.service(m, res, seen)
+		if err != nil {
+			return false, err
+		}
+		narrowed = narrowed || n
+	}
+
+	return narrowed, nil
+}
+
+// servicesOf resolves the services of a policy, negated if negate is "enable".
+func (o *analysisObjects) servicesOf(names []string, negate string) (analysisServices, bool, error) {
+	res := make(analysisServices)
+	narrowed := false
+
+	for _, n := range names {
+		nw, err := o.service(n, res, map[string]bool{})
+		if err != nil {
+			return nil, false, err
+		}
+		narrowed = narrowed || nw
+	}
+
+	if negate == "enable" {
+		if narrowed {
+			return nil, false, fmt.Errorf("negated services restricted by source ports or addresses cannot be resolved")
+		}
+		neg := make(analysisServices)
+		for p := 0; p <= analysisMaxProt; p++ {
+			if r := res[p].complement(analysisMaxPort); len(r) > 0 {
+				neg[p] = r
+			}
+		}
+		res = neg
+	}
+
+	return res, narrowed, nil
+}
+
+func (s analysisServices) contains(o analysisServices) bool {
+	for p, r := range o {
+		if len(r) > 0 && !s[p].contains(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s analysisServices) intersects(o analysisServices) bool {
+	for p, r := range o {
+		if s[p].intersects(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func analysisIntfsOf(names []string) analysisIntfs {
+	res := analysisIntfs{names: make(map[string]bool, len(names))}
+	for _, n := range names {
+		if n == "any" {
+			res.any = true
+		}
+		res.names[n] = true
+	}
+	return res
+}
+
+func (s analysisIntfs) contains(o analysisIntfs) bool {
+	if s.any {
+		return true
+	}
+	if o.any {
+		return false
+	}
+	for n := range o.names {
+		if !s.names[n] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s analysisIntfs) intersects(o analysisIntfs) bool {
+	if s.any && len(o.names) > 0 || o.any && len(s.names) > 0 {
+		return true
+	}
+	for n := range o.names {
+		if s.names[n] {
+			return true
+		}
+	}
+	return false
+}
+
+// analysisNarrowingFields are the fields of a policy restricting its traffic beyond
+// interfaces, addresses and services, such as the users it applies to.
+var analysisNarrowingFields = []string{"users", "groups", "fsso-groups", "poolname", "src-vendor-mac"}
+
+// policy resolves the traffic matched by the firewall policy p.
+func (o *analysisObjects) policy(p map[string]interface{}) analysisPolicy {
+	res := analysisPolicy{
+		ID:      fortiIntValue(p["policyid"]),
+		Action:  cmdbObjectString(p["action"]),
+		SrcIntf: analysisIntfsOf(analysisNames(p["srcintf"])),
+		DstIntf: analysisIntfsOf(analysisNames(p["dstintf"])),
+	}
+
+	for _, f := range []string{"internet-service", "internet-service-src"} {
+		if cmdbObjectString(p[f]) == "enable" {
+			res.Reason = fmt.Sprintf("%s cannot be resolved", f)
+			return res
+		}
+	}
+
+	srcaddr, dstaddr := analysisNames(p["srcaddr"]), analysisNames(p["dstaddr"])
+	if len(srcaddr) == 0 || len(dstaddr) == 0 {
+		res.Reason = "the policy has no IPv4 addresses"
+		return res
+	}
+
+	var err error
+	if res.Src, err = o.addressesOf(srcaddr, cmdbObjectString(p["srcaddr-negate"])); err != nil {
+		res.Reason = err.Error()
+		return res
+	}
+	if res.Dst, err = o.addressesOf(dstaddr, cmdbObjectString(p["dstaddr-negate"])); err != nil {
+		res.Reason = err.Error()
+		return res
+	}
+	if res.Service, res.Narrowed, err = o.servicesOf(analysisNames(p["service"]), cmdbObjectString(p["service-negate"])); err != nil {
+		res.Reason = err.Error()
+		return res
+	}
+
+	if s := cmdbObjectString(p["schedule"]); s != "" && s != "always" {
+		res.Narrowed = true
+	}
+	for _, f := range analysisNarrowingFields {
+		if len(analysisNames(p[f])) > 0 {
+			res.Narrowed = true
+		}
+	}
+
+	return res
+}
+
+// contains reports whether the traffic of p includes all the traffic of q.
+func (p *analysisPolicy) contains(q *analysisPolicy) bool {
+	return p.SrcIntf.contains(q.SrcIntf) && p.DstIntf.contains(q.DstIntf) &&
+		p.Src.contains(q.Src) && p.Dst.contains(q.Dst) && p.Service.contains(q.Service)
+}
+
+// intersects reports whether p and q have traffic in common.
+func (p *analysisPolicy) intersects(q *analysisPolicy) bool {
+	return p.SrcIntf.intersects(q.SrcIntf) && p.DstIntf.intersects(q.DstIntf) &&
+		p.Src.intersects(q.Src) && p.Dst.intersects(q.Dst) && p.Service.intersects(q.Service)
+}
+
+// analysisRun compares the enabled policies pairwise, in the order they are evaluated:
+//
+//   - A policy is shadowed if an earlier policy with another action matches all its
+//     traffic, so that it never matches, and redundant if the earlier policy has the same
+//     action. A policy is also redundant if a later policy with the same action matches all
+//     its traffic and no policy in between with another action matches any of it.
+//   - Two policies conflict if they match some traffic in common with different actions,
+//     but neither matches all the traffic of the other.
+//
+// Policies matching less than their addresses and services, because of users or
+// schedules for example, are never considered to match all the traffic of another policy.
+// The union of several earlier policies covering a later one is not detected.
+func analysisRun(policies []analysisPolicy) analysisResult {
+	res := analysisResult{Unresolved: make(map[int]string)}
+	covered := make(map[int]bool)
+
+	for j := range policies {
+		q := &policies[j]
+		if q.Reason != "" {
+			res.Unresolved[q.ID] = q.Reason
+			continue
+		}
+
+		for i := 0; i < j; i++ {
+			p := &policies[i]
+			if p.Reason != "" || p.Narrowed || !p.contains(q) {
+				continue
+			}
+
+			if p.Action == q.Action {
+				res.Redundant = append(res.Redundant, analysisFinding{ID: q.ID, Related: p.ID})
+			} else {
+				res.Shadowed = append(res.Shadowed, analysisFinding{ID: q.ID, Related: p.ID})
+			}
+			covered[q.ID] = true
+			break
+		}
+		if covered[q.ID] || q.Narrowed {
+			continue
+		}
+
+		for i := 0; i < j; i++ {
+			p := &policies[i]
+			if p.Reason != "" || p.Narrowed || p.Action == q.Action {
+				continue
+			}
+			if p.intersects(q) && !q.contains(p) {
+				res.Conflicts = append(res.Conflicts, analysisFinding{ID: q.ID, Related: p.ID})
+			}
+		}
+	}
+
+	for i := range policies {
+		p := &policies[i]
+		if p.Reason != "" || covered[p.ID] {
+			continue
+		}
+
+		for j := i + 1; j < len(policies); j++ {
+			q := &policies[j]
+			// An unresolved policy may match the traffic of p with another action
+			if q.Reason != "" {
+				break
+			}
+
+			if q.Action != p.Action {
+				if q.intersects(p) {
+					break
+				}
+				continue
+			}
+
+			if !q.Narrowed && q.contains(p) {
+				res.Redundant = append(res.Redundant, analysisFinding{ID: p.ID, Related: q.ID})
+				break
+			}
+		}
+	}
+
+	index := make(map[int]int, len(policies))
+	for i, p := range policies {
+		index[p.ID] = i
+	}
+	sort.SliceStable(res.Redundant, func(i, j int) bool {
+		return index[res.Redundant[i].ID] < index[res.Redundant[j].ID]
+	})
+
+	return res
+}
@@ -0,0 +1,336 @@
+package fortios
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testAnalysisList(names ...string) []interface{} {
+	res := make([]interface{}, len(names))
+	for i, n := range names {
+		res[i] = map[string]interface{}{"name": n}
+	}
+	return res
+}
+
+func testAnalysisObjects() *analysisObjects {
+	return &analysisObjects{
+		addresses: analysisByName([]interface{}{
+			map[string]interface{}{"name": "all", "subnet": "0.0.0.0 0.0.0.0"},
+			map[string]interface{}{"name": "lan", "type": "ipmask", "subnet": "10.0.0.0 255.255.255.0"},
+			map[string]interface{}{"name": "lan-low", "type": "ipmask", "subnet": "10.0.0.0/25"},
+			map[string]interface{}{"name": "srv", "type": "iprange", "start-ip": "10.0.1.10", "end-ip": "10.0.1.20"},
+			map[string]interface{}{"name": "host", "type": "ipmask", "subnet": "10.0.1.15 255.255.255.255"},
+			map[string]interface{}{"name": "web", "type": "fqdn", "fqdn": "example.com"},
+			map[string]interface{}{"name": "bad", "type": "iprange", "start-ip": "10.0.0.9", "end-ip": "10.0.0.1"},
+		}),
+		addrgrps: analysisByName([]interface{}{
+			map[string]interface{}{"name": "servers", "member": testAnalysisList("srv", "host")},
+			map[string]interface{}{"name": "srv-but-host", "member": testAnalysisList("srv"), "exclude": "enable", "exclude-member": testAnalysisList("host")},
+			map[string]interface{}{"name": "loop", "member": testAnalysisList("loop2")},
+			map[string]interface{}{"name": "loop2", "member": testAnalysisList("loop")},
+		}),
+		services: analysisByName([]interface{}{
+			map[string]interface{}{"name": "ALL", "protocol": "IP", "protocol-number": 0.0},
+			map[string]interface{}{"name": "HTTP", "protocol": "TCP/UDP/SCTP", "tcp-portrange": "80"},
+			map[string]interface{}{"name": "HTTPS", "protocol": "TCP/UDP/SCTP", "tcp-portrange": "443"},
+			map[string]interface{}{"name": "WEB", "protocol": "TCP/UDP/SCTP", "tcp-portrange": "80-443"},
+			map[string]interface{}{"name": "DNS", "protocol": "TCP/UDP/SCTP", "tcp-portrange": "53", "udp-portrange": "53"},
+			map[string]interface{}{"name": "HIGH-SRC", "protocol": "TCP/UDP/SCTP", "tcp-portrange": "80:1024-65535"},
+			map[string]interface{}{"name": "PING", "protocol": "ICMP", "icmptype": 8.0},
+		}),
+		svcgrps: analysisByName([]interface{}{
+			map[string]interface{}{"name": "Web Access", "member": testAnalysisList("HTTP", "HTTPS")},
+		}),
+	}
+}
+
+func TestAnalysisSubnet(t *testing.T) {
+	cases := []struct {
+		s      string
+		lo, hi string
+		ok     bool
+	}{
+		{"10.0.0.0 255.255.255.0", "10.0.0.0", "10.0.0.255", true},
+		{"10.0.0.7 255.255.255.0", "10.0.0.0", "10.0.0.255", true},
+		{"10.0.0.0/16", "10.0.0.0", "10.0.255.255", true},
+		{"0.0.0.0 0.0.0.0", "0.0.0.0", "255.255.255.255", true},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255", true},
+		{"10.0.0.1/32", "10.0.0.1", "10.0.0.1", true},
+		{"10.0.0.0/33", "", "", false},
+		{"10.0.0.0", "", "", false},
+		{"::1/128", "", "", false},
+	}
+
+	for _, c := range cases {
+		r, ok := analysisSubnet(c.s)
+		if ok != c.ok {
+			t.Errorf("%s: got %v, want %v", c.s, ok, c.ok)
+			continue
+		}
+		if ok && (ipv4String(r.Lo) != c.lo || ipv4String(r.Hi) != c.hi) {
+			t.Errorf("%s: got %s-%s, want %s-%s", c.s, ipv4String(r.Lo), ipv4String(r.Hi), c.lo, c.hi)
+		}
+	}
+}
+
+func TestAnalysisPortRanges(t *testing.T) {
+	cases := []struct {
+		s        string
+		want     string
+		narrowed bool
+		err      bool
+	}{
+		{s: "", want: ""},
+		{s: "80", want: "80"},
+		{s: "443 80 8000-8080", want: "80,443,8000-8080"},
+		{s: "80-90 85-100", want: "80-100"},
+		{s: "80:1-65535", want: "80"},
+		{s: "80:0-65535", want: "80"},
+		{s: "80:1024-65535", want: "80", narrowed: true},
+		{s: "53:53", want: "53", narrowed: true},
+		{s: "90-80", err: true},
+		{s: "80:x", err: true},
+		{s: "70000", err: true},
+	}
+
+	for _, c := range cases {
+		r, narrowed, err := analysisPortRanges(c.s)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q: no error", c.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.s, err)
+			continue
+		}
+		if testRangesString(r) != c.want || narrowed != c.narrowed {
+			t.Errorf("%q: got %s, %v, want %s, %v", c.s, testRangesString(r), narrowed, c.want, c.narrowed)
+		}
+	}
+}
+
+func TestAnalysisAddresses(t *testing.T) {
+	o := testAnalysisObjects()
+
+	cases := []struct {
+		names  []string
+		negate string
+		want   string
+		err    string
+	}{
+		{names: []string{"lan"}, want: "167772160-167772415"},
+		{names: []string{"lan", "lan-low"}, want: "167772160-167772415"},
+		{names: []string{"servers"}, want: "167772426-167772436"},
+		{names: []string{"srv-but-host"}, want: "167772426-167772430,167772432-167772436"},
+		{names: []string{"all"}, negate: "enable", want: ""},
+		{names: []string{"lan"}, negate: "enable", want: "0-167772159,167772416-4294967295"},
+		{names: []string{"web"}, err: "address web of type fqdn cannot be resolved"},
+		{names: []string{"bad"}, err: "address bad has an invalid range"},
+		{names: []string{"missing"}, err: "address missing not found"},
+		{names: []string{"loop"}, err: "address group loop contains itself"},
+	}
+
+	for _, c := range cases {
+		r, err := o.addressesOf(c.names, c.negate)
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%v: got error %v, want %q", c.names, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", c.names, err)
+			continue
+		}
+		if got := testRangesString(r); got != c.want {
+			t.Errorf("%v %s: got %s, want %s", c.names, c.negate, got, c.want)
+		}
+	}
+}
+
+func TestAnalysisServices(t *testing.T) {
+	o := testAnalysisObjects()
+
+	cases := []struct {
+		names    []string
+		negate   string
+		want     map[int]string
+		narrowed bool
+		err      bool
+	}{
+		{names: []string{"HTTP"}, want: map[int]string{6: "80"}},
+		{names: []string{"Web Access"}, want: map[int]string{6: "80,443"}},
+		{names: []string{"DNS", "HTTP"}, want: map[int]string{6: "53,80", 17: "53"}},
+		{names: []string{"PING"}, want: map[int]string{1: "8"}},
+		{names: []string{"HIGH-SRC"}, want: map[int]string{6: "80"}, narrowed: true},
+		{names: []string{"HIGH-SRC"}, negate: "enable", err: true},
+		{names: []string{"missing"}, err: true},
+	}
+
+	for _, c := range cases {
+		s, narrowed, err := o.servicesOf(c.names, c.negate)
+		if c.err {
+			if err == nil {
+				t.Errorf("%v: no error", c.names)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", c.names, err)
+			continue
+		}
+
+		got := make(map[int]string)
+		for p, r := range s {
+			if len(r) > 0 {
+				got[p] = testRangesString(r)
+			}
+		}
+		if !reflect.DeepEqual(got, c.want) || narrowed != c.narrowed {
+			t.Errorf("%v: got %v, %v, want %v, %v", c.names, got, narrowed, c.want, c.narrowed)
+		}
+	}
+
+	all, _, err := o.servicesOf([]string{"ALL"}, "")
+	if err != nil || !all.contains(analysisServices{6: testRanges(t, "0-65535"), 132: testRanges(t, "1")}) {
+		t.Errorf("ALL: got %v, %v, want every protocol", all, err)
+	}
+
+	neg, _, err := o.servicesOf([]string{"HTTP"}, "enable")
+	if err != nil || neg.intersects(analysisServices{6: testRanges(t, "80")}) || !neg.contains(analysisServices{6: testRanges(t, "81"), 17: testRanges(t, "80")}) {
+		t.Errorf("negated HTTP: got %v, %v", neg, err)
+	}
+}
+
+func testAnalysisPolicy(id int, action, src, dst, service string, extra map[string]interface{}) map[string]interface{} {
+	p := map[string]interface{}{
+		"policyid": float64(id),
+		"action":   action,
+		"srcintf":  testAnalysisList("port1"),
+		"dstintf":  testAnalysisList("port2"),
+		"srcaddr":  testAnalysisList(src),
+		"dstaddr":  testAnalysisList(dst),
+		"service":  testAnalysisList(service),
+		"schedule": "always",
+	}
+	for k, v := range extra {
+		p[k] = v
+	}
+	return p
+}
+
+func TestAnalysisRun(t *testing.T) {
+	o := testAnalysisObjects()
+
+	cases := []struct {
+		name       string
+		policies   []map[string]interface{}
+		shadowed   []analysisFinding
+		redundant  []analysisFinding
+		conflicts  []analysisFinding
+		unresolved []int
+	}{
+		{
+			name: "shadowed",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "deny", "lan", "all", "ALL", nil),
+				testAnalysisPolicy(2, "accept", "lan-low", "servers", "HTTP", nil),
+			},
+			shadowed: []analysisFinding{{ID: 2, Related: 1}},
+		},
+		{
+			name: "redundant with an earlier policy",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "accept", "lan", "servers", "Web Access", nil),
+				testAnalysisPolicy(2, "accept", "lan-low", "srv", "HTTPS", nil),
+			},
+			redundant: []analysisFinding{{ID: 2, Related: 1}},
+		},
+		{
+			name: "redundant with a later policy",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "accept", "lan-low", "host", "HTTP", nil),
+				testAnalysisPolicy(2, "deny", "lan", "srv", "DNS", nil),
+				testAnalysisPolicy(3, "accept", "lan", "servers", "WEB", nil),
+			},
+			redundant: []analysisFinding{{ID: 1, Related: 3}},
+		},
+		{
+			name: "not redundant past a policy with another action",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "accept", "lan-low", "host", "HTTP", nil),
+				testAnalysisPolicy(2, "deny", "lan", "srv", "WEB", nil),
+				testAnalysisPolicy(3, "accept", "lan", "servers", "WEB", nil),
+			},
+			shadowed: []analysisFinding{{ID: 3, Related: 2}},
+		},
+		{
+			name: "conflict",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "accept", "lan-low", "servers", "WEB", nil),
+				testAnalysisPolicy(2, "deny", "lan", "servers", "HTTP", nil),
+			},
+			conflicts: []analysisFinding{{ID: 2, Related: 1}},
+		},
+		{
+			name: "narrowed by users",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "deny", "lan", "all", "ALL", map[string]interface{}{"users": testAnalysisList("bob")}),
+				testAnalysisPolicy(2, "accept", "lan-low", "servers", "HTTP", nil),
+			},
+		},
+		{
+			name: "other interfaces",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "deny", "lan", "all", "ALL", map[string]interface{}{"dstintf": testAnalysisList("port3")}),
+				testAnalysisPolicy(2, "accept", "lan-low", "servers", "HTTP", nil),
+			},
+		},
+		{
+			name: "any interface",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "deny", "lan", "all", "ALL", map[string]interface{}{"dstintf": testAnalysisList("any")}),
+				testAnalysisPolicy(2, "accept", "lan-low", "servers", "HTTP", nil),
+			},
+			shadowed: []analysisFinding{{ID: 2, Related: 1}},
+		},
+		{
+			name: "unresolved",
+			policies: []map[string]interface{}{
+				testAnalysisPolicy(1, "deny", "lan", "web", "ALL", nil),
+				testAnalysisPolicy(2, "accept", "lan", "all", "HTTP", map[string]interface{}{"internet-service": "enable"}),
+				testAnalysisPolicy(3, "accept", "lan-low", "srv", "HTTP", nil),
+			},
+			unresolved: []int{1, 2},
+		},
+	}
+
+	for _, c := range cases {
+		var policies []analysisPolicy
+		for _, p := range c.policies {
+			policies = append(policies, o.policy(p))
+		}
+
+		res := analysisRun(policies)
+		if !reflect.DeepEqual(res.Shadowed, c.shadowed) {
+			t.Errorf("%s: got shadowed %v, want %v", c.name, res.Shadowed, c.shadowed)
+		}
+		if !reflect.DeepEqual(res.Redundant, c.redundant) {
+			t.Errorf("%s: got redundant %v, want %v", c.name, res.Redundant, c.redundant)
+		}
+		if !reflect.DeepEqual(res.Conflicts, c.conflicts) {
+			t.Errorf("%s: got conflicts %v, want %v", c.name, res.Conflicts, c.conflicts)
+		}
+		if len(res.Unresolved) != len(c.unresolved) {
+			t.Errorf("%s: got unresolved %v, want %v", c.name, res.Unresolved, c.unresolved)
+		}
+		for _, id := range c.unresolved {
+			if res.Unresolved[id] == "" {
+				t.Errorf("%s: policy %d is not unresolved", c.name, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var positionKeys = []string{"position.0.top", "position.0.bottom", "position.0.before", "position.0.after"}
+
+func positionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"top": &schema.Schema{
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: positionKeys,
+				},
+				"bottom": &schema.Schema{
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: positionKeys,
+				},
+				"before": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: positionKeys,
+				},
+				"after": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ExactlyOneOf: positionKeys,
+				},
+				"status": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+			},
+		},
+	}
+}
+
+// positionOf returns the position block of the resource, nil if it is not set.
+func positionOf(d *schema.ResourceData) map[string]interface{} {
+	l, ok := d.Get("position").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	p, _ := l[0].(map[string]interface{})
+	return p
+}
+
+// positionMove returns the move putting the entry id in position p in current, nil if it
+// is there already.
+func positionMove(current []string, id string, p map[string]interface{}) (*orderMove, error) {
+	i := orderIndex(current, id)
+	if i < 0 {
+		return nil, fmt.Errorf("%s not found", id)
+	}
+
+	var mv orderMove
+	switch {
+	case p["top"] == true:
+		if i == 0 {
+			return nil, nil
+		}
+		mv = orderMove{ID: id, Where: "before", Dst: current[0]}
+	case p["bottom"] == true:
+		if i == len(current)-1 {
+			return nil, nil
+		}
+		mv = orderMove{ID: id, Where: "after", Dst: current[len(current)-1]}
+	case p["before"] != "":
+		mv = orderMove{ID: id, Where: "before", Dst: p["before"].(string)}
+	case p["after"] != "":
+		mv = orderMove{ID: id, Where: "after", Dst: p["after"].(string)}
+	default:
+		return nil, fmt.Errorf("position needs one of top, bottom, before or after")
+	}
+
+	if mv.Dst == id {
+		return nil, fmt.Errorf("%s cannot be moved %s itself", id, mv.Where)
+	}
+
+	j := orderIndex(current, mv.Dst)
+	if j < 0 {
+		return nil, fmt.Errorf("%s not found", mv.Dst)
+	}
+	if mv.Where == "before" && i == j-1 || mv.Where == "after" && i == j+1 {
+		return nil, nil
+	}
+
+	return &mv, nil
+}
+
+// positionApply moves the entry of the resource to its position.
+func positionApply(c *forticlient.FortiSDKClient, t orderTable, d *schema.ResourceData, vdomparam string) error {
+	p := positionOf(d)
+	if p == nil {
+		return nil
+	}
+
+	current, err := orderRead(c, t, vdomparam)
+	if err != nil {
+		return err
+	}
+
+	mv, err := positionMove(current, d.Id(), p)
+	if err != nil || mv == nil {
+		return err
+	}
+
+	log.Printf("[INFO] Moving %s %s %s %s", t.Name, mv.ID, mv.Where, mv.Dst)
+	return orderApply(c, t, *mv, vdomparam)
+}
+
+// orderCache memoizes the order of the tables, so that refreshing the position blocks of
+// all the policies of a table reads the table once rather than once per policy.
+type orderCache struct {
+	mu     sync.Mutex
+	tables map[string]*orderCacheEntry
+}
+
+type orderCacheEntry struct {
+	once sync.Once
+	ids  []string
+	err  error
+}
+
+func orderCacheKey(t orderTable, vdomparam string) string {
+	return vdomparam + "/" + t.Path
+}
+
+// read returns the order of the table, reading it only if it is not known yet. The entries
+// being refreshed concurrently wait for the same read.
+func (oc *orderCache) read(c *forticlient.FortiSDKClient, t orderTable, vdomparam string) ([]string, error) {
+	k := orderCacheKey(t, vdomparam)
+
+	oc.mu.Lock()
+	if oc.tables == nil {
+		oc.tables = make(map[string]*orderCacheEntry)
+	}
+	e := oc.tables[k]
+	if e == nil {
+		e = &orderCacheEntry{}
+		oc.tables[k] = e
+	}
+	oc.mu.Unlock()
+
+	e.once.Do(func() {
+		e.ids, e.err = orderRead(c, t, vdomparam)
+	})
+
+	// A failed read is not kept, the next refresh reads the table again
+	if e.err != nil {
+		oc.mu.Lock()
+		if oc.tables[k] == e {
+			delete(oc.tables, k)
+		}
+		oc.mu.Unlock()
+	}
+	return e.ids, e.err
+}
+
+// forget drops the order of the table, changed by a move, a new or a deleted entry.
+func (oc *orderCache) forget(t orderTable, vdomparam string) {
+	oc.mu.Lock()
+	delete(oc.tables, orderCacheKey(t, vdomparam))
+	oc.mu.Unlock()
+}
+
+// orderForget drops the order of the table known by the provider m.
+func orderForget(m interface{}, t orderTable, vdomparam string) {
+	if fc, ok := m.(*FortiClient); ok {
+		fc.orders.forget(t, vdomparam)
+	}
+}
+
+// positionRead sets the status of the position block to "moved" if the entry of the
+// resource is no longer in its position. The order of the table is read again unless
+// cached is set, for the refresh, when the order known by the provider is used.
+func positionRead(m interface{}, c *forticlient.FortiSDKClient, t orderTable, d *schema.ResourceData, vdomparam string, cached bool) error {
+	p := positionOf(d)
+	if p == nil {
+		return nil
+	}
+
+	var current []string
+	var err error
+	if cached {
+		current, err = m.(*FortiClient).orders.read(c, t, vdomparam)
+	} else {
+		orderForget(m, t, vdomparam)
+		current, err = orderRead(c, t, vdomparam)
+	}
+	if err != nil {
+		return err
+	}
+
+	status := ""
+	if mv, err := positionMove(current, d.Id(), p); err != nil || mv != nil {
+		status = "moved"
+	}
+	p["status"] = status
+
+	return d.Set("position", []interface{}{p})
+}
+
+func positionClient(d *schema.ResourceData, m interface{}) (*forticlient.FortiSDKClient, string) {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return nil, ""
+	}
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	return c, vdomparam
+}
+
+// setPositionHooks adds the position block to the resource of the entries of an ordered
+// table, moving a new entry to its position right after it is created and reporting
+// when it is no longer there on refresh.
+func setPositionHooks(rtype string, r *schema.Resource) {
+	if _, ok := orderTableNames[rtype]; !ok || r.Create == nil || r.Read == nil {
+		return
+	}
+
+	t := orderTableOf(rtype)
+	r.Schema["position"] = positionSchema()
+
+	create, read, update, del := r.Create, r.Read, r.Update, r.Delete
+
+	r.Create = func(d *schema.ResourceData, m interface{}) error {
+		err := create(d, m)
+		_, vdomparam := positionClient(d, m)
+		orderForget(m, t, vdomparam)
+		if err != nil || d.Id() == "" {
+			return err
+		}
+
+		c, vdomparam := positionClient(d, m)
+		if c == nil {
+			return fmt.Errorf("FortiOS connection did not initialize successfully!")
+		}
+		if err := positionApply(c, t, d, vdomparam); err != nil {
+			return fmt.Errorf("Error moving %s %s to its position: %v", t.Name, d.Id(), err)
+		}
+
+		return positionRead(m, c, t, d, vdomparam, false)
+	}
+
+	if del != nil {
+		r.Delete = func(d *schema.ResourceData, m interface{}) error {
+			_, vdomparam := positionClient(d, m)
+			orderForget(m, t, vdomparam)
+			return del(d, m)
+		}
+	}
+
+	r.Read = func(d *schema.ResourceData, m interface{}) error {
+		if err := read(d, m); err != nil || d.Id() == "" {
+			return err
+		}
+
+		c, vdomparam := positionClient(d, m)
+		if c == nil {
+			return fmt.Errorf("FortiOS connection did not initialize successfully!")
+		}
+		if err := positionRead(m, c, t, d, vdomparam, true); err != nil {
+			return fmt.Errorf("Error reading position of %s %s: %v", t.Name, d.Id(), err)
+		}
+
+		return nil
+	}
+
+	if update == nil {
+		return
+	}
+
+	r.Update = func(d *schema.ResourceData, m interface{}) error {
+		if err := update(d, m); err != nil || d.Id() == "" {
+			return err
+		}
+
+		c, vdomparam := positionClient(d, m)
+		if c == nil {
+			return fmt.Errorf("FortiOS connection did not initialize successfully!")
+		}
+		if d.HasChange("position") {
+			if err := positionApply(c, t, d, vdomparam); err != nil {
+				return fmt.Errorf("Error moving %s %s to its position: %v", t.Name, d.Id(), err)
+			}
+		}
+
+		return positionRead(m, c, t, d, vdomparam, false)
+	}
+}
@@ -0,0 +1,96 @@
+package fortios
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func TestPositionMove(t *testing.T) {
+	current := []string{"1", "2", "3", "4"}
+
+	cases := []struct {
+		id    string
+		p     map[string]interface{}
+		moved *orderMove
+		err   bool
+	}{
+		{id: "1", p: map[string]interface{}{"top": true}},
+		{id: "3", p: map[string]interface{}{"top": true}, moved: &orderMove{ID: "3", Where: "before", Dst: "1"}},
+		{id: "4", p: map[string]interface{}{"bottom": true}},
+		{id: "1", p: map[string]interface{}{"bottom": true}, moved: &orderMove{ID: "1", Where: "after", Dst: "4"}},
+		{id: "2", p: map[string]interface{}{"before": "3"}},
+		{id: "4", p: map[string]interface{}{"before": "2"}, moved: &orderMove{ID: "4", Where: "before", Dst: "2"}},
+		{id: "3", p: map[string]interface{}{"after": "2"}},
+		{id: "1", p: map[string]interface{}{"after": "3"}, moved: &orderMove{ID: "1", Where: "after", Dst: "3"}},
+		{id: "2", p: map[string]interface{}{"after": "2"}, err: true},
+		{id: "2", p: map[string]interface{}{"after": "9"}, err: true},
+		{id: "9", p: map[string]interface{}{"top": true}, err: true},
+	}
+
+	for _, c := range cases {
+		for _, k := range []string{"before", "after"} {
+			if _, ok := c.p[k]; !ok {
+				c.p[k] = ""
+			}
+		}
+
+		mv, err := positionMove(current, c.id, c.p)
+		if c.err {
+			if err == nil {
+				t.Errorf("%s %v: no error", c.id, c.p)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: unexpected error %v", c.id, c.p, err)
+			continue
+		}
+		if (mv == nil) != (c.moved == nil) || mv != nil && *mv != *c.moved {
+			t.Errorf("%s %v: got move %+v, want %+v", c.id, c.p, mv, c.moved)
+		}
+	}
+}
+
+func TestOrderCache(t *testing.T) {
+	var mu sync.Mutex
+	reads := 0
+	m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		if path != "/api/v2/cmdb/firewall/policy" {
+			return http.StatusNotFound, nil
+		}
+		mu.Lock()
+		reads++
+		mu.Unlock()
+		return http.StatusOK, []interface{}{
+			map[string]interface{}{"policyid": 1.0},
+			map[string]interface{}{"policyid": 2.0},
+		}
+	})
+	tbl := orderTableOf("fortios_firewall_policy")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids, err := m.orders.read(m.Client, tbl, "")
+			if err != nil || len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+				t.Errorf("got %v, %v, want [1 2]", ids, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if reads != 1 {
+		t.Errorf("the table was read %d times, want once", reads)
+	}
+
+	// Other vdoms are read on their own, and the table again once it changed
+	m.orders.read(m.Client, tbl, "vdom1")
+	orderForget(m, tbl, "")
+	m.orders.read(m.Client, tbl, "")
+	if reads != 3 {
+		t.Errorf("the table was read %d times, want 3", reads)
+	}
+}
@@ -15,7 +15,7 @@ import (
 
 // Provider creates and returns the FortiOS terraform.ResourceProvider
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": &schema.Schema{
 				Type:        schema.TypeString,
@@ -112,240 +112,999 @@ func Provider() terraform.ResourceProvider {
 				Default:     "",
 				Description: "CA Bundle file",
 			},
+
+			"ignore_fields": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Attributes that are never diffed or sent, a map of resource type to comma separated attribute paths",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"fortios_ipmask_cidr":                             dataSourceIPMaskCIDR(),
-			"fortios_json_generic_api":                        dataSourceJSONGenericAPI(),
-			"fortios_firewall_DoSpolicy":                      dataSourceFirewallDosPolicy(),
-			"fortios_firewall_DoSpolicy6":                     dataSourceFirewallDosPolicy6(),
-			"fortios_firewall_address":                        dataSourceFirewallAddress(),
-			"fortios_firewall_address6":                       dataSourceFirewallAddress6(),
-			"fortios_firewall_address6template":               dataSourceFirewallAddress6Template(),
-			"fortios_firewall_addrgrp":                        dataSourceFirewallAddrgrp(),
-			"fortios_firewall_addrgrp6":                       dataSourceFirewallAddrgrp6(),
-			"fortios_firewall_centralsnatmap":                 dataSourceFirewallCentralSnatMap(),
-			"fortios_firewall_internetservice":                dataSourceFirewallInternetService(),
-			"fortios_firewall_internetservicecustom":          dataSourceFirewallInternetServiceCustom(),
-			"fortios_firewall_internetservicecustomgroup":     dataSourceFirewallInternetServiceCustomGroup(),
-			"fortios_firewall_internetservicedefinition":      dataSourceFirewallInternetServiceDefinition(),
-			"fortios_firewall_internetserviceextension":       dataSourceFirewallInternetServiceExtension(),
-			"fortios_firewall_internetservicegroup":           dataSourceFirewallInternetServiceGroup(),
-			"fortios_firewall_ipv6ehfilter":                   dataSourceFirewallIpv6EhFilter(),
-			"fortios_firewall_multicastaddress":               dataSourceFirewallMulticastAddress(),
-			"fortios_firewall_multicastaddress6":              dataSourceFirewallMulticastAddress6(),
-			"fortios_firewall_policy":                         dataSourceFirewallPolicy(),
-			"fortios_firewall_policy46":                       dataSourceFirewallPolicy46(),
-			"fortios_firewall_policy6":                        dataSourceFirewallPolicy6(),
-			"fortios_firewall_policy64":                       dataSourceFirewallPolicy64(),
-			"fortios_firewall_profileprotocoloptions":         dataSourceFirewallProfileProtocolOptions(),
-			"fortios_firewall_proxyaddress":                   dataSourceFirewallProxyAddress(),
-			"fortios_firewall_proxyaddrgrp":                   dataSourceFirewallProxyAddrgrp(),
-			"fortios_firewall_proxypolicy":                    dataSourceFirewallProxyPolicy(),
-			"fortios_firewallconsolidated_policy":             dataSourceFirewallConsolidatedPolicy(),
-			"fortios_firewallschedule_group":                  dataSourceFirewallScheduleGroup(),
-			"fortios_firewallschedule_onetime":                dataSourceFirewallScheduleOnetime(),
-			"fortios_firewallschedule_recurring":              dataSourceFirewallScheduleRecurring(),
-			"fortios_firewallservice_category":                dataSourceFirewallServiceCategory(),
-			"fortios_firewallservice_custom":                  dataSourceFirewallServiceCustom(),
-			"fortios_firewallservice_group":                   dataSourceFirewallServiceGroup(),
-			"fortios_firewallshaper_peripshaper":              dataSourceFirewallShaperPerIpShaper(),
-			"fortios_firewallshaper_trafficshaper":            dataSourceFirewallShaperTrafficShaper(),
-			"fortios_firewallwildcardfqdn_custom":             dataSourceFirewallWildcardFqdnCustom(),
-			"fortios_firewallwildcardfqdn_group":              dataSourceFirewallWildcardFqdnGroup(),
-			"fortios_router_accesslist":                       dataSourceRouterAccessList(),
-			"fortios_router_accesslist6":                      dataSourceRouterAccessList6(),
-			"fortios_router_aspathlist":                       dataSourceRouterAspathList(),
-			"fortios_router_authpath":                         dataSourceRouterAuthPath(),
-			"fortios_router_bfd":                              dataSourceRouterBfd(),
-			"fortios_router_bfd6":                             dataSourceRouterBfd6(),
-			"fortios_router_bgp":                              dataSourceRouterBgp(),
-			"fortios_router_communitylist":                    dataSourceRouterCommunityList(),
-			"fortios_router_isis":                             dataSourceRouterIsis(),
-			"fortios_router_keychain":                         dataSourceRouterKeyChain(),
-			"fortios_router_multicast":                        dataSourceRouterMulticast(),
-			"fortios_router_multicastflow":                    dataSourceRouterMulticastFlow(),
-			"fortios_router_multicast6":                       dataSourceRouterMulticast6(),
-			"fortios_router_ospf":                             dataSourceRouterOspf(),
-			"fortios_router_ospf6":                            dataSourceRouterOspf6(),
-			"fortios_router_policy":                           dataSourceRouterPolicy(),
-			"fortios_router_policy6":                          dataSourceRouterPolicy6(),
-			"fortios_router_prefixlist":                       dataSourceRouterPrefixList(),
-			"fortios_router_prefixlist6":                      dataSourceRouterPrefixList6(),
-			"fortios_router_rip":                              dataSourceRouterRip(),
-			"fortios_router_ripng":                            dataSourceRouterRipng(),
-			"fortios_router_routemap":                         dataSourceRouterRouteMap(),
-			"fortios_router_setting":                          dataSourceRouterSetting(),
-			"fortios_router_static":                           dataSourceRouterStatic(),
-			"fortios_router_static6":                          dataSourceRouterStatic6(),
-			"fortios_system_accprofile":                       dataSourceSystemAccprofile(),
-			"fortios_system_admin":                            dataSourceSystemAdmin(),
-			"fortios_system_alias":                            dataSourceSystemAlias(),
-			"fortios_system_apiuser":                          dataSourceSystemApiUser(),
-			"fortios_system_arptable":                         dataSourceSystemArpTable(),
-			"fortios_system_autoinstall":                      dataSourceSystemAutoInstall(),
-			"fortios_system_autoscript":                       dataSourceSystemAutoScript(),
-			"fortios_system_automationaction":                 dataSourceSystemAutomationAction(),
-			"fortios_system_automationdestination":            dataSourceSystemAutomationDestination(),
-			"fortios_system_automationtrigger":                dataSourceSystemAutomationTrigger(),
-			"fortios_system_centralmanagement":                dataSourceSystemCentralManagement(),
-			"fortios_system_clustersync":                      dataSourceSystemClusterSync(),
-			"fortios_system_console":                          dataSourceSystemConsole(),
-			"fortios_system_csf":                              dataSourceSystemCsf(),
-			"fortios_system_ddns":                             dataSourceSystemDdns(),
-			"fortios_system_dns":                              dataSourceSystemDns(),
-			"fortios_system_dnsdatabase":                      dataSourceSystemDnsDatabase(),
-			"fortios_system_dnsserver":                        dataSourceSystemDnsServer(),
-			"fortios_system_dscpbasedpriority":                dataSourceSystemDscpBasedPriority(),
-			"fortios_system_emailserver":                      dataSourceSystemEmailServer(),
-			"fortios_system_externalresource":                 dataSourceSystemExternalResource(),
-			"fortios_system_fipscc":                           dataSourceSystemFipsCc(),
-			"fortios_system_fm":                               dataSourceSystemFm(),
-			"fortios_system_fortiguard":                       dataSourceSystemFortiguard(),
-			"fortios_system_fortimanager":                     dataSourceSystemFortimanager(),
-			"fortios_system_fortisandbox":                     dataSourceSystemFortisandbox(),
-			"fortios_system_fssopolling":                      dataSourceSystemFssoPolling(),
-			"fortios_system_ftmpush":                          dataSourceSystemFtmPush(),
-			"fortios_system_global":                           dataSourceSystemGlobal(),
-			"fortios_system_gretunnel":                        dataSourceSystemGreTunnel(),
-			"fortios_system_ha":                               dataSourceSystemHa(),
-			"fortios_system_hamonitor":                        dataSourceSystemHaMonitor(),
-			"fortios_system_interface":                        dataSourceSystemInterface(),
-			"fortios_system_ipiptunnel":                       dataSourceSystemIpipTunnel(),
-			"fortios_system_ipv6neighborcache":                dataSourceSystemIpv6NeighborCache(),
-			"fortios_system_ipv6tunnel":                       dataSourceSystemIpv6Tunnel(),
-			"fortios_system_linkmonitor":                      dataSourceSystemLinkMonitor(),
-			"fortios_system_managementtunnel":                 dataSourceSystemManagementTunnel(),
-			"fortios_system_mobiletunnel":                     dataSourceSystemMobileTunnel(),
-			"fortios_system_nat64":                            dataSourceSystemNat64(),
-			"fortios_system_ndproxy":                          dataSourceSystemNdProxy(),
-			"fortios_system_netflow":                          dataSourceSystemNetflow(),
-			"fortios_system_networkvisibility":                dataSourceSystemNetworkVisibility(),
-			"fortios_system_ntp":                              dataSourceSystemNtp(),
-			"fortios_system_objecttagging":                    dataSourceSystemObjectTagging(),
-			"fortios_system_passwordpolicy":                   dataSourceSystemPasswordPolicy(),
-			"fortios_system_passwordpolicyguestadmin":         dataSourceSystemPasswordPolicyGuestAdmin(),
-			"fortios_system_pppoeinterface":                   dataSourceSystemPppoeInterface(),
-			"fortios_system_proberesponse":                    dataSourceSystemProbeResponse(),
-			"fortios_system_proxyarp":                         dataSourceSystemProxyArp(),
-			"fortios_system_replacemsggroup":                  dataSourceSystemReplacemsgGroup(),
-			"fortios_system_replacemsgimage":                  dataSourceSystemReplacemsgImage(),
-			"fortios_system_resourcelimits":                   dataSourceSystemResourceLimits(),
-			"fortios_system_sdnconnector":                     dataSourceSystemSdnConnector(),
-			"fortios_system_sessionhelper":                    dataSourceSystemSessionHelper(),
-			"fortios_system_sessionttl":                       dataSourceSystemSessionTtl(),
-			"fortios_system_sflow":                            dataSourceSystemSflow(),
-			"fortios_system_sittunnel":                        dataSourceSystemSitTunnel(),
-			"fortios_system_smsserver":                        dataSourceSystemSmsServer(),
-			"fortios_system_tosbasedpriority":                 dataSourceSystemTosBasedPriority(),
-			"fortios_system_vdomexception":                    dataSourceSystemVdomException(),
-			"fortios_system_vdomnetflow":                      dataSourceSystemVdomNetflow(),
-			"fortios_system_vdomsflow":                        dataSourceSystemVdomSflow(),
-			"fortios_system_virtualwanlink":                   dataSourceSystemVirtualWanLink(),
-			"fortios_system_vxlan":                            dataSourceSystemVxlan(),
-			"fortios_system_wccp":                             dataSourceSystemWccp(),
-			"fortios_system_zone":                             dataSourceSystemZone(),
-			"fortios_systemautoupdate_pushupdate":             dataSourceSystemAutoupdatePushUpdate(),
-			"fortios_systemautoupdate_schedule":               dataSourceSystemAutoupdateSchedule(),
-			"fortios_systemautoupdate_tunneling":              dataSourceSystemAutoupdateTunneling(),
-			"fortios_systemdhcp_server":                       dataSourceSystemDhcpServer(),
-			"fortios_systemlldp_networkpolicy":                dataSourceSystemLldpNetworkPolicy(),
-			"fortios_systemsnmp_community":                    dataSourceSystemSnmpCommunity(),
-			"fortios_systemsnmp_sysinfo":                      dataSourceSystemSnmpSysinfo(),
-			"fortios_systemsnmp_user":                         dataSourceSystemSnmpUser(),
-			"fortios_user_saml":                               dataSourceUserSaml(),
-			"fortios_routerbgp_neighbor":                      dataSourceRouterbgpNeighbor(),
-			"fortios_firewall_DoSpolicylist":                  dataSourceFirewallDosPolicyList(),
-			"fortios_firewall_DoSpolicy6list":                 dataSourceFirewallDosPolicy6List(),
-			"fortios_firewall_addresslist":                    dataSourceFirewallAddressList(),
-			"fortios_firewall_address6list":                   dataSourceFirewallAddress6List(),
-			"fortios_firewall_address6templatelist":           dataSourceFirewallAddress6TemplateList(),
-			"fortios_firewall_addrgrplist":                    dataSourceFirewallAddrgrpList(),
-			"fortios_firewall_addrgrp6list":                   dataSourceFirewallAddrgrp6List(),
-			"fortios_firewall_centralsnatmaplist":             dataSourceFirewallCentralSnatMapList(),
-			"fortios_firewall_internetservicelist":            dataSourceFirewallInternetServiceList(),
-			"fortios_firewall_internetservicecustomlist":      dataSourceFirewallInternetServiceCustomList(),
-			"fortios_firewall_internetservicecustomgrouplist": dataSourceFirewallInternetServiceCustomGroupList(),
-			"fortios_firewall_internetservicedefinitionlist":  dataSourceFirewallInternetServiceDefinitionList(),
-			"fortios_firewall_internetserviceextensionlist":   dataSourceFirewallInternetServiceExtensionList(),
-			"fortios_firewall_internetservicegrouplist":       dataSourceFirewallInternetServiceGroupList(),
-			"fortios_firewall_multicastaddresslist":           dataSourceFirewallMulticastAddressList(),
-			"fortios_firewall_multicastaddress6list":          dataSourceFirewallMulticastAddress6List(),
-			"fortios_firewall_policylist":                     dataSourceFirewallPolicyList(),
-			"fortios_firewall_policy46list":                   dataSourceFirewallPolicy46List(),
-			"fortios_firewall_policy6list":                    dataSourceFirewallPolicy6List(),
-			"fortios_firewall_policy64list":                   dataSourceFirewallPolicy64List(),
-			"fortios_firewall_profileprotocoloptionslist":     dataSourceFirewallProfileProtocolOptionsList(),
-			"fortios_firewall_proxyaddresslist":               dataSourceFirewallProxyAddressList(),
-			"fortios_firewall_proxyaddrgrplist":               dataSourceFirewallProxyAddrgrpList(),
-			"fortios_firewall_proxypolicylist":                dataSourceFirewallProxyPolicyList(),
-			"fortios_firewallconsolidated_policylist":         dataSourceFirewallConsolidatedPolicyList(),
-			"fortios_firewallschedule_grouplist":              dataSourceFirewallScheduleGroupList(),
-			"fortios_firewallschedule_onetimelist":            dataSourceFirewallScheduleOnetimeList(),
-			"fortios_firewallschedule_recurringlist":          dataSourceFirewallScheduleRecurringList(),
-			"fortios_firewallservice_categorylist":            dataSourceFirewallServiceCategoryList(),
-			"fortios_firewallservice_customlist":              dataSourceFirewallServiceCustomList(),
-			"fortios_firewallservice_grouplist":               dataSourceFirewallServiceGroupList(),
-			"fortios_firewallshaper_peripshaperlist":          dataSourceFirewallShaperPerIpShaperList(),
-			"fortios_firewallshaper_trafficshaperlist":        dataSourceFirewallShaperTrafficShaperList(),
-			"fortios_firewallwildcardfqdn_customlist":         dataSourceFirewallWildcardFqdnCustomList(),
-			"fortios_firewallwildcardfqdn_grouplist":          dataSourceFirewallWildcardFqdnGroupList(),
-			"fortios_router_accesslistlist":                   dataSourceRouterAccessListList(),
-			"fortios_router_accesslist6list":                  dataSourceRouterAccessList6List(),
-			"fortios_router_aspathlistlist":                   dataSourceRouterAspathListList(),
-			"fortios_router_authpathlist":                     dataSourceRouterAuthPathList(),
-			"fortios_router_communitylistlist":                dataSourceRouterCommunityListList(),
-			"fortios_router_keychainlist":                     dataSourceRouterKeyChainList(),
-			"fortios_router_multicastflowlist":                dataSourceRouterMulticastFlowList(),
-			"fortios_router_policylist":                       dataSourceRouterPolicyList(),
-			"fortios_router_policy6list":                      dataSourceRouterPolicy6List(),
-			"fortios_router_prefixlistlist":                   dataSourceRouterPrefixListList(),
-			"fortios_router_prefixlist6list":                  dataSourceRouterPrefixList6List(),
-			"fortios_router_routemaplist":                     dataSourceRouterRouteMapList(),
-			"fortios_router_staticlist":                       dataSourceRouterStaticList(),
-			"fortios_router_static6list":                      dataSourceRouterStatic6List(),
-			"fortios_system_accprofilelist":                   dataSourceSystemAccprofileList(),
-			"fortios_system_adminlist":                        dataSourceSystemAdminList(),
-			"fortios_system_aliaslist":                        dataSourceSystemAliasList(),
-			"fortios_system_apiuserlist":                      dataSourceSystemApiUserList(),
-			"fortios_system_arptablelist":                     dataSourceSystemArpTableList(),
-			"fortios_system_autoscriptlist":                   dataSourceSystemAutoScriptList(),
-			"fortios_system_automationactionlist":             dataSourceSystemAutomationActionList(),
-			"fortios_system_automationdestinationlist":        dataSourceSystemAutomationDestinationList(),
-			"fortios_system_automationtriggerlist":            dataSourceSystemAutomationTriggerList(),
-			"fortios_system_clustersynclist":                  dataSourceSystemClusterSyncList(),
-			"fortios_system_ddnslist":                         dataSourceSystemDdnsList(),
-			"fortios_system_dnsdatabaselist":                  dataSourceSystemDnsDatabaseList(),
-			"fortios_system_dnsserverlist":                    dataSourceSystemDnsServerList(),
-			"fortios_system_dscpbasedprioritylist":            dataSourceSystemDscpBasedPriorityList(),
-			"fortios_system_externalresourcelist":             dataSourceSystemExternalResourceList(),
-			"fortios_system_gretunnellist":                    dataSourceSystemGreTunnelList(),
-			"fortios_system_interfacelist":                    dataSourceSystemInterfaceList(),
-			"fortios_system_ipiptunnellist":                   dataSourceSystemIpipTunnelList(),
-			"fortios_system_ipv6neighborcachelist":            dataSourceSystemIpv6NeighborCacheList(),
-			"fortios_system_ipv6tunnellist":                   dataSourceSystemIpv6TunnelList(),
-			"fortios_system_linkmonitorlist":                  dataSourceSystemLinkMonitorList(),
-			"fortios_system_mobiletunnellist":                 dataSourceSystemMobileTunnelList(),
-			"fortios_system_objecttagginglist":                dataSourceSystemObjectTaggingList(),
-			"fortios_system_pppoeinterfacelist":               dataSourceSystemPppoeInterfaceList(),
-			"fortios_system_proxyarplist":                     dataSourceSystemProxyArpList(),
-			"fortios_system_replacemsggrouplist":              dataSourceSystemReplacemsgGroupList(),
-			"fortios_system_replacemsgimagelist":              dataSourceSystemReplacemsgImageList(),
-			"fortios_system_sdnconnectorlist":                 dataSourceSystemSdnConnectorList(),
-			"fortios_system_sessionhelperlist":                dataSourceSystemSessionHelperList(),
-			"fortios_system_sittunnellist":                    dataSourceSystemSitTunnelList(),
-			"fortios_system_smsserverlist":                    dataSourceSystemSmsServerList(),
-			"fortios_system_tosbasedprioritylist":             dataSourceSystemTosBasedPriorityList(),
-			"fortios_system_vdomexceptionlist":                dataSourceSystemVdomExceptionList(),
-			"fortios_system_vxlanlist":                        dataSourceSystemVxlanList(),
-			"fortios_system_wccplist":                         dataSourceSystemWccpList(),
-			"fortios_system_zonelist":                         dataSourceSystemZoneList(),
-			"fortios_systemdhcp_serverlist":                   dataSourceSystemDhcpServerList(),
-			"fortios_systemlldp_networkpolicylist":            dataSourceSystemLldpNetworkPolicyList(),
-			"fortios_systemsnmp_communitylist":                dataSourceSystemSnmpCommunityList(),
-			"fortios_systemsnmp_userlist":                     dataSourceSystemSnmpUserList(),
-			"fortios_user_samllist":                           dataSourceUserSamlList(),
-			"fortios_routerbgp_neighborlist":                  dataSourceRouterbgpNeighborList(),
+			"fortios_ipmask_cidr":                                            dataSourceIPMaskCIDR(),
+			"fortios_json_generic_api":                                       dataSourceJSONGenericAPI(),
+			"fortios_cli_render":                                             dataSourceCliRender(),
+			"fortios_monitor":                                                dataSourceMonitor(),
+			"fortios_firewall_policy_analysis":                               dataSourceFirewallPolicyAnalysis(),
+			"fortios_firewall_policy_lookup":                                 dataSourceFirewallPolicyLookup(),
+			"fortios_object_usage":                                           dataSourceObjectUsage(),
+			"fortios_firewall_addrgrp_resolved":                              dataSourceFirewallAddrgrpResolved(),
+			"fortios_firewallschedule_expired":                               dataSourceFirewallScheduleExpired(),
+			"fortios_firewall_DoSpolicy":                                     dataSourceFirewallDosPolicy(),
+			"fortios_firewall_DoSpolicy6":                                    dataSourceFirewallDosPolicy6(),
+			"fortios_firewall_address":                                       dataSourceFirewallAddress(),
+			"fortios_firewall_address6":                                      dataSourceFirewallAddress6(),
+			"fortios_firewall_address6template":                              dataSourceFirewallAddress6Template(),
+			"fortios_firewall_addrgrp":                                       dataSourceFirewallAddrgrp(),
+			"fortios_firewall_addrgrp6":                                      dataSourceFirewallAddrgrp6(),
+			"fortios_firewall_centralsnatmap":                                dataSourceFirewallCentralSnatMap(),
+			"fortios_firewall_internetservice":                               dataSourceFirewallInternetService(),
+			"fortios_firewall_internetservicecustom":                         dataSourceFirewallInternetServiceCustom(),
+			"fortios_firewall_internetservicecustomgroup":                    dataSourceFirewallInternetServiceCustomGroup(),
+			"fortios_firewall_internetservicedefinition":                     dataSourceFirewallInternetServiceDefinition(),
+			"fortios_firewall_internetserviceextension":                      dataSourceFirewallInternetServiceExtension(),
+			"fortios_firewall_internetservicegroup":                          dataSourceFirewallInternetServiceGroup(),
+			"fortios_firewall_ipv6ehfilter":                                  dataSourceFirewallIpv6EhFilter(),
+			"fortios_firewall_multicastaddress":                              dataSourceFirewallMulticastAddress(),
+			"fortios_firewall_multicastaddress6":                             dataSourceFirewallMulticastAddress6(),
+			"fortios_firewall_policy":                                        dataSourceFirewallPolicy(),
+			"fortios_firewall_policy46":                                      dataSourceFirewallPolicy46(),
+			"fortios_firewall_policy6":                                       dataSourceFirewallPolicy6(),
+			"fortios_firewall_policy64":                                      dataSourceFirewallPolicy64(),
+			"fortios_firewall_profileprotocoloptions":                        dataSourceFirewallProfileProtocolOptions(),
+			"fortios_firewall_proxyaddress":                                  dataSourceFirewallProxyAddress(),
+			"fortios_firewall_proxyaddrgrp":                                  dataSourceFirewallProxyAddrgrp(),
+			"fortios_firewall_proxypolicy":                                   dataSourceFirewallProxyPolicy(),
+			"fortios_firewallconsolidated_policy":                            dataSourceFirewallConsolidatedPolicy(),
+			"fortios_firewallschedule_group":                                 dataSourceFirewallScheduleGroup(),
+			"fortios_firewallschedule_onetime":                               dataSourceFirewallScheduleOnetime(),
+			"fortios_firewallschedule_recurring":                             dataSourceFirewallScheduleRecurring(),
+			"fortios_firewallservice_category":                               dataSourceFirewallServiceCategory(),
+			"fortios_firewallservice_custom":                                 dataSourceFirewallServiceCustom(),
+			"fortios_firewallservice_group":                                  dataSourceFirewallServiceGroup(),
+			"fortios_firewallshaper_peripshaper":                             dataSourceFirewallShaperPerIpShaper(),
+			"fortios_firewallshaper_trafficshaper":                           dataSourceFirewallShaperTrafficShaper(),
+			"fortios_firewallwildcardfqdn_custom":                            dataSourceFirewallWildcardFqdnCustom(),
+			"fortios_firewallwildcardfqdn_group":                             dataSourceFirewallWildcardFqdnGroup(),
+			"fortios_router_accesslist":                                      dataSourceRouterAccessList(),
+			"fortios_router_accesslist6":                                     dataSourceRouterAccessList6(),
+			"fortios_router_aspathlist":                                      dataSourceRouterAspathList(),
+			"fortios_router_authpath":                                        dataSourceRouterAuthPath(),
+			"fortios_router_bfd":                                             dataSourceRouterBfd(),
+			"fortios_router_bfd6":                                            dataSourceRouterBfd6(),
+			"fortios_router_bgp":                                             dataSourceRouterBgp(),
+			"fortios_router_communitylist":                                   dataSourceRouterCommunityList(),
+			"fortios_router_isis":                                            dataSourceRouterIsis(),
+			"fortios_router_keychain":                                        dataSourceRouterKeyChain(),
+			"fortios_router_multicast":                                       dataSourceRouterMulticast(),
+			"fortios_router_multicastflow":                                   dataSourceRouterMulticastFlow(),
+			"fortios_router_multicast6":                                      dataSourceRouterMulticast6(),
+			"fortios_router_ospf":                                            dataSourceRouterOspf(),
+			"fortios_router_ospf6":                                           dataSourceRouterOspf6(),
+			"fortios_router_policy":                                          dataSourceRouterPolicy(),
+			"fortios_router_policy6":                                         dataSourceRouterPolicy6(),
+			"fortios_router_prefixlist":                                      dataSourceRouterPrefixList(),
+			"fortios_router_prefixlist6":                                     dataSourceRouterPrefixList6(),
+			"fortios_router_rip":                                             dataSourceRouterRip(),
+			"fortios_router_ripng":                                           dataSourceRouterRipng(),
+			"fortios_router_routemap":                                        dataSourceRouterRouteMap(),
+			"fortios_router_setting":                                         dataSourceRouterSetting(),
+			"fortios_router_static":                                          dataSourceRouterStatic(),
+			"fortios_router_static6":                                         dataSourceRouterStatic6(),
+			"fortios_system_accprofile":                                      dataSourceSystemAccprofile(),
+			"fortios_system_admin":                                           dataSourceSystemAdmin(),
+			"fortios_system_alias":                                           dataSourceSystemAlias(),
+			"fortios_system_apiuser":                                         dataSourceSystemApiUser(),
+			"fortios_system_arptable":                                        dataSourceSystemArpTable(),
+			"fortios_system_autoinstall":                                     dataSourceSystemAutoInstall(),
+			"fortios_system_autoscript":                                      dataSourceSystemAutoScript(),
+			"fortios_system_automationaction":                                dataSourceSystemAutomationAction(),
+			"fortios_system_automationdestination":                           dataSourceSystemAutomationDestination(),
+			"fortios_system_automationtrigger":                               dataSourceSystemAutomationTrigger(),
+			"fortios_system_centralmanagement":                               dataSourceSystemCentralManagement(),
+			"fortios_system_clustersync":                                     dataSourceSystemClusterSync(),
+			"fortios_system_console":                                         dataSourceSystemConsole(),
+			"fortios_system_csf":                                             dataSourceSystemCsf(),
+			"fortios_system_ddns":                                            dataSourceSystemDdns(),
+			"fortios_system_dns":                                             dataSourceSystemDns(),
+			"fortios_system_dnsdatabase":                                     dataSourceSystemDnsDatabase(),
+			"fortios_system_dnsserver":                                       dataSourceSystemDnsServer(),
+			"fortios_system_dscpbasedpriority":                               dataSourceSystemDscpBasedPriority(),
+			"fortios_system_emailserver":                                     dataSourceSystemEmailServer(),
+			"fortios_system_externalresource":                                dataSourceSystemExternalResource(),
+			"fortios_system_fipscc":                                          dataSourceSystemFipsCc(),
+			"fortios_system_fm":                                              dataSourceSystemFm(),
+			"fortios_system_fortiguard":                                      dataSourceSystemFortiguard(),
+			"fortios_system_fortimanager":                                    dataSourceSystemFortimanager(),
+			"fortios_system_fortisandbox":                                    dataSourceSystemFortisandbox(),
+			"fortios_system_fssopolling":                                     dataSourceSystemFssoPolling(),
+			"fortios_system_ftmpush":                                         dataSourceSystemFtmPush(),
+			"fortios_system_global":                                          dataSourceSystemGlobal(),
+			"fortios_system_gretunnel":                                       dataSourceSystemGreTunnel(),
+			"fortios_system_ha":                                              dataSourceSystemHa(),
+			"fortios_system_hamonitor":                                       dataSourceSystemHaMonitor(),
+			"fortios_system_interface":                                       dataSourceSystemInterface(),
+			"fortios_system_ipiptunnel":                                      dataSourceSystemIpipTunnel(),
+			"fortios_system_ipv6neighborcache":                               dataSourceSystemIpv6NeighborCache(),
+			"fortios_system_ipv6tunnel":                                      dataSourceSystemIpv6Tunnel(),
+			"fortios_system_linkmonitor":                                     dataSourceSystemLinkMonitor(),
+			"fortios_system_managementtunnel":                                dataSourceSystemManagementTunnel(),
+			"fortios_system_mobiletunnel":                                    dataSourceSystemMobileTunnel(),
+			"fortios_system_nat64":                                           dataSourceSystemNat64(),
+			"fortios_system_ndproxy":                                         dataSourceSystemNdProxy(),
+			"fortios_system_netflow":                                         dataSourceSystemNetflow(),
+			"fortios_system_networkvisibility":                               dataSourceSystemNetworkVisibility(),
+			"fortios_system_ntp":                                             dataSourceSystemNtp(),
+			"fortios_system_objecttagging":                                   dataSourceSystemObjectTagging(),
+			"fortios_system_passwordpolicy":                                  dataSourceSystemPasswordPolicy(),
+			"fortios_system_passwordpolicyguestadmin":                        dataSourceSystemPasswordPolicyGuestAdmin(),
+			"fortios_system_pppoeinterface":                                  dataSourceSystemPppoeInterface(),
+			"fortios_system_proberesponse":                                   dataSourceSystemProbeResponse(),
+			"fortios_system_proxyarp":                                        dataSourceSystemProxyArp(),
+			"fortios_system_replacemsggroup":                                 dataSourceSystemReplacemsgGroup(),
+			"fortios_system_replacemsgimage":                                 dataSourceSystemReplacemsgImage(),
+			"fortios_system_resourcelimits":                                  dataSourceSystemResourceLimits(),
+			"fortios_system_sdnconnector":                                    dataSourceSystemSdnConnector(),
+			"fortios_system_sessionhelper":                                   dataSourceSystemSessionHelper(),
+			"fortios_system_sessionttl":                                      dataSourceSystemSessionTtl(),
+			"fortios_system_sflow":                                           dataSourceSystemSflow(),
+			"fortios_system_sittunnel":                                       dataSourceSystemSitTunnel(),
+			"fortios_system_smsserver":                                       dataSourceSystemSmsServer(),
+			"fortios_system_tosbasedpriority":                                dataSourceSystemTosBasedPriority(),
+			"fortios_system_vdomexception":                                   dataSourceSystemVdomException(),
+			"fortios_system_vdomnetflow":                                     dataSourceSystemVdomNetflow(),
+			"fortios_system_vdomsflow":                                       dataSourceSystemVdomSflow(),
+			"fortios_system_virtualwanlink":                                  dataSourceSystemVirtualWanLink(),
+			"fortios_system_vxlan":                                           dataSourceSystemVxlan(),
+			"fortios_system_wccp":                                            dataSourceSystemWccp(),
+			"fortios_system_zone":                                            dataSourceSystemZone(),
+			"fortios_systemautoupdate_pushupdate":                            dataSourceSystemAutoupdatePushUpdate(),
+			"fortios_systemautoupdate_schedule":                              dataSourceSystemAutoupdateSchedule(),
+			"fortios_systemautoupdate_tunneling":                             dataSourceSystemAutoupdateTunneling(),
+			"fortios_systemdhcp_server":                                      dataSourceSystemDhcpServer(),
+			"fortios_systemlldp_networkpolicy":                               dataSourceSystemLldpNetworkPolicy(),
+			"fortios_systemsnmp_community":                                   dataSourceSystemSnmpCommunity(),
+			"fortios_systemsnmp_sysinfo":                                     dataSourceSystemSnmpSysinfo(),
+			"fortios_systemsnmp_user":                                        dataSourceSystemSnmpUser(),
+			"fortios_user_saml":                                              dataSourceUserSaml(),
+			"fortios_routerbgp_neighbor":                                     dataSourceRouterbgpNeighbor(),
+			"fortios_firewall_DoSpolicylist":                                 dataSourceFirewallDosPolicyList(),
+			"fortios_firewall_DoSpolicy6list":                                dataSourceFirewallDosPolicy6List(),
+			"fortios_firewall_addresslist":                                   dataSourceFirewallAddressList(),
+			"fortios_firewall_address6list":                                  dataSourceFirewallAddress6List(),
+			"fortios_firewall_address6templatelist":                          dataSourceFirewallAddress6TemplateList(),
+			"fortios_firewall_addrgrplist":                                   dataSourceFirewallAddrgrpList(),
+			"fortios_firewall_addrgrp6list":                                  dataSourceFirewallAddrgrp6List(),
+			"fortios_firewall_centralsnatmaplist":                            dataSourceFirewallCentralSnatMapList(),
+			"fortios_firewall_internetservicelist":                           dataSourceFirewallInternetServiceList(),
+			"fortios_firewall_internetservicecustomlist":                     dataSourceFirewallInternetServiceCustomList(),
+			"fortios_firewall_internetservicecustomgrouplist":                dataSourceFirewallInternetServiceCustomGroupList(),
+			"fortios_firewall_internetservicedefinitionlist":                 dataSourceFirewallInternetServiceDefinitionList(),
+			"fortios_firewall_internetserviceextensionlist":                  dataSourceFirewallInternetServiceExtensionList(),
+			"fortios_firewall_internetservicegrouplist":                      dataSourceFirewallInternetServiceGroupList(),
+			"fortios_firewall_multicastaddresslist":                          dataSourceFirewallMulticastAddressList(),
+			"fortios_firewall_multicastaddress6list":                         dataSourceFirewallMulticastAddress6List(),
+			"fortios_firewall_policylist":                                    dataSourceFirewallPolicyList(),
+			"fortios_firewall_policy46list":                                  dataSourceFirewallPolicy46List(),
+			"fortios_firewall_policy6list":                                   dataSourceFirewallPolicy6List(),
+			"fortios_firewall_policy64list":                                  dataSourceFirewallPolicy64List(),
+			"fortios_firewall_profileprotocoloptionslist":                    dataSourceFirewallProfileProtocolOptionsList(),
+			"fortios_firewall_proxyaddresslist":                              dataSourceFirewallProxyAddressList(),
+			"fortios_firewall_proxyaddrgrplist":                              dataSourceFirewallProxyAddrgrpList(),
+			"fortios_firewall_proxypolicylist":                               dataSourceFirewallProxyPolicyList(),
+			"fortios_firewallconsolidated_policylist":                        dataSourceFirewallConsolidatedPolicyList(),
+			"fortios_firewallschedule_grouplist":                             dataSourceFirewallScheduleGroupList(),
+			"fortios_firewallschedule_onetimelist":                           dataSourceFirewallScheduleOnetimeList(),
+			"fortios_firewallschedule_recurringlist":                         dataSourceFirewallScheduleRecurringList(),
+			"fortios_firewallservice_categorylist":                           dataSourceFirewallServiceCategoryList(),
+			"fortios_firewallservice_customlist":                             dataSourceFirewallServiceCustomList(),
+			"fortios_firewallservice_grouplist":                              dataSourceFirewallServiceGroupList(),
+			"fortios_firewallshaper_peripshaperlist":                         dataSourceFirewallShaperPerIpShaperList(),
+			"fortios_firewallshaper_trafficshaperlist":                       dataSourceFirewallShaperTrafficShaperList(),
+			"fortios_firewallwildcardfqdn_customlist":                        dataSourceFirewallWildcardFqdnCustomList(),
+			"fortios_firewallwildcardfqdn_grouplist":                         dataSourceFirewallWildcardFqdnGroupList(),
+			"fortios_router_accesslistlist":                                  dataSourceRouterAccessListList(),
+			"fortios_router_accesslist6list":                                 dataSourceRouterAccessList6List(),
+			"fortios_router_aspathlistlist":                                  dataSourceRouterAspathListList(),
+			"fortios_router_authpathlist":                                    dataSourceRouterAuthPathList(),
+			"fortios_router_communitylistlist":                               dataSourceRouterCommunityListList(),
+			"fortios_router_keychainlist":                                    dataSourceRouterKeyChainList(),
+			"fortios_router_multicastflowlist":                               dataSourceRouterMulticastFlowList(),
+			"fortios_router_policylist":                                      dataSourceRouterPolicyList(),
+			"fortios_router_policy6list":                                     dataSourceRouterPolicy6List(),
+			"fortios_router_prefixlistlist":                                  dataSourceRouterPrefixListList(),
+			"fortios_router_prefixlist6list":                                 dataSourceRouterPrefixList6List(),
+			"fortios_router_routemaplist":                                    dataSourceRouterRouteMapList(),
+			"fortios_router_staticlist":                                      dataSourceRouterStaticList(),
+			"fortios_router_static6list":                                     dataSourceRouterStatic6List(),
+			"fortios_system_accprofilelist":                                  dataSourceSystemAccprofileList(),
+			"fortios_system_adminlist":                                       dataSourceSystemAdminList(),
+			"fortios_system_aliaslist":                                       dataSourceSystemAliasList(),
+			"fortios_system_apiuserlist":                                     dataSourceSystemApiUserList(),
+			"fortios_system_arptablelist":                                    dataSourceSystemArpTableList(),
+			"fortios_system_autoscriptlist":                                  dataSourceSystemAutoScriptList(),
+			"fortios_system_automationactionlist":                            dataSourceSystemAutomationActionList(),
+			"fortios_system_automationdestinationlist":                       dataSourceSystemAutomationDestinationList(),
+			"fortios_system_automationtriggerlist":                           dataSourceSystemAutomationTriggerList(),
+			"fortios_system_clustersynclist":                                 dataSourceSystemClusterSyncList(),
+			"fortios_system_ddnslist":                                        dataSourceSystemDdnsList(),
+			"fortios_system_dnsdatabaselist":                                 dataSourceSystemDnsDatabaseList(),
+			"fortios_system_dnsserverlist":                                   dataSourceSystemDnsServerList(),
+			"fortios_system_dscpbasedprioritylist":                           dataSourceSystemDscpBasedPriorityList(),
+			"fortios_system_externalresourcelist":                            dataSourceSystemExternalResourceList(),
+			"fortios_system_gretunnellist":                                   dataSourceSystemGreTunnelList(),
+			"fortios_system_interfacelist":                                   dataSourceSystemInterfaceList(),
+			"fortios_system_ipiptunnellist":                                  dataSourceSystemIpipTunnelList(),
+			"fortios_system_ipv6neighborcachelist":                           dataSourceSystemIpv6NeighborCacheList(),
+			"fortios_system_ipv6tunnellist":                                  dataSourceSystemIpv6TunnelList(),
+			"fortios_system_linkmonitorlist":                                 dataSourceSystemLinkMonitorList(),
+			"fortios_system_mobiletunnellist":                                dataSourceSystemMobileTunnelList(),
+			"fortios_system_objecttagginglist":                               dataSourceSystemObjectTaggingList(),
+			"fortios_system_pppoeinterfacelist":                              dataSourceSystemPppoeInterfaceList(),
+			"fortios_system_proxyarplist":                                    dataSourceSystemProxyArpList(),
+			"fortios_system_replacemsggrouplist":                             dataSourceSystemReplacemsgGroupList(),
+			"fortios_system_replacemsgimagelist":                             dataSourceSystemReplacemsgImageList(),
+			"fortios_system_sdnconnectorlist":                                dataSourceSystemSdnConnectorList(),
+			"fortios_system_sessionhelperlist":                               dataSourceSystemSessionHelperList(),
+			"fortios_system_sittunnellist":                                   dataSourceSystemSitTunnelList(),
+			"fortios_system_smsserverlist":                                   dataSourceSystemSmsServerList(),
+			"fortios_system_tosbasedprioritylist":                            dataSourceSystemTosBasedPriorityList(),
+			"fortios_system_vdomexceptionlist":                               dataSourceSystemVdomExceptionList(),
+			"fortios_system_vxlanlist":                                       dataSourceSystemVxlanList(),
+			"fortios_system_wccplist":                                        dataSourceSystemWccpList(),
+			"fortios_system_zonelist":                                        dataSourceSystemZoneList(),
+			"fortios_systemdhcp_serverlist":                                  dataSourceSystemDhcpServerList(),
+			"fortios_systemlldp_networkpolicylist":                           dataSourceSystemLldpNetworkPolicyList(),
+			"fortios_systemsnmp_communitylist":                               dataSourceSystemSnmpCommunityList(),
+			"fortios_systemsnmp_userlist":                                    dataSourceSystemSnmpUserList(),
+			"fortios_user_samllist":                                          dataSourceUserSamlList(),
+			"fortios_routerbgp_neighborlist":                                 dataSourceRouterbgpNeighborList(),
+			"fortios_alertemail_setting":                                     dataSourceAlertemailSetting(),
+			"fortios_antivirus_heuristic":                                    dataSourceAntivirusHeuristic(),
+			"fortios_antivirus_profile":                                      dataSourceAntivirusProfile(),
+			"fortios_antivirus_profilelist":                                  dataSourceAntivirusProfileList(),
+			"fortios_antivirus_quarantine":                                   dataSourceAntivirusQuarantine(),
+			"fortios_antivirus_settings":                                     dataSourceAntivirusSettings(),
+			"fortios_application_custom":                                     dataSourceApplicationCustom(),
+			"fortios_application_customlist":                                 dataSourceApplicationCustomList(),
+			"fortios_application_group":                                      dataSourceApplicationGroup(),
+			"fortios_application_grouplist":                                  dataSourceApplicationGroupList(),
+			"fortios_application_list":                                       dataSourceApplicationList(),
+			"fortios_application_listlist":                                   dataSourceApplicationListList(),
+			"fortios_application_name":                                       dataSourceApplicationName(),
+			"fortios_application_namelist":                                   dataSourceApplicationNameList(),
+			"fortios_application_rulesettings":                               dataSourceApplicationRuleSettings(),
+			"fortios_application_rulesettingslist":                           dataSourceApplicationRuleSettingsList(),
+			"fortios_authentication_rule":                                    dataSourceAuthenticationRule(),
+			"fortios_authentication_rulelist":                                dataSourceAuthenticationRuleList(),
+			"fortios_authentication_scheme":                                  dataSourceAuthenticationScheme(),
+			"fortios_authentication_schemelist":                              dataSourceAuthenticationSchemeList(),
+			"fortios_authentication_setting":                                 dataSourceAuthenticationSetting(),
+			"fortios_certificate_ca":                                         dataSourceCertificateCa(),
+			"fortios_certificate_calist":                                     dataSourceCertificateCaList(),
+			"fortios_certificate_crl":                                        dataSourceCertificateCrl(),
+			"fortios_certificate_crllist":                                    dataSourceCertificateCrlList(),
+			"fortios_certificate_local":                                      dataSourceCertificateLocal(),
+			"fortios_certificate_locallist":                                  dataSourceCertificateLocalList(),
+			"fortios_certificate_remote":                                     dataSourceCertificateRemote(),
+			"fortios_certificate_remotelist":                                 dataSourceCertificateRemoteList(),
+			"fortios_cifs_domaincontroller":                                  dataSourceCifsDomainController(),
+			"fortios_cifs_domaincontrollerlist":                              dataSourceCifsDomainControllerList(),
+			"fortios_cifs_profile":                                           dataSourceCifsProfile(),
+			"fortios_cifs_profilelist":                                       dataSourceCifsProfileList(),
+			"fortios_credentialstore_domaincontroller":                       dataSourceCredentialStoreDomainController(),
+			"fortios_credentialstore_domaincontrollerlist":                   dataSourceCredentialStoreDomainControllerList(),
+			"fortios_dlp_filepattern":                                        dataSourceDlpFilepattern(),
+			"fortios_dlp_filepatternlist":                                    dataSourceDlpFilepatternList(),
+			"fortios_dlp_fpdocsource":                                        dataSourceDlpFpDocSource(),
+			"fortios_dlp_fpdocsourcelist":                                    dataSourceDlpFpDocSourceList(),
+			"fortios_dlp_fpsensitivity":                                      dataSourceDlpFpSensitivity(),
+			"fortios_dlp_fpsensitivitylist":                                  dataSourceDlpFpSensitivityList(),
+			"fortios_dlp_sensitivity":                                        dataSourceDlpSensitivity(),
+			"fortios_dlp_sensitivitylist":                                    dataSourceDlpSensitivityList(),
+			"fortios_dlp_sensor":                                             dataSourceDlpSensor(),
+			"fortios_dlp_sensorlist":                                         dataSourceDlpSensorList(),
+			"fortios_dlp_settings":                                           dataSourceDlpSettings(),
+			"fortios_dnsfilter_domainfilter":                                 dataSourceDnsfilterDomainFilter(),
+			"fortios_dnsfilter_domainfilterlist":                             dataSourceDnsfilterDomainFilterList(),
+			"fortios_dnsfilter_profile":                                      dataSourceDnsfilterProfile(),
+			"fortios_dnsfilter_profilelist":                                  dataSourceDnsfilterProfileList(),
+			"fortios_dpdk_cpus":                                              dataSourceDpdkCpus(),
+			"fortios_dpdk_global":                                            dataSourceDpdkGlobal(),
+			"fortios_emailfilter_blockallowlist":                             dataSourceEmailfilterBlockAllowList(),
+			"fortios_emailfilter_blockallowlistlist":                         dataSourceEmailfilterBlockAllowListList(),
+			"fortios_emailfilter_bwl":                                        dataSourceEmailfilterBwl(),
+			"fortios_emailfilter_bwllist":                                    dataSourceEmailfilterBwlList(),
+			"fortios_emailfilter_bword":                                      dataSourceEmailfilterBword(),
+			"fortios_emailfilter_bwordlist":                                  dataSourceEmailfilterBwordList(),
+			"fortios_emailfilter_dnsbl":                                      dataSourceEmailfilterDnsbl(),
+			"fortios_emailfilter_dnsbllist":                                  dataSourceEmailfilterDnsblList(),
+			"fortios_emailfilter_fortishield":                                dataSourceEmailfilterFortishield(),
+			"fortios_emailfilter_iptrust":                                    dataSourceEmailfilterIptrust(),
+			"fortios_emailfilter_iptrustlist":                                dataSourceEmailfilterIptrustList(),
+			"fortios_emailfilter_mheader":                                    dataSourceEmailfilterMheader(),
+			"fortios_emailfilter_mheaderlist":                                dataSourceEmailfilterMheaderList(),
+			"fortios_emailfilter_options":                                    dataSourceEmailfilterOptions(),
+			"fortios_emailfilter_profile":                                    dataSourceEmailfilterProfile(),
+			"fortios_emailfilter_profilelist":                                dataSourceEmailfilterProfileList(),
+			"fortios_endpointcontrol_client":                                 dataSourceEndpointControlClient(),
+			"fortios_endpointcontrol_clientlist":                             dataSourceEndpointControlClientList(),
+			"fortios_endpointcontrol_fctems":                                 dataSourceEndpointControlFctems(),
+			"fortios_endpointcontrol_fctemslist":                             dataSourceEndpointControlFctemsList(),
+			"fortios_endpointcontrol_forticlientems":                         dataSourceEndpointControlForticlientEms(),
+			"fortios_endpointcontrol_forticlientemslist":                     dataSourceEndpointControlForticlientEmsList(),
+			"fortios_endpointcontrol_forticlientregistrationsync":            dataSourceEndpointControlForticlientRegistrationSync(),
+			"fortios_endpointcontrol_forticlientregistrationsynclist":        dataSourceEndpointControlForticlientRegistrationSyncList(),
+			"fortios_endpointcontrol_profile":                                dataSourceEndpointControlProfile(),
+			"fortios_endpointcontrol_profilelist":                            dataSourceEndpointControlProfileList(),
+			"fortios_endpointcontrol_registeredforticlient":                  dataSourceEndpointControlRegisteredForticlient(),
+			"fortios_endpointcontrol_registeredforticlientlist":              dataSourceEndpointControlRegisteredForticlientList(),
+			"fortios_endpointcontrol_settings":                               dataSourceEndpointControlSettings(),
+			"fortios_extendercontroller_dataplan":                            dataSourceExtenderControllerDataplan(),
+			"fortios_extendercontroller_dataplanlist":                        dataSourceExtenderControllerDataplanList(),
+			"fortios_extendercontroller_extender":                            dataSourceExtenderControllerExtender(),
+			"fortios_extendercontroller_extender1":                           dataSourceExtenderControllerExtender1(),
+			"fortios_extendercontroller_extender1list":                       dataSourceExtenderControllerExtender1List(),
+			"fortios_extendercontroller_extenderlist":                        dataSourceExtenderControllerExtenderList(),
+			"fortios_extendercontroller_extenderprofile":                     dataSourceExtenderControllerExtenderProfile(),
+			"fortios_extendercontroller_extenderprofilelist":                 dataSourceExtenderControllerExtenderProfileList(),
+			"fortios_filefilter_profile":                                     dataSourceFileFilterProfile(),
+			"fortios_filefilter_profilelist":                                 dataSourceFileFilterProfileList(),
+			"fortios_firewall_accessproxy":                                   dataSourceFirewallAccessProxy(),
+			"fortios_firewall_accessproxy6":                                  dataSourceFirewallAccessProxy6(),
+			"fortios_firewall_accessproxy6list":                              dataSourceFirewallAccessProxy6List(),
+			"fortios_firewall_accessproxylist":                               dataSourceFirewallAccessProxyList(),
+			"fortios_firewall_accessproxysshclientcert":                      dataSourceFirewallAccessProxySshClientCert(),
+			"fortios_firewall_accessproxysshclientcertlist":                  dataSourceFirewallAccessProxySshClientCertList(),
+			"fortios_firewall_accessproxyvirtualhost":                        dataSourceFirewallAccessProxyVirtualHost(),
+			"fortios_firewall_accessproxyvirtualhostlist":                    dataSourceFirewallAccessProxyVirtualHostList(),
+			"fortios_firewall_authportal":                                    dataSourceFirewallAuthPortal(),
+			"fortios_firewall_city":                                          dataSourceFirewallCity(),
+			"fortios_firewall_citylist":                                      dataSourceFirewallCityList(),
+			"fortios_firewall_country":                                       dataSourceFirewallCountry(),
+			"fortios_firewall_countrylist":                                   dataSourceFirewallCountryList(),
+			"fortios_firewall_decryptedtrafficmirror":                        dataSourceFirewallDecryptedTrafficMirror(),
+			"fortios_firewall_decryptedtrafficmirrorlist":                    dataSourceFirewallDecryptedTrafficMirrorList(),
+			"fortios_firewall_dnstranslation":                                dataSourceFirewallDnstranslation(),
+			"fortios_firewall_dnstranslationlist":                            dataSourceFirewallDnstranslationList(),
+			"fortios_firewall_identitybasedroute":                            dataSourceFirewallIdentityBasedRoute(),
+			"fortios_firewall_identitybasedroutelist":                        dataSourceFirewallIdentityBasedRouteList(),
+			"fortios_firewall_interfacepolicy":                               dataSourceFirewallInterfacePolicy(),
+			"fortios_firewall_interfacepolicy6":                              dataSourceFirewallInterfacePolicy6(),
+			"fortios_firewall_interfacepolicy6list":                          dataSourceFirewallInterfacePolicy6List(),
+			"fortios_firewall_interfacepolicylist":                           dataSourceFirewallInterfacePolicyList(),
+			"fortios_firewall_internetserviceaddition":                       dataSourceFirewallInternetServiceAddition(),
+			"fortios_firewall_internetserviceadditionlist":                   dataSourceFirewallInternetServiceAdditionList(),
+			"fortios_firewall_internetserviceappend":                         dataSourceFirewallInternetServiceAppend(),
+			"fortios_firewall_internetservicebotnet":                         dataSourceFirewallInternetServiceBotnet(),
+			"fortios_firewall_internetservicebotnetlist":                     dataSourceFirewallInternetServiceBotnetList(),
+			"fortios_firewall_internetserviceipblreason":                     dataSourceFirewallInternetServiceIpblReason(),
+			"fortios_firewall_internetserviceipblreasonlist":                 dataSourceFirewallInternetServiceIpblReasonList(),
+			"fortios_firewall_internetserviceipblvendor":                     dataSourceFirewallInternetServiceIpblVendor(),
+			"fortios_firewall_internetserviceipblvendorlist":                 dataSourceFirewallInternetServiceIpblVendorList(),
+			"fortios_firewall_internetservicelistlist":                       dataSourceFirewallInternetServiceListList(),
+			"fortios_firewall_internetservicename":                           dataSourceFirewallInternetServiceName(),
+			"fortios_firewall_internetservicenamelist":                       dataSourceFirewallInternetServiceNameList(),
+			"fortios_firewall_internetserviceowner":                          dataSourceFirewallInternetServiceOwner(),
+			"fortios_firewall_internetserviceownerlist":                      dataSourceFirewallInternetServiceOwnerList(),
+			"fortios_firewall_internetservicereputation":                     dataSourceFirewallInternetServiceReputation(),
+			"fortios_firewall_internetservicereputationlist":                 dataSourceFirewallInternetServiceReputationList(),
+			"fortios_firewall_ippool":                                        dataSourceFirewallIppool(),
+			"fortios_firewall_ippool6":                                       dataSourceFirewallIppool6(),
+			"fortios_firewall_ippool6list":                                   dataSourceFirewallIppool6List(),
+			"fortios_firewall_ippoollist":                                    dataSourceFirewallIppoolList(),
+			"fortios_firewall_iptranslation":                                 dataSourceFirewallIpTranslation(),
+			"fortios_firewall_iptranslationlist":                             dataSourceFirewallIpTranslationList(),
+			"fortios_firewall_ldbmonitor":                                    dataSourceFirewallLdbMonitor(),
+			"fortios_firewall_ldbmonitorlist":                                dataSourceFirewallLdbMonitorList(),
+			"fortios_firewall_localinpolicy":                                 dataSourceFirewallLocalInPolicy(),
+			"fortios_firewall_localinpolicy6":                                dataSourceFirewallLocalInPolicy6(),
+			"fortios_firewall_localinpolicy6list":                            dataSourceFirewallLocalInPolicy6List(),
+			"fortios_firewall_localinpolicylist":                             dataSourceFirewallLocalInPolicyList(),
+			"fortios_firewall_multicastpolicy":                               dataSourceFirewallMulticastPolicy(),
+			"fortios_firewall_multicastpolicy6":                              dataSourceFirewallMulticastPolicy6(),
+			"fortios_firewall_multicastpolicy6list":                          dataSourceFirewallMulticastPolicy6List(),
+			"fortios_firewall_multicastpolicylist":                           dataSourceFirewallMulticastPolicyList(),
+			"fortios_firewall_profilegroup":                                  dataSourceFirewallProfileGroup(),
+			"fortios_firewall_profilegrouplist":                              dataSourceFirewallProfileGroupList(),
+			"fortios_firewall_region":                                        dataSourceFirewallRegion(),
+			"fortios_firewall_regionlist":                                    dataSourceFirewallRegionList(),
+			"fortios_firewall_securitypolicy":                                dataSourceFirewallSecurityPolicy(),
+			"fortios_firewall_securitypolicylist":                            dataSourceFirewallSecurityPolicyList(),
+			"fortios_firewall_shapingpolicy":                                 dataSourceFirewallShapingPolicy(),
+			"fortios_firewall_shapingpolicylist":                             dataSourceFirewallShapingPolicyList(),
+			"fortios_firewall_shapingprofile":                                dataSourceFirewallShapingProfile(),
+			"fortios_firewall_shapingprofilelist":                            dataSourceFirewallShapingProfileList(),
+			"fortios_firewall_sniffer":                                       dataSourceFirewallSniffer(),
+			"fortios_firewall_snifferlist":                                   dataSourceFirewallSnifferList(),
+			"fortios_firewall_sslserver":                                     dataSourceFirewallSslServer(),
+			"fortios_firewall_sslserverlist":                                 dataSourceFirewallSslServerList(),
+			"fortios_firewall_sslsshprofile":                                 dataSourceFirewallSslSshProfile(),
+			"fortios_firewall_sslsshprofilelist":                             dataSourceFirewallSslSshProfileList(),
+			"fortios_firewall_trafficclass":                                  dataSourceFirewallTrafficClass(),
+			"fortios_firewall_trafficclasslist":                              dataSourceFirewallTrafficClassList(),
+			"fortios_firewall_ttlpolicy":                                     dataSourceFirewallTtlPolicy(),
+			"fortios_firewall_ttlpolicylist":                                 dataSourceFirewallTtlPolicyList(),
+			"fortios_firewall_vendormac":                                     dataSourceFirewallVendorMac(),
+			"fortios_firewall_vendormaclist":                                 dataSourceFirewallVendorMacList(),
+			"fortios_firewall_vip":                                           dataSourceFirewallVip(),
+			"fortios_firewall_vip46":                                         dataSourceFirewallVip46(),
+			"fortios_firewall_vip46list":                                     dataSourceFirewallVip46List(),
+			"fortios_firewall_vip6":                                          dataSourceFirewallVip6(),
+			"fortios_firewall_vip64":                                         dataSourceFirewallVip64(),
+			"fortios_firewall_vip64list":                                     dataSourceFirewallVip64List(),
+			"fortios_firewall_vip6list":                                      dataSourceFirewallVip6List(),
+			"fortios_firewall_vipgrp":                                        dataSourceFirewallVipgrp(),
+			"fortios_firewall_vipgrp46":                                      dataSourceFirewallVipgrp46(),
+			"fortios_firewall_vipgrp46list":                                  dataSourceFirewallVipgrp46List(),
+			"fortios_firewall_vipgrp6":                                       dataSourceFirewallVipgrp6(),
+			"fortios_firewall_vipgrp64":                                      dataSourceFirewallVipgrp64(),
+			"fortios_firewall_vipgrp64list":                                  dataSourceFirewallVipgrp64List(),
+			"fortios_firewall_vipgrp6list":                                   dataSourceFirewallVipgrp6List(),
+			"fortios_firewall_vipgrplist":                                    dataSourceFirewallVipgrpList(),
+			"fortios_firewall_viplist":                                       dataSourceFirewallVipList(),
+			"fortios_firewallipmacbinding_setting":                           dataSourceFirewallIpmacbindingSetting(),
+			"fortios_firewallipmacbinding_table":                             dataSourceFirewallIpmacbindingTable(),
+			"fortios_firewallipmacbinding_tablelist":                         dataSourceFirewallIpmacbindingTableList(),
+			"fortios_firewallssh_hostkey":                                    dataSourceFirewallSshHostKey(),
+			"fortios_firewallssh_hostkeylist":                                dataSourceFirewallSshHostKeyList(),
+			"fortios_firewallssh_localca":                                    dataSourceFirewallSshLocalCa(),
+			"fortios_firewallssh_localcalist":                                dataSourceFirewallSshLocalCaList(),
+			"fortios_firewallssh_localkey":                                   dataSourceFirewallSshLocalKey(),
+			"fortios_firewallssh_localkeylist":                               dataSourceFirewallSshLocalKeyList(),
+			"fortios_firewallssh_setting":                                    dataSourceFirewallSshSetting(),
+			"fortios_firewallssl_setting":                                    dataSourceFirewallSslSetting(),
+			"fortios_ftpproxy_explicit":                                      dataSourceFtpProxyExplicit(),
+			"fortios_icap_profile":                                           dataSourceIcapProfile(),
+			"fortios_icap_profilelist":                                       dataSourceIcapProfileList(),
+			"fortios_icap_server":                                            dataSourceIcapServer(),
+			"fortios_icap_serverlist":                                        dataSourceIcapServerList(),
+			"fortios_ips_custom":                                             dataSourceIpsCustom(),
+			"fortios_ips_customlist":                                         dataSourceIpsCustomList(),
+			"fortios_ips_decoder":                                            dataSourceIpsDecoder(),
+			"fortios_ips_decoderlist":                                        dataSourceIpsDecoderList(),
+			"fortios_ips_global":                                             dataSourceIpsGlobal(),
+			"fortios_ips_rule":                                               dataSourceIpsRule(),
+			"fortios_ips_rulelist":                                           dataSourceIpsRuleList(),
+			"fortios_ips_rulesettings":                                       dataSourceIpsRuleSettings(),
+			"fortios_ips_rulesettingslist":                                   dataSourceIpsRuleSettingsList(),
+			"fortios_ips_sensor":                                             dataSourceIpsSensor(),
+			"fortios_ips_sensorlist":                                         dataSourceIpsSensorList(),
+			"fortios_ips_settings":                                           dataSourceIpsSettings(),
+			"fortios_ips_viewmap":                                            dataSourceIpsViewMap(),
+			"fortios_ips_viewmaplist":                                        dataSourceIpsViewMapList(),
+			"fortios_log_customfield":                                        dataSourceLogCustomField(),
+			"fortios_log_customfieldlist":                                    dataSourceLogCustomFieldList(),
+			"fortios_log_eventfilter":                                        dataSourceLogEventfilter(),
+			"fortios_log_guidisplay":                                         dataSourceLogGuiDisplay(),
+			"fortios_log_setting":                                            dataSourceLogSetting(),
+			"fortios_log_threatweight":                                       dataSourceLogThreatWeight(),
+			"fortios_logdisk_filter":                                         dataSourceLogDiskFilter(),
+			"fortios_logdisk_setting":                                        dataSourceLogDiskSetting(),
+			"fortios_logfortianalyzer2_filter":                               dataSourceLogFortianalyzer2Filter(),
+			"fortios_logfortianalyzer2_overridefilter":                       dataSourceLogFortianalyzer2OverrideFilter(),
+			"fortios_logfortianalyzer2_overridesetting":                      dataSourceLogFortianalyzer2OverrideSetting(),
+			"fortios_logfortianalyzer2_setting":                              dataSourceLogFortianalyzer2Setting(),
+			"fortios_logfortianalyzer3_filter":                               dataSourceLogFortianalyzer3Filter(),
+			"fortios_logfortianalyzer3_overridefilter":                       dataSourceLogFortianalyzer3OverrideFilter(),
+			"fortios_logfortianalyzer3_overridesetting":                      dataSourceLogFortianalyzer3OverrideSetting(),
+			"fortios_logfortianalyzer3_setting":                              dataSourceLogFortianalyzer3Setting(),
+			"fortios_logfortianalyzer_filter":                                dataSourceLogFortianalyzerFilter(),
+			"fortios_logfortianalyzer_overridefilter":                        dataSourceLogFortianalyzerOverrideFilter(),
+			"fortios_logfortianalyzer_overridesetting":                       dataSourceLogFortianalyzerOverrideSetting(),
+			"fortios_logfortianalyzer_setting":                               dataSourceLogFortianalyzerSetting(),
+			"fortios_logfortianalyzercloud_filter":                           dataSourceLogFortianalyzerCloudFilter(),
+			"fortios_logfortianalyzercloud_overridefilter":                   dataSourceLogFortianalyzerCloudOverrideFilter(),
+			"fortios_logfortianalyzercloud_overridesetting":                  dataSourceLogFortianalyzerCloudOverrideSetting(),
+			"fortios_logfortianalyzercloud_setting":                          dataSourceLogFortianalyzerCloudSetting(),
+			"fortios_logfortiguard_filter":                                   dataSourceLogFortiguardFilter(),
+			"fortios_logfortiguard_overridefilter":                           dataSourceLogFortiguardOverrideFilter(),
+			"fortios_logfortiguard_overridesetting":                          dataSourceLogFortiguardOverrideSetting(),
+			"fortios_logfortiguard_setting":                                  dataSourceLogFortiguardSetting(),
+			"fortios_logmemory_filter":                                       dataSourceLogMemoryFilter(),
+			"fortios_logmemory_globalsetting":                                dataSourceLogMemoryGlobalSetting(),
+			"fortios_logmemory_setting":                                      dataSourceLogMemorySetting(),
+			"fortios_lognulldevice_filter":                                   dataSourceLogNullDeviceFilter(),
+			"fortios_lognulldevice_setting":                                  dataSourceLogNullDeviceSetting(),
+			"fortios_logsyslogd2_filter":                                     dataSourceLogSyslogd2Filter(),
+			"fortios_logsyslogd2_overridefilter":                             dataSourceLogSyslogd2OverrideFilter(),
+			"fortios_logsyslogd2_overridesetting":                            dataSourceLogSyslogd2OverrideSetting(),
+			"fortios_logsyslogd2_setting":                                    dataSourceLogSyslogd2Setting(),
+			"fortios_logsyslogd3_filter":                                     dataSourceLogSyslogd3Filter(),
+			"fortios_logsyslogd3_overridefilter":                             dataSourceLogSyslogd3OverrideFilter(),
+			"fortios_logsyslogd3_overridesetting":                            dataSourceLogSyslogd3OverrideSetting(),
+			"fortios_logsyslogd3_setting":                                    dataSourceLogSyslogd3Setting(),
+			"fortios_logsyslogd4_filter":                                     dataSourceLogSyslogd4Filter(),
+			"fortios_logsyslogd4_overridefilter":                             dataSourceLogSyslogd4OverrideFilter(),
+			"fortios_logsyslogd4_overridesetting":                            dataSourceLogSyslogd4OverrideSetting(),
+			"fortios_logsyslogd4_setting":                                    dataSourceLogSyslogd4Setting(),
+			"fortios_logsyslogd_filter":                                      dataSourceLogSyslogdFilter(),
+			"fortios_logsyslogd_overridefilter":                              dataSourceLogSyslogdOverrideFilter(),
+			"fortios_logsyslogd_overridesetting":                             dataSourceLogSyslogdOverrideSetting(),
+			"fortios_logsyslogd_setting":                                     dataSourceLogSyslogdSetting(),
+			"fortios_logtacacsaccounting2_filter":                            dataSourceLogTacacsAccounting2Filter(),
+			"fortios_logtacacsaccounting2_setting":                           dataSourceLogTacacsAccounting2Setting(),
+			"fortios_logtacacsaccounting3_filter":                            dataSourceLogTacacsAccounting3Filter(),
+			"fortios_logtacacsaccounting3_setting":                           dataSourceLogTacacsAccounting3Setting(),
+			"fortios_logtacacsaccounting_filter":                             dataSourceLogTacacsAccountingFilter(),
+			"fortios_logtacacsaccounting_setting":                            dataSourceLogTacacsAccountingSetting(),
+			"fortios_logwebtrends_filter":                                    dataSourceLogWebtrendsFilter(),
+			"fortios_logwebtrends_setting":                                   dataSourceLogWebtrendsSetting(),
+			"fortios_nsxt_servicechain":                                      dataSourceNsxtServiceChain(),
+			"fortios_nsxt_servicechainlist":                                  dataSourceNsxtServiceChainList(),
+			"fortios_nsxt_setting":                                           dataSourceNsxtSetting(),
+			"fortios_report_chart":                                           dataSourceReportChart(),
+			"fortios_report_chartlist":                                       dataSourceReportChartList(),
+			"fortios_report_dataset":                                         dataSourceReportDataset(),
+			"fortios_report_datasetlist":                                     dataSourceReportDatasetList(),
+			"fortios_report_layout":                                          dataSourceReportLayout(),
+			"fortios_report_layoutlist":                                      dataSourceReportLayoutList(),
+			"fortios_report_setting":                                         dataSourceReportSetting(),
+			"fortios_report_style":                                           dataSourceReportStyle(),
+			"fortios_report_stylelist":                                       dataSourceReportStyleList(),
+			"fortios_report_theme":                                           dataSourceReportTheme(),
+			"fortios_report_themelist":                                       dataSourceReportThemeList(),
+			"fortios_routerbgp_network":                                      dataSourceRouterbgpNetwork(),
+			"fortios_routerbgp_network6":                                     dataSourceRouterbgpNetwork6(),
+			"fortios_routerbgp_network6list":                                 dataSourceRouterbgpNetwork6List(),
+			"fortios_routerbgp_networklist":                                  dataSourceRouterbgpNetworkList(),
+			"fortios_routerospf6_ospf6interface":                             dataSourceRouterospf6Ospf6Interface(),
+			"fortios_routerospf6_ospf6interfacelist":                         dataSourceRouterospf6Ospf6InterfaceList(),
+			"fortios_routerospf_neighbor":                                    dataSourceRouterospfNeighbor(),
+			"fortios_routerospf_neighborlist":                                dataSourceRouterospfNeighborList(),
+			"fortios_routerospf_network":                                     dataSourceRouterospfNetwork(),
+			"fortios_routerospf_networklist":                                 dataSourceRouterospfNetworkList(),
+			"fortios_routerospf_ospfinterface":                               dataSourceRouterospfOspfInterface(),
+			"fortios_routerospf_ospfinterfacelist":                           dataSourceRouterospfOspfInterfaceList(),
+			"fortios_sctpfilter_profile":                                     dataSourceSctpFilterProfile(),
+			"fortios_sctpfilter_profilelist":                                 dataSourceSctpFilterProfileList(),
+			"fortios_spamfilter_bwl":                                         dataSourceSpamfilterBwl(),
+			"fortios_spamfilter_bwllist":                                     dataSourceSpamfilterBwlList(),
+			"fortios_spamfilter_bword":                                       dataSourceSpamfilterBword(),
+			"fortios_spamfilter_bwordlist":                                   dataSourceSpamfilterBwordList(),
+			"fortios_spamfilter_dnsbl":                                       dataSourceSpamfilterDnsbl(),
+			"fortios_spamfilter_dnsbllist":                                   dataSourceSpamfilterDnsblList(),
+			"fortios_spamfilter_fortishield":                                 dataSourceSpamfilterFortishield(),
+			"fortios_spamfilter_iptrust":                                     dataSourceSpamfilterIptrust(),
+			"fortios_spamfilter_iptrustlist":                                 dataSourceSpamfilterIptrustList(),
+			"fortios_spamfilter_mheader":                                     dataSourceSpamfilterMheader(),
+			"fortios_spamfilter_mheaderlist":                                 dataSourceSpamfilterMheaderList(),
+			"fortios_spamfilter_options":                                     dataSourceSpamfilterOptions(),
+			"fortios_spamfilter_profile":                                     dataSourceSpamfilterProfile(),
+			"fortios_spamfilter_profilelist":                                 dataSourceSpamfilterProfileList(),
+			"fortios_sshfilter_profile":                                      dataSourceSshFilterProfile(),
+			"fortios_sshfilter_profilelist":                                  dataSourceSshFilterProfileList(),
+			"fortios_switchcontroller_8021Xsettings":                         dataSourceSwitchController8021XSettings(),
+			"fortios_switchcontroller_customcommand":                         dataSourceSwitchControllerCustomCommand(),
+			"fortios_switchcontroller_customcommandlist":                     dataSourceSwitchControllerCustomCommandList(),
+			"fortios_switchcontroller_dynamicportpolicy":                     dataSourceSwitchControllerDynamicPortPolicy(),
+			"fortios_switchcontroller_dynamicportpolicylist":                 dataSourceSwitchControllerDynamicPortPolicyList(),
+			"fortios_switchcontroller_flowtracking":                          dataSourceSwitchControllerFlowTracking(),
+			"fortios_switchcontroller_fortilinksettings":                     dataSourceSwitchControllerFortilinkSettings(),
+			"fortios_switchcontroller_fortilinksettingslist":                 dataSourceSwitchControllerFortilinkSettingsList(),
+			"fortios_switchcontroller_global":                                dataSourceSwitchControllerGlobal(),
+			"fortios_switchcontroller_igmpsnooping":                          dataSourceSwitchControllerIgmpSnooping(),
+			"fortios_switchcontroller_lldpprofile":                           dataSourceSwitchControllerLldpProfile(),
+			"fortios_switchcontroller_lldpprofilelist":                       dataSourceSwitchControllerLldpProfileList(),
+			"fortios_switchcontroller_lldpsettings":                          dataSourceSwitchControllerLldpSettings(),
+			"fortios_switchcontroller_location":                              dataSourceSwitchControllerLocation(),
+			"fortios_switchcontroller_locationlist":                          dataSourceSwitchControllerLocationList(),
+			"fortios_switchcontroller_macsyncsettings":                       dataSourceSwitchControllerMacSyncSettings(),
+			"fortios_switchcontroller_managedswitch":                         dataSourceSwitchControllerManagedSwitch(),
+			"fortios_switchcontroller_managedswitchlist":                     dataSourceSwitchControllerManagedSwitchList(),
+			"fortios_switchcontroller_nacdevice":                             dataSourceSwitchControllerNacDevice(),
+			"fortios_switchcontroller_nacdevicelist":                         dataSourceSwitchControllerNacDeviceList(),
+			"fortios_switchcontroller_nacsettings":                           dataSourceSwitchControllerNacSettings(),
+			"fortios_switchcontroller_nacsettingslist":                       dataSourceSwitchControllerNacSettingsList(),
+			"fortios_switchcontroller_networkmonitorsettings":                dataSourceSwitchControllerNetworkMonitorSettings(),
+			"fortios_switchcontroller_portpolicy":                            dataSourceSwitchControllerPortPolicy(),
+			"fortios_switchcontroller_portpolicylist":                        dataSourceSwitchControllerPortPolicyList(),
+			"fortios_switchcontroller_quarantine":                            dataSourceSwitchControllerQuarantine(),
+			"fortios_switchcontroller_remotelog":                             dataSourceSwitchControllerRemoteLog(),
+			"fortios_switchcontroller_remoteloglist":                         dataSourceSwitchControllerRemoteLogList(),
+			"fortios_switchcontroller_sflow":                                 dataSourceSwitchControllerSflow(),
+			"fortios_switchcontroller_snmpcommunity":                         dataSourceSwitchControllerSnmpCommunity(),
+			"fortios_switchcontroller_snmpcommunitylist":                     dataSourceSwitchControllerSnmpCommunityList(),
+			"fortios_switchcontroller_snmpsysinfo":                           dataSourceSwitchControllerSnmpSysinfo(),
+			"fortios_switchcontroller_snmptrapthreshold":                     dataSourceSwitchControllerSnmpTrapThreshold(),
+			"fortios_switchcontroller_snmpuser":                              dataSourceSwitchControllerSnmpUser(),
+			"fortios_switchcontroller_snmpuserlist":                          dataSourceSwitchControllerSnmpUserList(),
+			"fortios_switchcontroller_stormcontrol":                          dataSourceSwitchControllerStormControl(),
+			"fortios_switchcontroller_stormcontrolpolicy":                    dataSourceSwitchControllerStormControlPolicy(),
+			"fortios_switchcontroller_stormcontrolpolicylist":                dataSourceSwitchControllerStormControlPolicyList(),
+			"fortios_switchcontroller_stpinstance":                           dataSourceSwitchControllerStpInstance(),
+			"fortios_switchcontroller_stpinstancelist":                       dataSourceSwitchControllerStpInstanceList(),
+			"fortios_switchcontroller_stpsettings":                           dataSourceSwitchControllerStpSettings(),
+			"fortios_switchcontroller_switchgroup":                           dataSourceSwitchControllerSwitchGroup(),
+			"fortios_switchcontroller_switchgrouplist":                       dataSourceSwitchControllerSwitchGroupList(),
+			"fortios_switchcontroller_switchinterfacetag":                    dataSourceSwitchControllerSwitchInterfaceTag(),
+			"fortios_switchcontroller_switchinterfacetaglist":                dataSourceSwitchControllerSwitchInterfaceTagList(),
+			"fortios_switchcontroller_switchlog":                             dataSourceSwitchControllerSwitchLog(),
+			"fortios_switchcontroller_switchprofile":                         dataSourceSwitchControllerSwitchProfile(),
+			"fortios_switchcontroller_switchprofilelist":                     dataSourceSwitchControllerSwitchProfileList(),
+			"fortios_switchcontroller_system":                                dataSourceSwitchControllerSystem(),
+			"fortios_switchcontroller_trafficpolicy":                         dataSourceSwitchControllerTrafficPolicy(),
+			"fortios_switchcontroller_trafficpolicylist":                     dataSourceSwitchControllerTrafficPolicyList(),
+			"fortios_switchcontroller_trafficsniffer":                        dataSourceSwitchControllerTrafficSniffer(),
+			"fortios_switchcontroller_virtualportpool":                       dataSourceSwitchControllerVirtualPortPool(),
+			"fortios_switchcontroller_virtualportpoollist":                   dataSourceSwitchControllerVirtualPortPoolList(),
+			"fortios_switchcontroller_vlan":                                  dataSourceSwitchControllerVlan(),
+			"fortios_switchcontroller_vlanlist":                              dataSourceSwitchControllerVlanList(),
+			"fortios_switchcontroller_vlanpolicy":                            dataSourceSwitchControllerVlanPolicy(),
+			"fortios_switchcontroller_vlanpolicylist":                        dataSourceSwitchControllerVlanPolicyList(),
+			"fortios_switchcontrollerautoconfig_custom":                      dataSourceSwitchControllerAutoConfigCustom(),
+			"fortios_switchcontrollerautoconfig_customlist":                  dataSourceSwitchControllerAutoConfigCustomList(),
+			"fortios_switchcontrollerautoconfig_default":                     dataSourceSwitchControllerAutoConfigDefault(),
+			"fortios_switchcontrollerautoconfig_policy":                      dataSourceSwitchControllerAutoConfigPolicy(),
+			"fortios_switchcontrollerautoconfig_policylist":                  dataSourceSwitchControllerAutoConfigPolicyList(),
+			"fortios_switchcontrollerinitialconfig_template":                 dataSourceSwitchControllerInitialConfigTemplate(),
+			"fortios_switchcontrollerinitialconfig_templatelist":             dataSourceSwitchControllerInitialConfigTemplateList(),
+			"fortios_switchcontrollerinitialconfig_vlans":                    dataSourceSwitchControllerInitialConfigVlans(),
+			"fortios_switchcontrollerptp_policy":                             dataSourceSwitchControllerPtpPolicy(),
+			"fortios_switchcontrollerptp_policylist":                         dataSourceSwitchControllerPtpPolicyList(),
+			"fortios_switchcontrollerptp_settings":                           dataSourceSwitchControllerPtpSettings(),
+			"fortios_switchcontrollerqos_dot1pmap":                           dataSourceSwitchControllerQosDot1PMap(),
+			"fortios_switchcontrollerqos_dot1pmaplist":                       dataSourceSwitchControllerQosDot1PMapList(),
+			"fortios_switchcontrollerqos_ipdscpmap":                          dataSourceSwitchControllerQosIpDscpMap(),
+			"fortios_switchcontrollerqos_ipdscpmaplist":                      dataSourceSwitchControllerQosIpDscpMapList(),
+			"fortios_switchcontrollerqos_qospolicy":                          dataSourceSwitchControllerQosQosPolicy(),
+			"fortios_switchcontrollerqos_qospolicylist":                      dataSourceSwitchControllerQosQosPolicyList(),
+			"fortios_switchcontrollerqos_queuepolicy":                        dataSourceSwitchControllerQosQueuePolicy(),
+			"fortios_switchcontrollerqos_queuepolicylist":                    dataSourceSwitchControllerQosQueuePolicyList(),
+			"fortios_switchcontrollersecuritypolicy_8021X":                   dataSourceSwitchControllerSecurityPolicy8021X(),
+			"fortios_switchcontrollersecuritypolicy_8021Xlist":               dataSourceSwitchControllerSecurityPolicy8021XList(),
+			"fortios_switchcontrollersecuritypolicy_captiveportal":           dataSourceSwitchControllerSecurityPolicyCaptivePortal(),
+			"fortios_switchcontrollersecuritypolicy_captiveportallist":       dataSourceSwitchControllerSecurityPolicyCaptivePortalList(),
+			"fortios_switchcontrollersecuritypolicy_localaccess":             dataSourceSwitchControllerSecurityPolicyLocalAccess(),
+			"fortios_switchcontrollersecuritypolicy_localaccesslist":         dataSourceSwitchControllerSecurityPolicyLocalAccessList(),
+			"fortios_system3gmodem_custom":                                   dataSourceSystem3GModemCustom(),
+			"fortios_system3gmodem_customlist":                               dataSourceSystem3GModemCustomList(),
+			"fortios_system_acme":                                            dataSourceSystemAcme(),
+			"fortios_system_affinityinterrupt":                               dataSourceSystemAffinityInterrupt(),
+			"fortios_system_affinityinterruptlist":                           dataSourceSystemAffinityInterruptList(),
+			"fortios_system_affinitypacketredistribution":                    dataSourceSystemAffinityPacketRedistribution(),
+			"fortios_system_affinitypacketredistributionlist":                dataSourceSystemAffinityPacketRedistributionList(),
+			"fortios_system_alarm":                                           dataSourceSystemAlarm(),
+			"fortios_system_automationstitch":                                dataSourceSystemAutomationStitch(),
+			"fortios_system_automationstitchlist":                            dataSourceSystemAutomationStitchList(),
+			"fortios_system_customlanguage":                                  dataSourceSystemCustomLanguage(),
+			"fortios_system_customlanguagelist":                              dataSourceSystemCustomLanguageList(),
+			"fortios_system_dedicatedmgmt":                                   dataSourceSystemDedicatedMgmt(),
+			"fortios_system_dns64":                                           dataSourceSystemDns64(),
+			"fortios_system_federatedupgrade":                                dataSourceSystemFederatedUpgrade(),
+			"fortios_system_fortiai":                                         dataSourceSystemFortiai(),
+			"fortios_system_geneve":                                          dataSourceSystemGeneve(),
+			"fortios_system_genevelist":                                      dataSourceSystemGeneveList(),
+			"fortios_system_geoipcountry":                                    dataSourceSystemGeoipCountry(),
+			"fortios_system_geoipcountrylist":                                dataSourceSystemGeoipCountryList(),
+			"fortios_system_geoipoverride":                                   dataSourceSystemGeoipOverride(),
+			"fortios_system_geoipoverridelist":                               dataSourceSystemGeoipOverrideList(),
+			"fortios_system_ike":                                             dataSourceSystemIke(),
+			"fortios_system_ipam":                                            dataSourceSystemIpam(),
+			"fortios_system_ips":                                             dataSourceSystemIps(),
+			"fortios_system_ipsecaggregate":                                  dataSourceSystemIpsecAggregate(),
+			"fortios_system_ipsecaggregatelist":                              dataSourceSystemIpsecAggregateList(),
+			"fortios_system_ipsurlfilterdns":                                 dataSourceSystemIpsUrlfilterDns(),
+			"fortios_system_ipsurlfilterdns6":                                dataSourceSystemIpsUrlfilterDns6(),
+			"fortios_system_ipsurlfilterdns6list":                            dataSourceSystemIpsUrlfilterDns6List(),
+			"fortios_system_ipsurlfilterdnslist":                             dataSourceSystemIpsUrlfilterDnsList(),
+			"fortios_system_ltemodem":                                        dataSourceSystemLteModem(),
+			"fortios_system_macaddresstable":                                 dataSourceSystemMacAddressTable(),
+			"fortios_system_macaddresstablelist":                             dataSourceSystemMacAddressTableList(),
+			"fortios_system_modem":                                           dataSourceSystemModem(),
+			"fortios_system_npu":                                             dataSourceSystemNpu(),
+			"fortios_system_physicalswitch":                                  dataSourceSystemPhysicalSwitch(),
+			"fortios_system_physicalswitchlist":                              dataSourceSystemPhysicalSwitchList(),
+			"fortios_system_ptp":                                             dataSourceSystemPtp(),
+			"fortios_system_saml":                                            dataSourceSystemSaml(),
+			"fortios_system_sdwan":                                           dataSourceSystemSdwan(),
+			"fortios_system_settings":                                        dataSourceSystemSettings(),
+			"fortios_system_speedtestschedule":                               dataSourceSystemSpeedTestSchedule(),
+			"fortios_system_speedtestschedulelist":                           dataSourceSystemSpeedTestScheduleList(),
+			"fortios_system_speedtestserver":                                 dataSourceSystemSpeedTestServer(),
+			"fortios_system_speedtestserverlist":                             dataSourceSystemSpeedTestServerList(),
+			"fortios_system_ssoadmin":                                        dataSourceSystemSsoAdmin(),
+			"fortios_system_ssoadminlist":                                    dataSourceSystemSsoAdminList(),
+			"fortios_system_ssoforticloudadmin":                              dataSourceSystemSsoForticloudAdmin(),
+			"fortios_system_ssoforticloudadminlist":                          dataSourceSystemSsoForticloudAdminList(),
+			"fortios_system_standalonecluster":                               dataSourceSystemStandaloneCluster(),
+			"fortios_system_storage":                                         dataSourceSystemStorage(),
+			"fortios_system_storagelist":                                     dataSourceSystemStorageList(),
+			"fortios_system_stp":                                             dataSourceSystemStp(),
+			"fortios_system_switchinterface":                                 dataSourceSystemSwitchInterface(),
+			"fortios_system_switchinterfacelist":                             dataSourceSystemSwitchInterfaceList(),
+			"fortios_system_vdom":                                            dataSourceSystemVdom(),
+			"fortios_system_vdomdns":                                         dataSourceSystemVdomDns(),
+			"fortios_system_vdomlink":                                        dataSourceSystemVdomLink(),
+			"fortios_system_vdomlinklist":                                    dataSourceSystemVdomLinkList(),
+			"fortios_system_vdomlist":                                        dataSourceSystemVdomList(),
+			"fortios_system_vdomproperty":                                    dataSourceSystemVdomProperty(),
+			"fortios_system_vdompropertylist":                                dataSourceSystemVdomPropertyList(),
+			"fortios_system_vdomradiusserver":                                dataSourceSystemVdomRadiusServer(),
+			"fortios_system_vdomradiusserverlist":                            dataSourceSystemVdomRadiusServerList(),
+			"fortios_system_virtualswitch":                                   dataSourceSystemVirtualSwitch(),
+			"fortios_system_virtualswitchlist":                               dataSourceSystemVirtualSwitchList(),
+			"fortios_system_virtualwirepair":                                 dataSourceSystemVirtualWirePair(),
+			"fortios_system_virtualwirepairlist":                             dataSourceSystemVirtualWirePairList(),
+			"fortios_system_vnetunnel":                                       dataSourceSystemVneTunnel(),
+			"fortios_systemdhcp6_server":                                     dataSourceSystemDhcp6Server(),
+			"fortios_systemdhcp6_serverlist":                                 dataSourceSystemDhcp6ServerList(),
+			"fortios_systemreplacemsg_admin":                                 dataSourceSystemReplacemsgAdmin(),
+			"fortios_systemreplacemsg_adminlist":                             dataSourceSystemReplacemsgAdminList(),
+			"fortios_systemreplacemsg_alertmail":                             dataSourceSystemReplacemsgAlertmail(),
+			"fortios_systemreplacemsg_alertmaillist":                         dataSourceSystemReplacemsgAlertmailList(),
+			"fortios_systemreplacemsg_auth":                                  dataSourceSystemReplacemsgAuth(),
+			"fortios_systemreplacemsg_authlist":                              dataSourceSystemReplacemsgAuthList(),
+			"fortios_systemreplacemsg_automation":                            dataSourceSystemReplacemsgAutomation(),
+			"fortios_systemreplacemsg_automationlist":                        dataSourceSystemReplacemsgAutomationList(),
+			"fortios_systemreplacemsg_devicedetectionportal":                 dataSourceSystemReplacemsgDeviceDetectionPortal(),
+			"fortios_systemreplacemsg_devicedetectionportallist":             dataSourceSystemReplacemsgDeviceDetectionPortalList(),
+			"fortios_systemreplacemsg_ec":                                    dataSourceSystemReplacemsgEc(),
+			"fortios_systemreplacemsg_eclist":                                dataSourceSystemReplacemsgEcList(),
+			"fortios_systemreplacemsg_fortiguardwf":                          dataSourceSystemReplacemsgFortiguardWf(),
+			"fortios_systemreplacemsg_fortiguardwflist":                      dataSourceSystemReplacemsgFortiguardWfList(),
+			"fortios_systemreplacemsg_ftp":                                   dataSourceSystemReplacemsgFtp(),
+			"fortios_systemreplacemsg_ftplist":                               dataSourceSystemReplacemsgFtpList(),
+			"fortios_systemreplacemsg_http":                                  dataSourceSystemReplacemsgHttp(),
+			"fortios_systemreplacemsg_httplist":                              dataSourceSystemReplacemsgHttpList(),
+			"fortios_systemreplacemsg_icap":                                  dataSourceSystemReplacemsgIcap(),
+			"fortios_systemreplacemsg_icaplist":                              dataSourceSystemReplacemsgIcapList(),
+			"fortios_systemreplacemsg_mail":                                  dataSourceSystemReplacemsgMail(),
+			"fortios_systemreplacemsg_maillist":                              dataSourceSystemReplacemsgMailList(),
+			"fortios_systemreplacemsg_nacquar":                               dataSourceSystemReplacemsgNacQuar(),
+			"fortios_systemreplacemsg_nacquarlist":                           dataSourceSystemReplacemsgNacQuarList(),
+			"fortios_systemreplacemsg_nntp":                                  dataSourceSystemReplacemsgNntp(),
+			"fortios_systemreplacemsg_nntplist":                              dataSourceSystemReplacemsgNntpList(),
+			"fortios_systemreplacemsg_spam":                                  dataSourceSystemReplacemsgSpam(),
+			"fortios_systemreplacemsg_spamlist":                              dataSourceSystemReplacemsgSpamList(),
+			"fortios_systemreplacemsg_sslvpn":                                dataSourceSystemReplacemsgSslvpn(),
+			"fortios_systemreplacemsg_sslvpnlist":                            dataSourceSystemReplacemsgSslvpnList(),
+			"fortios_systemreplacemsg_trafficquota":                          dataSourceSystemReplacemsgTrafficQuota(),
+			"fortios_systemreplacemsg_trafficquotalist":                      dataSourceSystemReplacemsgTrafficQuotaList(),
+			"fortios_systemreplacemsg_utm":                                   dataSourceSystemReplacemsgUtm(),
+			"fortios_systemreplacemsg_utmlist":                               dataSourceSystemReplacemsgUtmList(),
+			"fortios_systemreplacemsg_webproxy":                              dataSourceSystemReplacemsgWebproxy(),
+			"fortios_systemreplacemsg_webproxylist":                          dataSourceSystemReplacemsgWebproxyList(),
+			"fortios_user_adgrp":                                             dataSourceUserAdgrp(),
+			"fortios_user_adgrplist":                                         dataSourceUserAdgrpList(),
+			"fortios_user_certificate":                                       dataSourceUserCertificate(),
+			"fortios_user_certificatelist":                                   dataSourceUserCertificateList(),
+			"fortios_user_device":                                            dataSourceUserDevice(),
+			"fortios_user_deviceaccesslist":                                  dataSourceUserDeviceAccessList(),
+			"fortios_user_deviceaccesslistlist":                              dataSourceUserDeviceAccessListList(),
+			"fortios_user_devicecategory":                                    dataSourceUserDeviceCategory(),
+			"fortios_user_devicecategorylist":                                dataSourceUserDeviceCategoryList(),
+			"fortios_user_devicegroup":                                       dataSourceUserDeviceGroup(),
+			"fortios_user_devicegrouplist":                                   dataSourceUserDeviceGroupList(),
+			"fortios_user_devicelist":                                        dataSourceUserDeviceList(),
+			"fortios_user_domaincontroller":                                  dataSourceUserDomainController(),
+			"fortios_user_domaincontrollerlist":                              dataSourceUserDomainControllerList(),
+			"fortios_user_exchange":                                          dataSourceUserExchange(),
+			"fortios_user_exchangelist":                                      dataSourceUserExchangeList(),
+			"fortios_user_fortitoken":                                        dataSourceUserFortitoken(),
+			"fortios_user_fortitokenlist":                                    dataSourceUserFortitokenList(),
+			"fortios_user_fsso":                                              dataSourceUserFsso(),
+			"fortios_user_fssolist":                                          dataSourceUserFssoList(),
+			"fortios_user_fssopolling":                                       dataSourceUserFssoPolling(),
+			"fortios_user_fssopollinglist":                                   dataSourceUserFssoPollingList(),
+			"fortios_user_group":                                             dataSourceUserGroup(),
+			"fortios_user_grouplist":                                         dataSourceUserGroupList(),
+			"fortios_user_krbkeytab":                                         dataSourceUserKrbKeytab(),
+			"fortios_user_krbkeytablist":                                     dataSourceUserKrbKeytabList(),
+			"fortios_user_ldap":                                              dataSourceUserLdap(),
+			"fortios_user_ldaplist":                                          dataSourceUserLdapList(),
+			"fortios_user_local":                                             dataSourceUserLocal(),
+			"fortios_user_locallist":                                         dataSourceUserLocalList(),
+			"fortios_user_nacpolicy":                                         dataSourceUserNacPolicy(),
+			"fortios_user_nacpolicylist":                                     dataSourceUserNacPolicyList(),
+			"fortios_user_passwordpolicy":                                    dataSourceUserPasswordPolicy(),
+			"fortios_user_passwordpolicylist":                                dataSourceUserPasswordPolicyList(),
+			"fortios_user_peer":                                              dataSourceUserPeer(),
+			"fortios_user_peergrp":                                           dataSourceUserPeergrp(),
+			"fortios_user_peergrplist":                                       dataSourceUserPeergrpList(),
+			"fortios_user_peerlist":                                          dataSourceUserPeerList(),
+			"fortios_user_pop3":                                              dataSourceUserPop3(),
+			"fortios_user_pop3list":                                          dataSourceUserPop3List(),
+			"fortios_user_quarantine":                                        dataSourceUserQuarantine(),
+			"fortios_user_radius":                                            dataSourceUserRadius(),
+			"fortios_user_radiuslist":                                        dataSourceUserRadiusList(),
+			"fortios_user_securityexemptlist":                                dataSourceUserSecurityExemptList(),
+			"fortios_user_securityexemptlistlist":                            dataSourceUserSecurityExemptListList(),
+			"fortios_user_setting":                                           dataSourceUserSetting(),
+			"fortios_user_tacacs":                                            dataSourceUserTacacs(),
+			"fortios_user_tacacslist":                                        dataSourceUserTacacsList(),
+			"fortios_videofilter_profile":                                    dataSourceVideofilterProfile(),
+			"fortios_videofilter_profilelist":                                dataSourceVideofilterProfileList(),
+			"fortios_videofilter_youtubechannelfilter":                       dataSourceVideofilterYoutubeChannelFilter(),
+			"fortios_videofilter_youtubechannelfilterlist":                   dataSourceVideofilterYoutubeChannelFilterList(),
+			"fortios_videofilter_youtubekey":                                 dataSourceVideofilterYoutubeKey(),
+			"fortios_videofilter_youtubekeylist":                             dataSourceVideofilterYoutubeKeyList(),
+			"fortios_voip_profile":                                           dataSourceVoipProfile(),
+			"fortios_voip_profilelist":                                       dataSourceVoipProfileList(),
+			"fortios_vpn_l2tp":                                               dataSourceVpnL2Tp(),
+			"fortios_vpn_ocvpn":                                              dataSourceVpnOcvpn(),
+			"fortios_vpn_pptp":                                               dataSourceVpnPptp(),
+			"fortios_vpncertificate_ca":                                      dataSourceVpnCertificateCa(),
+			"fortios_vpncertificate_calist":                                  dataSourceVpnCertificateCaList(),
+			"fortios_vpncertificate_crl":                                     dataSourceVpnCertificateCrl(),
+			"fortios_vpncertificate_crllist":                                 dataSourceVpnCertificateCrlList(),
+			"fortios_vpncertificate_local":                                   dataSourceVpnCertificateLocal(),
+			"fortios_vpncertificate_locallist":                               dataSourceVpnCertificateLocalList(),
+			"fortios_vpncertificate_ocspserver":                              dataSourceVpnCertificateOcspServer(),
+			"fortios_vpncertificate_ocspserverlist":                          dataSourceVpnCertificateOcspServerList(),
+			"fortios_vpncertificate_remote":                                  dataSourceVpnCertificateRemote(),
+			"fortios_vpncertificate_remotelist":                              dataSourceVpnCertificateRemoteList(),
+			"fortios_vpncertificate_setting":                                 dataSourceVpnCertificateSetting(),
+			"fortios_vpnipsec_concentrator":                                  dataSourceVpnIpsecConcentrator(),
+			"fortios_vpnipsec_concentratorlist":                              dataSourceVpnIpsecConcentratorList(),
+			"fortios_vpnipsec_fec":                                           dataSourceVpnIpsecFec(),
+			"fortios_vpnipsec_feclist":                                       dataSourceVpnIpsecFecList(),
+			"fortios_vpnipsec_forticlient":                                   dataSourceVpnIpsecForticlient(),
+			"fortios_vpnipsec_forticlientlist":                               dataSourceVpnIpsecForticlientList(),
+			"fortios_vpnipsec_manualkey":                                     dataSourceVpnIpsecManualkey(),
+			"fortios_vpnipsec_manualkeyinterface":                            dataSourceVpnIpsecManualkeyInterface(),
+			"fortios_vpnipsec_manualkeyinterfacelist":                        dataSourceVpnIpsecManualkeyInterfaceList(),
+			"fortios_vpnipsec_manualkeylist":                                 dataSourceVpnIpsecManualkeyList(),
+			"fortios_vpnipsec_phase1":                                        dataSourceVpnIpsecPhase1(),
+			"fortios_vpnipsec_phase1interface":                               dataSourceVpnIpsecPhase1Interface(),
+			"fortios_vpnipsec_phase1interfacelist":                           dataSourceVpnIpsecPhase1InterfaceList(),
+			"fortios_vpnipsec_phase1list":                                    dataSourceVpnIpsecPhase1List(),
+			"fortios_vpnipsec_phase2":                                        dataSourceVpnIpsecPhase2(),
+			"fortios_vpnipsec_phase2interface":                               dataSourceVpnIpsecPhase2Interface(),
+			"fortios_vpnipsec_phase2interfacelist":                           dataSourceVpnIpsecPhase2InterfaceList(),
+			"fortios_vpnipsec_phase2list":                                    dataSourceVpnIpsecPhase2List(),
+			"fortios_vpnssl_client":                                          dataSourceVpnSslClient(),
+			"fortios_vpnssl_clientlist":                                      dataSourceVpnSslClientList(),
+			"fortios_vpnssl_settings":                                        dataSourceVpnSslSettings(),
+			"fortios_vpnsslweb_hostchecksoftware":                            dataSourceVpnSslWebHostCheckSoftware(),
+			"fortios_vpnsslweb_hostchecksoftwarelist":                        dataSourceVpnSslWebHostCheckSoftwareList(),
+			"fortios_vpnsslweb_portal":                                       dataSourceVpnSslWebPortal(),
+			"fortios_vpnsslweb_portallist":                                   dataSourceVpnSslWebPortalList(),
+			"fortios_vpnsslweb_realm":                                        dataSourceVpnSslWebRealm(),
+			"fortios_vpnsslweb_realmlist":                                    dataSourceVpnSslWebRealmList(),
+			"fortios_vpnsslweb_userbookmark":                                 dataSourceVpnSslWebUserBookmark(),
+			"fortios_vpnsslweb_userbookmarklist":                             dataSourceVpnSslWebUserBookmarkList(),
+			"fortios_vpnsslweb_usergroupbookmark":                            dataSourceVpnSslWebUserGroupBookmark(),
+			"fortios_vpnsslweb_usergroupbookmarklist":                        dataSourceVpnSslWebUserGroupBookmarkList(),
+			"fortios_waf_mainclass":                                          dataSourceWafMainClass(),
+			"fortios_waf_mainclasslist":                                      dataSourceWafMainClassList(),
+			"fortios_waf_profile":                                            dataSourceWafProfile(),
+			"fortios_waf_profilelist":                                        dataSourceWafProfileList(),
+			"fortios_waf_signature":                                          dataSourceWafSignature(),
+			"fortios_waf_signaturelist":                                      dataSourceWafSignatureList(),
+			"fortios_waf_subclass":                                           dataSourceWafSubClass(),
+			"fortios_waf_subclasslist":                                       dataSourceWafSubClassList(),
+			"fortios_wanopt_authgroup":                                       dataSourceWanoptAuthGroup(),
+			"fortios_wanopt_authgrouplist":                                   dataSourceWanoptAuthGroupList(),
+			"fortios_wanopt_cacheservice":                                    dataSourceWanoptCacheService(),
+			"fortios_wanopt_contentdeliverynetworkrule":                      dataSourceWanoptContentDeliveryNetworkRule(),
+			"fortios_wanopt_contentdeliverynetworkrulelist":                  dataSourceWanoptContentDeliveryNetworkRuleList(),
+			"fortios_wanopt_peer":                                            dataSourceWanoptPeer(),
+			"fortios_wanopt_peerlist":                                        dataSourceWanoptPeerList(),
+			"fortios_wanopt_profile":                                         dataSourceWanoptProfile(),
+			"fortios_wanopt_profilelist":                                     dataSourceWanoptProfileList(),
+			"fortios_wanopt_remotestorage":                                   dataSourceWanoptRemoteStorage(),
+			"fortios_wanopt_settings":                                        dataSourceWanoptSettings(),
+			"fortios_wanopt_webcache":                                        dataSourceWanoptWebcache(),
+			"fortios_webfilter_content":                                      dataSourceWebfilterContent(),
+			"fortios_webfilter_contentheader":                                dataSourceWebfilterContentHeader(),
+			"fortios_webfilter_contentheaderlist":                            dataSourceWebfilterContentHeaderList(),
+			"fortios_webfilter_contentlist":                                  dataSourceWebfilterContentList(),
+			"fortios_webfilter_fortiguard":                                   dataSourceWebfilterFortiguard(),
+			"fortios_webfilter_ftgdlocalcat":                                 dataSourceWebfilterFtgdLocalCat(),
+			"fortios_webfilter_ftgdlocalcatlist":                             dataSourceWebfilterFtgdLocalCatList(),
+			"fortios_webfilter_ftgdlocalrating":                              dataSourceWebfilterFtgdLocalRating(),
+			"fortios_webfilter_ftgdlocalratinglist":                          dataSourceWebfilterFtgdLocalRatingList(),
+			"fortios_webfilter_ipsurlfiltercachesetting":                     dataSourceWebfilterIpsUrlfilterCacheSetting(),
+			"fortios_webfilter_ipsurlfiltersetting":                          dataSourceWebfilterIpsUrlfilterSetting(),
+			"fortios_webfilter_ipsurlfiltersetting6":                         dataSourceWebfilterIpsUrlfilterSetting6(),
+			"fortios_webfilter_override":                                     dataSourceWebfilterOverride(),
+			"fortios_webfilter_overridelist":                                 dataSourceWebfilterOverrideList(),
+			"fortios_webfilter_profile":                                      dataSourceWebfilterProfile(),
+			"fortios_webfilter_profilelist":                                  dataSourceWebfilterProfileList(),
+			"fortios_webfilter_searchengine":                                 dataSourceWebfilterSearchEngine(),
+			"fortios_webfilter_searchenginelist":                             dataSourceWebfilterSearchEngineList(),
+			"fortios_webfilter_urlfilter":                                    dataSourceWebfilterUrlfilter(),
+			"fortios_webfilter_urlfilterlist":                                dataSourceWebfilterUrlfilterList(),
+			"fortios_webproxy_debugurl":                                      dataSourceWebProxyDebugUrl(),
+			"fortios_webproxy_debugurllist":                                  dataSourceWebProxyDebugUrlList(),
+			"fortios_webproxy_explicit":                                      dataSourceWebProxyExplicit(),
+			"fortios_webproxy_forwardserver":                                 dataSourceWebProxyForwardServer(),
+			"fortios_webproxy_forwardservergroup":                            dataSourceWebProxyForwardServerGroup(),
+			"fortios_webproxy_forwardservergrouplist":                        dataSourceWebProxyForwardServerGroupList(),
+			"fortios_webproxy_forwardserverlist":                             dataSourceWebProxyForwardServerList(),
+			"fortios_webproxy_global":                                        dataSourceWebProxyGlobal(),
+			"fortios_webproxy_profile":                                       dataSourceWebProxyProfile(),
+			"fortios_webproxy_profilelist":                                   dataSourceWebProxyProfileList(),
+			"fortios_webproxy_urlmatch":                                      dataSourceWebProxyUrlMatch(),
+			"fortios_webproxy_urlmatchlist":                                  dataSourceWebProxyUrlMatchList(),
+			"fortios_webproxy_wisp":                                          dataSourceWebProxyWisp(),
+			"fortios_webproxy_wisplist":                                      dataSourceWebProxyWispList(),
+			"fortios_wirelesscontroller_accesscontrollist":                   dataSourceWirelessControllerAccessControlList(),
+			"fortios_wirelesscontroller_accesscontrollistlist":               dataSourceWirelessControllerAccessControlListList(),
+			"fortios_wirelesscontroller_address":                             dataSourceWirelessControllerAddress(),
+			"fortios_wirelesscontroller_addresslist":                         dataSourceWirelessControllerAddressList(),
+			"fortios_wirelesscontroller_addrgrp":                             dataSourceWirelessControllerAddrgrp(),
+			"fortios_wirelesscontroller_addrgrplist":                         dataSourceWirelessControllerAddrgrpList(),
+			"fortios_wirelesscontroller_apcfgprofile":                        dataSourceWirelessControllerApcfgProfile(),
+			"fortios_wirelesscontroller_apcfgprofilelist":                    dataSourceWirelessControllerApcfgProfileList(),
+			"fortios_wirelesscontroller_apstatus":                            dataSourceWirelessControllerApStatus(),
+			"fortios_wirelesscontroller_apstatuslist":                        dataSourceWirelessControllerApStatusList(),
+			"fortios_wirelesscontroller_arrpprofile":                         dataSourceWirelessControllerArrpProfile(),
+			"fortios_wirelesscontroller_arrpprofilelist":                     dataSourceWirelessControllerArrpProfileList(),
+			"fortios_wirelesscontroller_bleprofile":                          dataSourceWirelessControllerBleProfile(),
+			"fortios_wirelesscontroller_bleprofilelist":                      dataSourceWirelessControllerBleProfileList(),
+			"fortios_wirelesscontroller_bonjourprofile":                      dataSourceWirelessControllerBonjourProfile(),
+			"fortios_wirelesscontroller_bonjourprofilelist":                  dataSourceWirelessControllerBonjourProfileList(),
+			"fortios_wirelesscontroller_global":                              dataSourceWirelessControllerGlobal(),
+			"fortios_wirelesscontroller_intercontroller":                     dataSourceWirelessControllerInterController(),
+			"fortios_wirelesscontroller_log":                                 dataSourceWirelessControllerLog(),
+			"fortios_wirelesscontroller_mpskprofile":                         dataSourceWirelessControllerMpskProfile(),
+			"fortios_wirelesscontroller_mpskprofilelist":                     dataSourceWirelessControllerMpskProfileList(),
+			"fortios_wirelesscontroller_nacprofile":                          dataSourceWirelessControllerNacProfile(),
+			"fortios_wirelesscontroller_nacprofilelist":                      dataSourceWirelessControllerNacProfileList(),
+			"fortios_wirelesscontroller_qosprofile":                          dataSourceWirelessControllerQosProfile(),
+			"fortios_wirelesscontroller_qosprofilelist":                      dataSourceWirelessControllerQosProfileList(),
+			"fortios_wirelesscontroller_region":                              dataSourceWirelessControllerRegion(),
+			"fortios_wirelesscontroller_regionlist":                          dataSourceWirelessControllerRegionList(),
+			"fortios_wirelesscontroller_setting":                             dataSourceWirelessControllerSetting(),
+			"fortios_wirelesscontroller_snmp":                                dataSourceWirelessControllerSnmp(),
+			"fortios_wirelesscontroller_ssidpolicy":                          dataSourceWirelessControllerSsidPolicy(),
+			"fortios_wirelesscontroller_ssidpolicylist":                      dataSourceWirelessControllerSsidPolicyList(),
+			"fortios_wirelesscontroller_syslogprofile":                       dataSourceWirelessControllerSyslogProfile(),
+			"fortios_wirelesscontroller_syslogprofilelist":                   dataSourceWirelessControllerSyslogProfileList(),
+			"fortios_wirelesscontroller_timers":                              dataSourceWirelessControllerTimers(),
+			"fortios_wirelesscontroller_utmprofile":                          dataSourceWirelessControllerUtmProfile(),
+			"fortios_wirelesscontroller_utmprofilelist":                      dataSourceWirelessControllerUtmProfileList(),
+			"fortios_wirelesscontroller_vap":                                 dataSourceWirelessControllerVap(),
+			"fortios_wirelesscontroller_vapgroup":                            dataSourceWirelessControllerVapGroup(),
+			"fortios_wirelesscontroller_vapgrouplist":                        dataSourceWirelessControllerVapGroupList(),
+			"fortios_wirelesscontroller_vaplist":                             dataSourceWirelessControllerVapList(),
+			"fortios_wirelesscontroller_wagprofile":                          dataSourceWirelessControllerWagProfile(),
+			"fortios_wirelesscontroller_wagprofilelist":                      dataSourceWirelessControllerWagProfileList(),
+			"fortios_wirelesscontroller_widsprofile":                         dataSourceWirelessControllerWidsProfile(),
+			"fortios_wirelesscontroller_widsprofilelist":                     dataSourceWirelessControllerWidsProfileList(),
+			"fortios_wirelesscontroller_wtp":                                 dataSourceWirelessControllerWtp(),
+			"fortios_wirelesscontroller_wtpgroup":                            dataSourceWirelessControllerWtpGroup(),
+			"fortios_wirelesscontroller_wtpgrouplist":                        dataSourceWirelessControllerWtpGroupList(),
+			"fortios_wirelesscontroller_wtplist":                             dataSourceWirelessControllerWtpList(),
+			"fortios_wirelesscontroller_wtpprofile":                          dataSourceWirelessControllerWtpProfile(),
+			"fortios_wirelesscontroller_wtpprofilelist":                      dataSourceWirelessControllerWtpProfileList(),
+			"fortios_wirelesscontrollerhotspot20_anqp3gppcellular":           dataSourceWirelessControllerHotspot20Anqp3GppCellular(),
+			"fortios_wirelesscontrollerhotspot20_anqp3gppcellularlist":       dataSourceWirelessControllerHotspot20Anqp3GppCellularList(),
+			"fortios_wirelesscontrollerhotspot20_anqpipaddresstype":          dataSourceWirelessControllerHotspot20AnqpIpAddressType(),
+			"fortios_wirelesscontrollerhotspot20_anqpipaddresstypelist":      dataSourceWirelessControllerHotspot20AnqpIpAddressTypeList(),
+			"fortios_wirelesscontrollerhotspot20_anqpnairealm":               dataSourceWirelessControllerHotspot20AnqpNaiRealm(),
+			"fortios_wirelesscontrollerhotspot20_anqpnairealmlist":           dataSourceWirelessControllerHotspot20AnqpNaiRealmList(),
+			"fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype":        dataSourceWirelessControllerHotspot20AnqpNetworkAuthType(),
+			"fortios_wirelesscontrollerhotspot20_anqpnetworkauthtypelist":    dataSourceWirelessControllerHotspot20AnqpNetworkAuthTypeList(),
+			"fortios_wirelesscontrollerhotspot20_anqproamingconsortium":      dataSourceWirelessControllerHotspot20AnqpRoamingConsortium(),
+			"fortios_wirelesscontrollerhotspot20_anqproamingconsortiumlist":  dataSourceWirelessControllerHotspot20AnqpRoamingConsortiumList(),
+			"fortios_wirelesscontrollerhotspot20_anqpvenuename":              dataSourceWirelessControllerHotspot20AnqpVenueName(),
+			"fortios_wirelesscontrollerhotspot20_anqpvenuenamelist":          dataSourceWirelessControllerHotspot20AnqpVenueNameList(),
+			"fortios_wirelesscontrollerhotspot20_anqpvenueurl":               dataSourceWirelessControllerHotspot20AnqpVenueUrl(),
+			"fortios_wirelesscontrollerhotspot20_anqpvenueurllist":           dataSourceWirelessControllerHotspot20AnqpVenueUrlList(),
+			"fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge":         dataSourceWirelessControllerHotspot20H2QpAdviceOfCharge(),
+			"fortios_wirelesscontrollerhotspot20_h2qpadviceofchargelist":     dataSourceWirelessControllerHotspot20H2QpAdviceOfChargeList(),
+			"fortios_wirelesscontrollerhotspot20_h2qpconncapability":         dataSourceWirelessControllerHotspot20H2QpConnCapability(),
+			"fortios_wirelesscontrollerhotspot20_h2qpconncapabilitylist":     dataSourceWirelessControllerHotspot20H2QpConnCapabilityList(),
+			"fortios_wirelesscontrollerhotspot20_h2qpoperatorname":           dataSourceWirelessControllerHotspot20H2QpOperatorName(),
+			"fortios_wirelesscontrollerhotspot20_h2qpoperatornamelist":       dataSourceWirelessControllerHotspot20H2QpOperatorNameList(),
+			"fortios_wirelesscontrollerhotspot20_h2qposuprovider":            dataSourceWirelessControllerHotspot20H2QpOsuProvider(),
+			"fortios_wirelesscontrollerhotspot20_h2qposuproviderlist":        dataSourceWirelessControllerHotspot20H2QpOsuProviderList(),
+			"fortios_wirelesscontrollerhotspot20_h2qposuprovidernai":         dataSourceWirelessControllerHotspot20H2QpOsuProviderNai(),
+			"fortios_wirelesscontrollerhotspot20_h2qposuprovidernailist":     dataSourceWirelessControllerHotspot20H2QpOsuProviderNaiList(),
+			"fortios_wirelesscontrollerhotspot20_h2qptermsandconditions":     dataSourceWirelessControllerHotspot20H2QpTermsAndConditions(),
+			"fortios_wirelesscontrollerhotspot20_h2qptermsandconditionslist": dataSourceWirelessControllerHotspot20H2QpTermsAndConditionsList(),
+			"fortios_wirelesscontrollerhotspot20_h2qpwanmetric":              dataSourceWirelessControllerHotspot20H2QpWanMetric(),
+			"fortios_wirelesscontrollerhotspot20_h2qpwanmetriclist":          dataSourceWirelessControllerHotspot20H2QpWanMetricList(),
+			"fortios_wirelesscontrollerhotspot20_hsprofile":                  dataSourceWirelessControllerHotspot20HsProfile(),
+			"fortios_wirelesscontrollerhotspot20_hsprofilelist":              dataSourceWirelessControllerHotspot20HsProfileList(),
+			"fortios_wirelesscontrollerhotspot20_icon":                       dataSourceWirelessControllerHotspot20Icon(),
+			"fortios_wirelesscontrollerhotspot20_iconlist":                   dataSourceWirelessControllerHotspot20IconList(),
+			"fortios_wirelesscontrollerhotspot20_qosmap":                     dataSourceWirelessControllerHotspot20QosMap(),
+			"fortios_wirelesscontrollerhotspot20_qosmaplist":                 dataSourceWirelessControllerHotspot20QosMapList(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -364,6 +1123,7 @@ func Provider() terraform.ResourceProvider {
 			"fortios_firewall_security_policy":                resourceFirewallSecurityPolicy1(),
 			"fortios_firewall_security_policyseq":             resourceFirewallSecurityPolicySeq(),
 			"fortios_firewall_security_policysort":            resourceFirewallSecurityPolicySort(),
+			"fortios_firewall_policy_order":                   resourceFirewallPolicyOrder(),
 			"fortios_system_setting_global":                   resourceSystemSettingGlobal(),
 			"fortios_system_setting_dns":                      resourceSystemSettingDNS(),
 			"fortios_system_setting_ntp":                      resourceSystemSettingNTP(),
@@ -377,6 +1137,7 @@ func Provider() terraform.ResourceProvider {
 			"fortios_vpn_ipsec_phase1interface":               resourceVPNIPsecPhase1Interface(),
 			"fortios_vpn_ipsec_phase2interface":               resourceVPNIPsecPhase2Interface(),
 			"fortios_json_generic_api":                        resourceJSONGenericAPI(),
+			"fortios_cmdb_object":                             resourceCmdbObject(),
 			"fortios_fmg_system_admin_profiles":               resourceFortimanagerSystemAdminProfiles(),
 			"fortios_fmg_system_admin_user":                   resourceFortimanagerSystemAdminUser(),
 			"fortios_fmg_devicemanager_device":                resourceFortimanagerDVMDevice(),
@@ -461,6 +1222,7 @@ func Provider() terraform.ResourceProvider {
 			"fortios_firewall_accessproxyvirtualhost":                    resourceFirewallAccessProxyVirtualHost(),
 			"fortios_firewall_accessproxy6":                              resourceFirewallAccessProxy6(),
 			"fortios_firewall_address":                                   resourceFirewallAddress(),
+			"fortios_firewall_address_set":                               resourceFirewallAddressSet(),
 			"fortios_firewall_address6":                                  resourceFirewallAddress6(),
 			"fortios_firewall_address6template":                          resourceFirewallAddress6Template(),
 			"fortios_firewall_addrgrp":                                   resourceFirewallAddrgrp(),
@@ -881,6 +1643,9 @@ func Provider() terraform.ResourceProvider {
 			"fortios_vpncertificate_ca":                                  resourceVpnCertificateCa(),
 			"fortios_vpncertificate_crl":                                 resourceVpnCertificateCrl(),
 			"fortios_vpncertificate_local":                               resourceVpnCertificateLocal(),
+			"fortios_vpncertificate_local_import":                        resourceVpnCertificateLocalImport(),
+			"fortios_vpncertificate_local_csr":                           resourceVpnCertificateLocalCsr(),
+			"fortios_vpncertificate_local_signed":                        resourceVpnCertificateLocalSigned(),
 			"fortios_vpncertificate_ocspserver":                          resourceVpnCertificateOcspServer(),
 			"fortios_vpncertificate_remote":                              resourceVpnCertificateRemote(),
 			"fortios_vpncertificate_setting":                             resourceVpnCertificateSetting(),
@@ -988,13 +1753,73 @@ func Provider() terraform.ResourceProvider {
 			"fortios_firewall_proxypolicy_move":                          resourceFirewallProxypolicyMove(),
 			"fortios_firewall_centralsnatmap_sort":                       resourceFirewallCentralsnatmapSort(),
 			"fortios_firewall_proxypolicy_sort":                          resourceFirewallProxypolicySort(),
+			"fortios_authentication_rule_move":                           resourceAuthenticationRuleMove(),
+			"fortios_authentication_rule_sort":                           resourceAuthenticationRuleSort(),
+			"fortios_authentication_rule_order":                          resourceAuthenticationRuleOrder(),
+			"fortios_firewall_DoSpolicy_move":                            resourceFirewallDosPolicyMove(),
+			"fortios_firewall_DoSpolicy_sort":                            resourceFirewallDosPolicySort(),
+			"fortios_firewall_DoSpolicy_order":                           resourceFirewallDosPolicyOrder(),
+			"fortios_firewall_DoSpolicy6_move":                           resourceFirewallDosPolicy6Move(),
+			"fortios_firewall_DoSpolicy6_sort":                           resourceFirewallDosPolicy6Sort(),
+			"fortios_firewall_DoSpolicy6_order":                          resourceFirewallDosPolicy6Order(),
+			"fortios_firewall_centralsnatmap_order":                      resourceFirewallCentralsnatmapOrder(),
+			"fortios_firewall_interfacepolicy_move":                      resourceFirewallInterfacePolicyMove(),
+			"fortios_firewall_interfacepolicy_sort":                      resourceFirewallInterfacePolicySort(),
+			"fortios_firewall_interfacepolicy_order":                     resourceFirewallInterfacePolicyOrder(),
+			"fortios_firewall_interfacepolicy6_move":                     resourceFirewallInterfacePolicy6Move(),
+			"fortios_firewall_interfacepolicy6_sort":                     resourceFirewallInterfacePolicy6Sort(),
+			"fortios_firewall_interfacepolicy6_order":                    resourceFirewallInterfacePolicy6Order(),
+			"fortios_firewall_localinpolicy_move":                        resourceFirewallLocalInPolicyMove(),
+			"fortios_firewall_localinpolicy_sort":                        resourceFirewallLocalInPolicySort(),
+			"fortios_firewall_localinpolicy_order":                       resourceFirewallLocalInPolicyOrder(),
+			"fortios_firewall_localinpolicy6_move":                       resourceFirewallLocalInPolicy6Move(),
+			"fortios_firewall_localinpolicy6_sort":                       resourceFirewallLocalInPolicy6Sort(),
+			"fortios_firewall_localinpolicy6_order":                      resourceFirewallLocalInPolicy6Order(),
+			"fortios_firewall_multicastpolicy_move":                      resourceFirewallMulticastPolicyMove(),
+			"fortios_firewall_multicastpolicy_sort":                      resourceFirewallMulticastPolicySort(),
+			"fortios_firewall_multicastpolicy_order":                     resourceFirewallMulticastPolicyOrder(),
+			"fortios_firewall_multicastpolicy6_move":                     resourceFirewallMulticastPolicy6Move(),
+			"fortios_firewall_multicastpolicy6_sort":                     resourceFirewallMulticastPolicy6Sort(),
+			"fortios_firewall_multicastpolicy6_order":                    resourceFirewallMulticastPolicy6Order(),
+			"fortios_firewall_policy46_move":                             resourceFirewallPolicy46Move(),
+			"fortios_firewall_policy46_sort":                             resourceFirewallPolicy46Sort(),
+			"fortios_firewall_policy46_order":                            resourceFirewallPolicy46Order(),
+			"fortios_firewall_policy6_move":                              resourceFirewallPolicy6Move(),
+			"fortios_firewall_policy6_sort":                              resourceFirewallPolicy6Sort(),
+			"fortios_firewall_policy6_order":                             resourceFirewallPolicy6Order(),
+			"fortios_firewall_policy64_move":                             resourceFirewallPolicy64Move(),
+			"fortios_firewall_policy64_sort":                             resourceFirewallPolicy64Sort(),
+			"fortios_firewall_policy64_order":                            resourceFirewallPolicy64Order(),
+			"fortios_firewall_proxypolicy_order":                         resourceFirewallProxypolicyOrder(),
+			"fortios_firewall_shapingpolicy_move":                        resourceFirewallShapingPolicyMove(),
+			"fortios_firewall_shapingpolicy_sort":                        resourceFirewallShapingPolicySort(),
+			"fortios_firewall_shapingpolicy_order":                       resourceFirewallShapingPolicyOrder(),
+			"fortios_firewall_ttlpolicy_move":                            resourceFirewallTtlPolicyMove(),
+			"fortios_firewall_ttlpolicy_sort":                            resourceFirewallTtlPolicySort(),
+			"fortios_firewall_ttlpolicy_order":                           resourceFirewallTtlPolicyOrder(),
+			"fortios_router_policy_move":                                 resourceRouterPolicyMove(),
+			"fortios_router_policy_sort":                                 resourceRouterPolicySort(),
+			"fortios_router_policy_order":                                resourceRouterPolicyOrder(),
+			"fortios_router_policy6_move":                                resourceRouterPolicy6Move(),
+			"fortios_router_policy6_sort":                                resourceRouterPolicy6Sort(),
+			"fortios_router_policy6_order":                               resourceRouterPolicy6Order(),
 		},
+	}
 
-		ConfigureFunc: providerConfigure,
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, p.ResourcesMap)
 	}
+
+	for k, r := range p.ResourcesMap {
+		setPositionHooks(k, r)
+		setUsageHooks(k, r)
+		setIgnoreFieldsHooks(k, r)
+	}
+
+	return p
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, resources map[string]*schema.Resource) (interface{}, error) {
 
 	// Init client config with the values from TF files
 	config := Config{
@@ -1025,6 +1850,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.FMG_Insecure = &insecure
 	}
 
+	ignoreFields, err := parseIgnoreFields(d.Get("ignore_fields").(map[string]interface{}), resources)
+	if err != nil {
+		return nil, err
+	}
+	config.IgnoreFields = ignoreFields
+
 	// Create Client for later connections
 	return config.CreateClient()
 }
@@ -24,6 +24,18 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderCmdbDataSources(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	for rtype, tbl := range cmdbTables {
+		if _, ok := p.DataSourcesMap[rtype]; !ok {
+			t.Errorf("%s has no data source", rtype)
+		}
+		if _, ok := p.DataSourcesMap[rtype+"list"]; tbl.Mkey != "" && !ok {
+			t.Errorf("%s has no list data source", rtype)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("FORTIOS_ACCESS_HOSTNAME"); v == "" {
 		t.Fatal("FORTIOS_ACCESS_HOSTNAME must be set for acceptance tests")
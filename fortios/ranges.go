@@ -0,0 +1,136 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+)
+
+// valueRange is the closed interval from Lo to Hi, of IPv4 addresses or ports.
+type valueRange struct {
+	Lo uint32
+	Hi uint32
+}
+
+// valueRanges is a set of values as intervals. The functions below expect it sorted with
+// no overlapping or adjacent intervals, as normalize returns it.
+type valueRanges []valueRange
+
+// normalize returns the intervals of r sorted, with the overlapping and adjacent ones merged.
+func (r valueRanges) normalize() valueRanges {
+	if len(r) == 0 {
+		return nil
+	}
+
+	l := append(valueRanges{}, r...)
+	sort.Slice(l, func(i, j int) bool { return l[i].Lo < l[j].Lo })
+
+	res := valueRanges{l[0]}
+	for _, v := range l[1:] {
+		last := &res[len(res)-1]
+		if last.Hi == ^uint32(0) || v.Lo <= last.Hi+1 {
+			if v.Hi > last.Hi {
+				last.Hi = v.Hi
+			}
+			continue
+		}
+		res = append(res, v)
+	}
+
+	return res
+}
+
+// contains reports whether every value of o is in r.
+func (r valueRanges) contains(o valueRanges) bool {
+	i := 0
+	for _, v := range o {
+		for i < len(r) && r[i].Hi < v.Lo {
+			i++
+		}
+		if i == len(r) || r[i].Lo > v.Lo || r[i].Hi < v.Hi {
+			return false
+		}
+	}
+	return true
+}
+
+// intersect returns the values in both r and o.
+func (r valueRanges) intersect(o valueRanges) valueRanges {
+	var res valueRanges
+
+	for i, j := 0, 0; i < len(r) && j < len(o); {
+		lo, hi := r[i].Lo, r[i].Hi
+		if o[j].Lo > lo {
+			lo = o[j].Lo
+		}
+		if o[j].Hi < hi {
+			hi = o[j].Hi
+		}
+		if lo <= hi {
+			res = append(res, valueRange{Lo: lo, Hi: hi})
+		}
+		if r[i].Hi < o[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return res
+}
+
+// intersects reports whether r and o have a value in common.
+func (r valueRanges) intersects(o valueRanges) bool {
+	return len(r.intersect(o)) > 0
+}
+
+// complement returns the values from 0 to max which are not in r.
+func (r valueRanges) complement(max uint32) valueRanges {
+	var res valueRanges
+
+	next := uint32(0)
+	done := false
+	for _, v := range r {
+		if v.Lo > max {
+			break
+		}
+		if v.Lo > next {
+			res = append(res, valueRange{Lo: next, Hi: v.Lo - 1})
+		}
+		if v.Hi >= max {
+			done = true
+			break
+		}
+		next = v.Hi + 1
+	}
+	if !done {
+		res = append(res, valueRange{Lo: next, Hi: max})
+	}
+
+	return res
+}
+
+// ipv4String formats v as an IPv4 address.
+func ipv4String(v uint32) string {
+	return fmt.Sprintf("%d.%d.%d.%d", v>>24, v>>16&0xff, v>>8&0xff, v&0xff)
+}
+
+// ipv4CIDRs returns the fewest CIDR blocks covering the IPv4 addresses of r.
+func (r valueRange) ipv4CIDRs() []string {
+	var res []string
+
+	for lo, hi := uint64(r.Lo), uint64(r.Hi); lo <= hi; {
+		// widen the block while lo stays aligned on it and it stays within hi
+		n := uint(32)
+		for n > 0 {
+			size := uint64(1) << (33 - n)
+			if lo%size != 0 || lo+size-1 > hi {
+				break
+			}
+			n--
+		}
+		res = append(res, fmt.Sprintf("%s/%d", ipv4String(uint32(lo)), n))
+		lo += 1 << (32 - n)
+	}
+
+	return res
+}
@@ -0,0 +1,146 @@
+package fortios
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testRanges parses ranges written as "1-3,5", "" being no range.
+func testRanges(t *testing.T, s string) valueRanges {
+	var res valueRanges
+	for _, f := range strings.Split(s, ",") {
+		if f == "" {
+			continue
+		}
+		lo, hi := f, f
+		if i := strings.Index(f, "-"); i >= 0 {
+			lo, hi = f[:i], f[i+1:]
+		}
+		l, err1 := strconv.ParseUint(lo, 10, 32)
+		h, err2 := strconv.ParseUint(hi, 10, 32)
+		if err1 != nil || err2 != nil {
+			t.Fatalf("invalid range %s", f)
+		}
+		res = append(res, valueRange{Lo: uint32(l), Hi: uint32(h)})
+	}
+	return res
+}
+
+func testRangesString(r valueRanges) string {
+	l := make([]string, len(r))
+	for i, v := range r {
+		if v.Lo == v.Hi {
+			l[i] = fmt.Sprint(v.Lo)
+		} else {
+			l[i] = fmt.Sprintf("%d-%d", v.Lo, v.Hi)
+		}
+	}
+	return strings.Join(l, ",")
+}
+
+func TestValueRangesNormalize(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"5", "5"},
+		{"5-9,1-3", "1-3,5-9"},
+		{"1-3,4-6", "1-6"},
+		{"1-5,2-3", "1-5"},
+		{"1-5,3-8,10", "1-8,10"},
+		{"0-4294967295,7", "0-4294967295"},
+		{"4294967290-4294967295,4294967295", "4294967290-4294967295"},
+	}
+
+	for _, c := range cases {
+		if got := testRangesString(testRanges(t, c.in).normalize()); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestValueRangesSetOperations(t *testing.T) {
+	cases := []struct {
+		r, o       string
+		contains   bool
+		intersect  string
+		intersects bool
+	}{
+		{"1-10", "2-3,5", true, "2-3,5", true},
+		{"1-10", "", true, "", false},
+		{"", "1", false, "", false},
+		{"1-3,5-9", "4", false, "", false},
+		{"1-3,5-9", "3-5", false, "3,5", true},
+		{"1-3,5-9", "0-20", false, "1-3,5-9", true},
+		{"10-20", "1-5,25-30", false, "", false},
+		{"0-4294967295", "4294967295", true, "4294967295", true},
+	}
+
+	for _, c := range cases {
+		r, o := testRanges(t, c.r), testRanges(t, c.o)
+		if got := r.contains(o); got != c.contains {
+			t.Errorf("%s contains %s: got %v", c.r, c.o, got)
+		}
+		if got := testRangesString(r.intersect(o)); got != c.intersect {
+			t.Errorf("%s intersect %s: got %s, want %s", c.r, c.o, got, c.intersect)
+		}
+		if got := r.intersects(o); got != c.intersects {
+			t.Errorf("%s intersects %s: got %v", c.r, c.o, got)
+		}
+	}
+}
+
+func TestValueRangesComplement(t *testing.T) {
+	cases := []struct {
+		in   string
+		max  uint32
+		want string
+	}{
+		{"", 65535, "0-65535"},
+		{"0-65535", 65535, ""},
+		{"80,443", 65535, "0-79,81-442,444-65535"},
+		{"0-10", 65535, "11-65535"},
+		{"100-200", 150, "0-99"},
+		{"200-300", 150, "0-150"},
+		{"0-4294967295", 4294967295, ""},
+		{"1-4294967294", 4294967295, "0,4294967295"},
+	}
+
+	for _, c := range cases {
+		if got := testRangesString(testRanges(t, c.in).complement(c.max)); got != c.want {
+			t.Errorf("%s to %d: got %s, want %s", c.in, c.max, got, c.want)
+		}
+	}
+}
+
+func TestIPv4CIDRs(t *testing.T) {
+	ip := func(s string) uint32 {
+		v, ok := analysisIPv4(s)
+		if !ok {
+			t.Fatalf("invalid address %s", s)
+		}
+		return v
+	}
+
+	cases := []struct {
+		lo, hi string
+		want   string
+	}{
+		{"10.0.0.1", "10.0.0.1", "10.0.0.1/32"},
+		{"10.0.0.0", "10.0.0.255", "10.0.0.0/24"},
+		{"10.0.0.1", "10.0.0.6", "10.0.0.1/32,10.0.0.2/31,10.0.0.4/31,10.0.0.6/32"},
+		{"192.168.0.0", "192.168.3.255", "192.168.0.0/22"},
+		{"0.0.0.0", "255.255.255.255", "0.0.0.0/0"},
+		{"255.255.255.254", "255.255.255.255", "255.255.255.254/31"},
+		{"0.0.0.0", "127.255.255.255", "0.0.0.0/1"},
+	}
+
+	for _, c := range cases {
+		got := strings.Join(valueRange{Lo: ip(c.lo), Hi: ip(c.hi)}.ipv4CIDRs(), ",")
+		if got != c.want {
+			t.Errorf("%s-%s: got %s, want %s", c.lo, c.hi, got, c.want)
+		}
+	}
+}
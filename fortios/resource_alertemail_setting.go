@@ -26,7 +26,7 @@ func resourceAlertemailSetting() *schema.Resource {
 		Delete: resourceAlertemailSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -252,6 +252,8 @@ func resourceAlertemailSettingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AlertemailSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_alertemail_setting", obj)
+
 	o, err := c.UpdateAlertemailSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AlertemailSetting resource: %v", err)
@@ -286,6 +288,8 @@ func resourceAlertemailSettingDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AlertemailSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_alertemail_setting", obj)
+
 	_, err = c.UpdateAlertemailSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing AlertemailSetting resource: %v", err)
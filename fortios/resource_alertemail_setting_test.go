@@ -59,7 +59,7 @@ func testAccCheckFortiOSAlertemailSettingExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAlertemailSetting(i)
+		o, err := c.ReadAlertemailSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AlertemailSetting: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckAlertemailSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAlertemailSetting(i)
+		o, err := c.ReadAlertemailSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceAntivirusHeuristic() *schema.Resource {
 		Delete: resourceAntivirusHeuristicDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceAntivirusHeuristicUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating AntivirusHeuristic resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_heuristic", obj)
+
 	o, err := c.UpdateAntivirusHeuristic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusHeuristic resource: %v", err)
@@ -96,6 +98,8 @@ func resourceAntivirusHeuristicDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating AntivirusHeuristic resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_heuristic", obj)
+
 	_, err = c.UpdateAntivirusHeuristic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing AntivirusHeuristic resource: %v", err)
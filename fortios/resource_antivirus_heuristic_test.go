@@ -48,7 +48,7 @@ func testAccCheckFortiOSAntivirusHeuristicExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusHeuristic(i)
+		o, err := c.ReadAntivirusHeuristic(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AntivirusHeuristic: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckAntivirusHeuristicDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusHeuristic(i)
+		o, err := c.ReadAntivirusHeuristic(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceAntivirusProfile() *schema.Resource {
 		Delete: resourceAntivirusProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -895,6 +895,8 @@ func resourceAntivirusProfileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating AntivirusProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_profile", obj)
+
 	o, err := c.CreateAntivirusProfile(obj, vdomparam)
 
 	if err != nil {
@@ -928,6 +930,8 @@ func resourceAntivirusProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating AntivirusProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_profile", obj)
+
 	o, err := c.UpdateAntivirusProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusProfile resource: %v", err)
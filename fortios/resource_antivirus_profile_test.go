@@ -59,7 +59,7 @@ func testAccCheckFortiOSAntivirusProfileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusProfile(i)
+		o, err := c.ReadAntivirusProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AntivirusProfile: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckAntivirusProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusProfile(i)
+		o, err := c.ReadAntivirusProfile(i, "")
 
 		if err == nil {
 			if o != nil {
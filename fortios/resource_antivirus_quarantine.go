@@ -26,7 +26,7 @@ func resourceAntivirusQuarantine() *schema.Resource {
 		Delete: resourceAntivirusQuarantineDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,6 +124,8 @@ func resourceAntivirusQuarantineUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating AntivirusQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_quarantine", obj)
+
 	o, err := c.UpdateAntivirusQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusQuarantine resource: %v", err)
@@ -158,6 +160,8 @@ func resourceAntivirusQuarantineDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating AntivirusQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_quarantine", obj)
+
 	_, err = c.UpdateAntivirusQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing AntivirusQuarantine resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSAntivirusQuarantineExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusQuarantine(i)
+		o, err := c.ReadAntivirusQuarantine(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AntivirusQuarantine: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckAntivirusQuarantineDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusQuarantine(i)
+		o, err := c.ReadAntivirusQuarantine(i, "")
 
 		if err == nil {
 			if o != nil {
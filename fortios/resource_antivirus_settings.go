@@ -26,7 +26,7 @@ func resourceAntivirusSettings() *schema.Resource {
 		Delete: resourceAntivirusSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceAntivirusSettingsUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AntivirusSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_settings", obj)
+
 	o, err := c.UpdateAntivirusSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AntivirusSettings resource: %v", err)
@@ -127,6 +129,8 @@ func resourceAntivirusSettingsDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating AntivirusSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_antivirus_settings", obj)
+
 	_, err = c.UpdateAntivirusSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing AntivirusSettings resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSAntivirusSettingsExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusSettings(i)
+		o, err := c.ReadAntivirusSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AntivirusSettings: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckAntivirusSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAntivirusSettings(i)
+		o, err := c.ReadAntivirusSettings(i, "")
 
 		if err == nil {
 			if o != nil {
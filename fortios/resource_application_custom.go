@@ -26,7 +26,7 @@ func resourceApplicationCustom() *schema.Resource {
 		Delete: resourceApplicationCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,6 +110,8 @@ func resourceApplicationCustomCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating ApplicationCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_custom", obj)
+
 	o, err := c.CreateApplicationCustom(obj, vdomparam)
 
 	if err != nil {
@@ -143,6 +145,8 @@ func resourceApplicationCustomUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating ApplicationCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_custom", obj)
+
 	o, err := c.UpdateApplicationCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationCustom resource: %v", err)
@@ -26,7 +26,7 @@ func resourceApplicationGroup() *schema.Resource {
 		Delete: resourceApplicationGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,6 +142,8 @@ func resourceApplicationGroupCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating ApplicationGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_group", obj)
+
 	o, err := c.CreateApplicationGroup(obj, vdomparam)
 
 	if err != nil {
@@ -175,6 +177,8 @@ func resourceApplicationGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_group", obj)
+
 	o, err := c.UpdateApplicationGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationGroup resource: %v", err)
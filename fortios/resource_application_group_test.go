@@ -51,7 +51,7 @@ func testAccCheckFortiOSApplicationGroupExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadApplicationGroup(i)
+		o, err := c.ReadApplicationGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ApplicationGroup: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckApplicationGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadApplicationGroup(i)
+		o, err := c.ReadApplicationGroup(i, "")
 
 		if err == nil {
 			if o != nil {
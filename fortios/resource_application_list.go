@@ -26,7 +26,7 @@ func resourceApplicationList() *schema.Resource {
 		Delete: resourceApplicationListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -394,6 +394,8 @@ func resourceApplicationListCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating ApplicationList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_list", obj)
+
 	o, err := c.CreateApplicationList(obj, vdomparam)
 
 	if err != nil {
@@ -427,6 +429,8 @@ func resourceApplicationListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_list", obj)
+
 	o, err := c.UpdateApplicationList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationList resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSApplicationListExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadApplicationList(i)
+		o, err := c.ReadApplicationList(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ApplicationList: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckApplicationListDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadApplicationList(i)
+		o, err := c.ReadApplicationList(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceApplicationName() *schema.Resource {
 		Delete: resourceApplicationNameDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -164,6 +164,8 @@ func resourceApplicationNameCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating ApplicationName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_name", obj)
+
 	o, err := c.CreateApplicationName(obj, vdomparam)
 
 	if err != nil {
@@ -197,6 +199,8 @@ func resourceApplicationNameUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating ApplicationName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_name", obj)
+
 	o, err := c.UpdateApplicationName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationName resource: %v", err)
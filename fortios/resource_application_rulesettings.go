@@ -26,7 +26,7 @@ func resourceApplicationRuleSettings() *schema.Resource {
 		Delete: resourceApplicationRuleSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/application/rule-settings", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,6 +61,8 @@ func resourceApplicationRuleSettingsCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating ApplicationRuleSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_rulesettings", obj)
+
 	o, err := c.CreateApplicationRuleSettings(obj, vdomparam)
 
 	if err != nil {
@@ -94,6 +96,8 @@ func resourceApplicationRuleSettingsUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating ApplicationRuleSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_application_rulesettings", obj)
+
 	o, err := c.UpdateApplicationRuleSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ApplicationRuleSettings resource: %v", err)
@@ -26,7 +26,7 @@ func resourceAuthenticationRule() *schema.Resource {
 		Delete: resourceAuthenticationRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -185,6 +185,8 @@ func resourceAuthenticationRuleCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating AuthenticationRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_rule", obj)
+
 	o, err := c.CreateAuthenticationRule(obj, vdomparam)
 
 	if err != nil {
@@ -218,6 +220,8 @@ func resourceAuthenticationRuleUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating AuthenticationRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_rule", obj)
+
 	o, err := c.UpdateAuthenticationRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationRule resource: %v", err)
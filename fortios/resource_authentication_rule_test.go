@@ -53,7 +53,7 @@ func testAccCheckFortiOSAuthenticationRuleExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationRule(i)
+		o, err := c.ReadAuthenticationRule(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AuthenticationRule: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckAuthenticationRuleDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationRule(i)
+		o, err := c.ReadAuthenticationRule(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceAuthenticationScheme() *schema.Resource {
 		Delete: resourceAuthenticationSchemeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,6 +142,8 @@ func resourceAuthenticationSchemeCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating AuthenticationScheme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_scheme", obj)
+
 	o, err := c.CreateAuthenticationScheme(obj, vdomparam)
 
 	if err != nil {
@@ -175,6 +177,8 @@ func resourceAuthenticationSchemeUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating AuthenticationScheme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_scheme", obj)
+
 	o, err := c.UpdateAuthenticationScheme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationScheme resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSAuthenticationSchemeExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationScheme(i)
+		o, err := c.ReadAuthenticationScheme(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AuthenticationScheme: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckAuthenticationSchemeDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationScheme(i)
+		o, err := c.ReadAuthenticationScheme(i, "")
 
 		if err == nil {
 			if o != nil {
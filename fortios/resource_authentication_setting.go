@@ -26,7 +26,7 @@ func resourceAuthenticationSetting() *schema.Resource {
 		Delete: resourceAuthenticationSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -168,6 +168,8 @@ func resourceAuthenticationSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating AuthenticationSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_setting", obj)
+
 	o, err := c.UpdateAuthenticationSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating AuthenticationSetting resource: %v", err)
@@ -202,6 +204,8 @@ func resourceAuthenticationSettingDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating AuthenticationSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_authentication_setting", obj)
+
 	_, err = c.UpdateAuthenticationSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing AuthenticationSetting resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSAuthenticationSettingExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationSetting(i)
+		o, err := c.ReadAuthenticationSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading AuthenticationSetting: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckAuthenticationSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadAuthenticationSetting(i)
+		o, err := c.ReadAuthenticationSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceCertificateCa() *schema.Resource {
 		Delete: resourceCertificateCaDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +118,8 @@ func resourceCertificateCaCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating CertificateCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_ca", obj)
+
 	o, err := c.CreateCertificateCa(obj, vdomparam)
 
 	if err != nil {
@@ -151,6 +153,8 @@ func resourceCertificateCaUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CertificateCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_ca", obj)
+
 	o, err := c.UpdateCertificateCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateCa resource: %v", err)
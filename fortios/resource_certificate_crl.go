@@ -26,7 +26,7 @@ func resourceCertificateCrl() *schema.Resource {
 		Delete: resourceCertificateCrlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -134,6 +134,8 @@ func resourceCertificateCrlCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating CertificateCrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_crl", obj)
+
 	o, err := c.CreateCertificateCrl(obj, vdomparam)
 
 	if err != nil {
@@ -167,6 +169,8 @@ func resourceCertificateCrlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CertificateCrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_crl", obj)
+
 	o, err := c.UpdateCertificateCrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateCrl resource: %v", err)
@@ -26,7 +26,7 @@ func resourceCertificateLocal() *schema.Resource {
 		Delete: resourceCertificateLocalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -216,6 +216,8 @@ func resourceCertificateLocalCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating CertificateLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_local", obj)
+
 	o, err := c.CreateCertificateLocal(obj, vdomparam)
 
 	if err != nil {
@@ -249,6 +251,8 @@ func resourceCertificateLocalUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating CertificateLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_local", obj)
+
 	o, err := c.UpdateCertificateLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateLocal resource: %v", err)
@@ -26,7 +26,7 @@ func resourceCertificateRemote() *schema.Resource {
 		Delete: resourceCertificateRemoteDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceCertificateRemoteCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating CertificateRemote resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_remote", obj)
+
 	o, err := c.CreateCertificateRemote(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceCertificateRemoteUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating CertificateRemote resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_certificate_remote", obj)
+
 	o, err := c.UpdateCertificateRemote(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CertificateRemote resource: %v", err)
@@ -26,7 +26,7 @@ func resourceCifsDomainController() *schema.Resource {
 		Delete: resourceCifsDomainControllerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceCifsDomainControllerCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating CifsDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_cifs_domaincontroller", obj)
+
 	o, err := c.CreateCifsDomainController(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceCifsDomainControllerUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating CifsDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_cifs_domaincontroller", obj)
+
 	o, err := c.UpdateCifsDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CifsDomainController resource: %v", err)
@@ -26,7 +26,7 @@ func resourceCifsProfile() *schema.Resource {
 		Delete: resourceCifsProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -161,6 +161,8 @@ func resourceCifsProfileCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating CifsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_cifs_profile", obj)
+
 	o, err := c.CreateCifsProfile(obj, vdomparam)
 
 	if err != nil {
@@ -194,6 +196,8 @@ func resourceCifsProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating CifsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_cifs_profile", obj)
+
 	o, err := c.UpdateCifsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CifsProfile resource: %v", err)
@@ -0,0 +1,468 @@
+package fortios
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceCmdbObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCmdbObjectCreate,
+		Read:   resourceCmdbObjectRead,
+		Update: resourceCmdbObjectUpdate,
+		Delete: resourceCmdbObjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCmdbObjectImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny(":?&"),
+				StateFunc: func(v interface{}) string {
+					return cmdbObjectPath(v.(string))
+				},
+			},
+			"mkey": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"mkey_field": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"attributes": &schema.Schema{
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"body"},
+			},
+			"body": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: cmdbObjectDiffSuppress,
+				ConflictsWith:    []string{"attributes"},
+			},
+		},
+	}
+}
+
+// cmdbObjectPath returns the path of a table or object relative to /api/v2/cmdb.
+func cmdbObjectPath(path string) string {
+	return strings.TrimPrefix(strings.Trim(path, "/"), "api/v2/cmdb/")
+}
+
+// cmdbObjectID returns the ID of the resource, <path> for an object and <path>:<mkey> for
+// an entry of a table. Paths never contain ":" while mkeys, such as IPv6 addresses, can.
+func cmdbObjectID(path, mkey string) string {
+	if mkey == "" {
+		return path
+	}
+	return path + ":" + mkey
+}
+
+func cmdbObjectSplitID(id string) (path, mkey string) {
+	if i := strings.Index(id, ":"); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+	return id, ""
+}
+
+func cmdbObjectURL(path, mkey string) string {
+	p := "/api/v2/cmdb/" + path
+	if mkey != "" {
+		p += "/" + forticlient.EscapeURLString(mkey)
+	}
+	return p
+}
+
+// cmdbObjectMkeyField returns the key of the entries of the table at path, taken from the
+// resource managing the table or "name" for the tables the provider does not cover.
+func cmdbObjectMkeyField(path string) string {
+	if r, mkeyAttr := cliConfigResource(path); r != nil && mkeyAttr != "" {
+		return fortiAPIKey(mkeyAttr)
+	}
+	return "name"
+}
+
+// cmdbObjectBody returns the JSON object sent to FortiOS, built from attributes or body.
+func cmdbObjectBody(d *schema.ResourceData) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+
+	if v, ok := d.GetOk("body"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &obj); err != nil {
+			return nil, fmt.Errorf("body should be a JSON object: %v", err)
+		}
+		return obj, nil
+	}
+
+	for k, v := range d.Get("attributes").(map[string]interface{}) {
+		obj[k] = v
+	}
+
+	return obj, nil
+}
+
+// cmdbObjectString converts a value of a FortiOS JSON object to a value of attributes.
+// Numbers are written without exponent, other values than strings as JSON.
+func cmdbObjectString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// cmdbObjectPrune returns the parts of the value read from FortiOS which are present in the
+// configured value: the configured keys of objects and of the entries of tables.
+func cmdbObjectPrune(conf, remote interface{}) interface{} {
+	switch c := conf.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		res := make(map[string]interface{}, len(c))
+		for k, v := range c {
+			res[k] = cmdbObjectPrune(v, r[k])
+		}
+		return res
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok {
+			return remote
+		}
+		keys := make(map[string]interface{})
+		for _, e := range c {
+			if m, ok := e.(map[string]interface{}); ok {
+				for k, v := range m {
+					keys[k] = v
+				}
+			}
+		}
+		if len(keys) == 0 {
+			return remote
+		}
+		res := make([]interface{}, 0, len(r))
+		for _, e := range r {
+			res = append(res, cmdbObjectPrune(keys, e))
+		}
+		return res
+	}
+
+	return remote
+}
+
+// cmdbObjectCanonical converts numbers to strings, as FortiOS accepts either for numeric
+// fields, so that values can be compared.
+func cmdbObjectCanonical(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			res[k] = cmdbObjectCanonical(e)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			res = append(res, cmdbObjectCanonical(e))
+		}
+		return res
+	case float64:
+		return cmdbObjectString(t)
+	}
+	return v
+}
+
+// cmdbObjectDiffSuppress compares the configured body with the keys it has in the body of
+// the state, which holds the whole object after an import.
+func cmdbObjectDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if json.Unmarshal([]byte(old), &o) != nil || json.Unmarshal([]byte(new), &n) != nil {
+		return false
+	}
+	return reflect.DeepEqual(cmdbObjectCanonical(cmdbObjectPrune(n, o)), cmdbObjectCanonical(n))
+}
+
+// cmdbObjectRead returns the object or table entry, nil if it does not exist.
+func cmdbObjectRead(c *forticlient.FortiSDKClient, path, mkey, vdomparam string) (map[string]interface{}, error) {
+	p := cmdbObjectURL(path, mkey)
+
+	res, err := fortiGenericRequest(c, "GET", p, "", nil, vdomparam)
+	if err != nil {
+		if fortiGenericNotFound(res) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	switch t := res["results"].(type) {
+	case map[string]interface{}:
+		return t, nil
+	case []interface{}:
+		if mkey == "" {
+			return nil, fmt.Errorf("%s is a table, the mkey of the entry is required", p)
+		}
+		if len(t) == 0 {
+			return nil, nil
+		}
+		if o, ok := t[0].(map[string]interface{}); ok {
+			return o, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected results of %s", p)
+}
+
+func resourceCmdbObjectCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	path := cmdbObjectPath(d.Get("path").(string))
+	mkey := d.Get("mkey").(string)
+
+	obj, err := cmdbObjectBody(d)
+	if err != nil {
+		return fmt.Errorf("Error creating CmdbObject resource while getting object: %v", err)
+	}
+
+	if mkey == "" {
+		_, err = fortiGenericRequest(c, "PUT", cmdbObjectURL(path, ""), "", obj, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error creating CmdbObject resource: %v", err)
+		}
+	} else {
+		field := d.Get("mkey_field").(string)
+		if field == "" {
+			field = cmdbObjectMkeyField(path)
+			d.Set("mkey_field", field)
+		}
+
+		if r, mkeyAttr := cliConfigResource(path); r != nil && mkeyAttr != "" {
+			obj[field] = cliConfigScalar(r.Schema[mkeyAttr], mkey)
+		} else {
+			obj[field] = mkey
+		}
+
+		_, err = fortiGenericRequest(c, "POST", cmdbObjectURL(path, ""), "", obj, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error creating CmdbObject resource: %v", err)
+		}
+	}
+
+	d.SetId(cmdbObjectID(path, mkey))
+
+	return resourceCmdbObjectRead(d, m)
+}
+
+func resourceCmdbObjectUpdate(d *schema.ResourceData, m interface{}) error {
+	path, mkey := cmdbObjectSplitID(d.Id())
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	obj, err := cmdbObjectBody(d)
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbObject resource while getting object: %v", err)
+	}
+
+	_, err = fortiGenericRequest(c, "PUT", cmdbObjectURL(path, mkey), "", obj, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error updating CmdbObject resource: %v", err)
+	}
+
+	return resourceCmdbObjectRead(d, m)
+}
+
+func resourceCmdbObjectDelete(d *schema.ResourceData, m interface{}) error {
+	path, mkey := cmdbObjectSplitID(d.Id())
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	// An object, as opposed to a table entry, cannot be deleted and is left as it is
+	if mkey != "" {
+		res, err := fortiGenericRequest(c, "DELETE", cmdbObjectURL(path, mkey), "", nil, vdomparam)
+		if err != nil && !fortiGenericNotFound(res) {
+			return fmt.Errorf("Error deleting CmdbObject resource: %v", err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCmdbObjectRead(d *schema.ResourceData, m interface{}) error {
+	path, mkey := cmdbObjectSplitID(d.Id())
+
+	c := m.(*FortiClient).Client
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	o, err := cmdbObjectRead(c, path, mkey, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading CmdbObject resource: %v", err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("mkey", mkey)
+	if mkey != "" {
+		if _, ok := d.GetOk("mkey_field"); !ok {
+			d.Set("mkey_field", cmdbObjectMkeyField(path))
+		}
+	}
+
+	// Only the keys managed by the resource are refreshed, so that drift is reported for
+	// them and not for the defaults and other fields set on the FortiGate
+	if v, ok := d.GetOk("body"); ok {
+		var conf interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &conf); err != nil {
+			return fmt.Errorf("Error reading CmdbObject resource: body should be a JSON object: %v", err)
+		}
+
+		b, err := json.Marshal(cmdbObjectPrune(conf, o))
+		if err != nil {
+			return fmt.Errorf("Error reading CmdbObject resource from API: %v", err)
+		}
+		if err = d.Set("body", string(b)); err != nil {
+			return fmt.Errorf("Error reading body: %v", err)
+		}
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		conf := v.(map[string]interface{})
+		keys := make([]string, 0, len(conf))
+		for k := range conf {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		attrs := make(map[string]interface{}, len(conf))
+		for _, k := range keys {
+			if r, ok := o[k]; ok {
+				attrs[k] = cmdbObjectString(r)
+			} else {
+				log.Printf("[WARN] %s has no field %s", d.Id(), k)
+				attrs[k] = conf[k]
+			}
+		}
+		if err = d.Set("attributes", attrs); err != nil {
+			return fmt.Errorf("Error reading attributes: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// resourceCmdbObjectImport imports an object with an ID of <path> and a table entry with
+// an ID of <path>:<mkey>, optionally prefixed with <vdom>/ as in fortiImportStateVdom.
+// The whole object read is set as body, so that a configured body only differs from the
+// state for the keys it sets to other values.
+func resourceCmdbObjectImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	vdom, id, err := splitImportVdom(d.Id(), m)
+	if err != nil {
+		return nil, err
+	}
+
+	path, mkey := cmdbObjectSplitID(id)
+	path = cmdbObjectPath(path)
+	if !strings.Contains(path, "/") {
+		return nil, fmt.Errorf("Error importing %s: the import ID should be <path> or <path>:<mkey>", d.Id())
+	}
+
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return nil, fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	o, err := cmdbObjectRead(c, path, mkey, vdom)
+	if err != nil {
+		return nil, fmt.Errorf("Error importing %s: %v", d.Id(), err)
+	}
+	if o == nil {
+		return nil, fmt.Errorf("Error importing %s: %s not found", d.Id(), cmdbObjectID(path, mkey))
+	}
+
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, fmt.Errorf("Error importing %s: %v", d.Id(), err)
+	}
+
+	if err := setImportVdom(d, vdom); err != nil {
+		return nil, err
+	}
+	if err := d.Set("body", string(b)); err != nil {
+		return nil, fmt.Errorf("Error importing %s: %v", d.Id(), err)
+	}
+	d.SetId(cmdbObjectID(path, mkey))
+
+	return []*schema.ResourceData{d}, nil
+}
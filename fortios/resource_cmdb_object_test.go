@@ -0,0 +1,74 @@
+package fortios
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestCmdbObjectDiffSuppress(t *testing.T) {
+	state := `{"name":"web","subnet":"10.0.0.0 255.0.0.0","color":0,"member":[{"name":"a"},{"name":"b"}]}`
+
+	cases := []struct {
+		name     string
+		old, new string
+		suppress bool
+	}{
+		{"same", state, state, true},
+		{"subset", state, `{"subnet":"10.0.0.0 255.0.0.0"}`, true},
+		{"number as string", state, `{"color":"0"}`, true},
+		{"changed value", state, `{"subnet":"10.0.0.0 255.255.0.0"}`, false},
+		{"new key", state, `{"comment":"x"}`, false},
+		{"table entry removed", state, `{"member":[{"name":"a"}]}`, false},
+		{"table", state, `{"member":[{"name":"a"},{"name":"b"}]}`, true},
+		{"no state", "", `{"subnet":"10.0.0.0 255.0.0.0"}`, false},
+	}
+
+	for _, c := range cases {
+		if got := cmdbObjectDiffSuppress("body", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.suppress)
+		}
+	}
+}
+
+func TestResourceCmdbObjectImport(t *testing.T) {
+	m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		switch path {
+		case "/api/v2/cmdb/system/vdom/root":
+			return http.StatusOK, []interface{}{map[string]interface{}{"name": "root"}}
+		case "/api/v2/cmdb/firewall/address/web":
+			if query.Get("vdom") != "root" {
+				return http.StatusNotFound, nil
+			}
+			return http.StatusOK, []interface{}{map[string]interface{}{"name": "web", "subnet": "10.0.0.0 255.0.0.0"}}
+		}
+		return http.StatusNotFound, nil
+	})
+
+	d := resourceCmdbObject().TestResourceData()
+	d.SetId("root/firewall/address:web")
+	res, err := resourceCmdbObjectImport(d, m)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	d = res[0]
+	if d.Id() != "firewall/address:web" || d.Get("vdomparam").(string) != "root" {
+		t.Errorf("got ID %s and vdom %s, want firewall/address:web and root", d.Id(), d.Get("vdomparam"))
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &body); err != nil {
+		t.Fatalf("invalid body: %v", err)
+	}
+	if !reflect.DeepEqual(body, map[string]interface{}{"name": "web", "subnet": "10.0.0.0 255.0.0.0"}) {
+		t.Errorf("got body %v", body)
+	}
+
+	d = resourceCmdbObject().TestResourceData()
+	d.SetId("firewall/address:missing")
+	if _, err := resourceCmdbObjectImport(d, m); err == nil {
+		t.Errorf("missing entry: no error")
+	}
+}
@@ -26,7 +26,7 @@ func resourceCredentialStoreDomainController() *schema.Resource {
 		Delete: resourceCredentialStoreDomainControllerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +103,8 @@ func resourceCredentialStoreDomainControllerCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating CredentialStoreDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_credentialstore_domaincontroller", obj)
+
 	o, err := c.CreateCredentialStoreDomainController(obj, vdomparam)
 
 	if err != nil {
@@ -136,6 +138,8 @@ func resourceCredentialStoreDomainControllerUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating CredentialStoreDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_credentialstore_domaincontroller", obj)
+
 	o, err := c.UpdateCredentialStoreDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating CredentialStoreDomainController resource: %v", err)
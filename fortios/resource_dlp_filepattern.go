@@ -26,7 +26,7 @@ func resourceDlpFilepattern() *schema.Resource {
 		Delete: resourceDlpFilepatternDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/dlp/filepattern", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,6 +100,8 @@ func resourceDlpFilepatternCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating DlpFilepattern resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_filepattern", obj)
+
 	o, err := c.CreateDlpFilepattern(obj, vdomparam)
 
 	if err != nil {
@@ -133,6 +135,8 @@ func resourceDlpFilepatternUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpFilepattern resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_filepattern", obj)
+
 	o, err := c.UpdateDlpFilepattern(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFilepattern resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSDlpFilepatternExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFilepattern(i)
+		o, err := c.ReadDlpFilepattern(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DlpFilepattern: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckDlpFilepatternDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFilepattern(i)
+		o, err := c.ReadDlpFilepattern(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceDlpFpDocSource() *schema.Resource {
 		Delete: resourceDlpFpDocSourceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,6 +154,8 @@ func resourceDlpFpDocSourceCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating DlpFpDocSource resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_fpdocsource", obj)
+
 	o, err := c.CreateDlpFpDocSource(obj, vdomparam)
 
 	if err != nil {
@@ -187,6 +189,8 @@ func resourceDlpFpDocSourceUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpFpDocSource resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_fpdocsource", obj)
+
 	o, err := c.UpdateDlpFpDocSource(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFpDocSource resource: %v", err)
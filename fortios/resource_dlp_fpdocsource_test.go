@@ -63,7 +63,7 @@ func testAccCheckFortiOSDlpFpDocSourceExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFpDocSource(i)
+		o, err := c.ReadDlpFpDocSource(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DlpFpDocSource: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckDlpFpDocSourceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFpDocSource(i)
+		o, err := c.ReadDlpFpDocSource(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceDlpFpSensitivity() *schema.Resource {
 		Delete: resourceDlpFpSensitivityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceDlpFpSensitivityCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating DlpFpSensitivity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_fpsensitivity", obj)
+
 	o, err := c.CreateDlpFpSensitivity(obj, vdomparam)
 
 	if err != nil {
@@ -95,6 +97,8 @@ func resourceDlpFpSensitivityUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating DlpFpSensitivity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_fpsensitivity", obj)
+
 	o, err := c.UpdateDlpFpSensitivity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpFpSensitivity resource: %v", err)
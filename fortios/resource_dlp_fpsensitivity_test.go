@@ -48,7 +48,7 @@ func testAccCheckFortiOSDlpFpSensitivityExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFpSensitivity(i)
+		o, err := c.ReadDlpFpSensitivity(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DlpFpSensitivity: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckDlpFpSensitivityDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpFpSensitivity(i)
+		o, err := c.ReadDlpFpSensitivity(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceDlpSensitivity() *schema.Resource {
 		Delete: resourceDlpSensitivityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceDlpSensitivityCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating DlpSensitivity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_sensitivity", obj)
+
 	o, err := c.CreateDlpSensitivity(obj, vdomparam)
 
 	if err != nil {
@@ -95,6 +97,8 @@ func resourceDlpSensitivityUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSensitivity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_sensitivity", obj)
+
 	o, err := c.UpdateDlpSensitivity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSensitivity resource: %v", err)
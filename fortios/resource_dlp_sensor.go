@@ -26,7 +26,7 @@ func resourceDlpSensor() *schema.Resource {
 		Delete: resourceDlpSensorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -228,6 +228,8 @@ func resourceDlpSensorCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating DlpSensor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_sensor", obj)
+
 	o, err := c.CreateDlpSensor(obj, vdomparam)
 
 	if err != nil {
@@ -261,6 +263,8 @@ func resourceDlpSensorUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSensor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_sensor", obj)
+
 	o, err := c.UpdateDlpSensor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSensor resource: %v", err)
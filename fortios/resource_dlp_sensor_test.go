@@ -53,7 +53,7 @@ func testAccCheckFortiOSDlpSensorExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpSensor(i)
+		o, err := c.ReadDlpSensor(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DlpSensor: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckDlpSensorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpSensor(i)
+		o, err := c.ReadDlpSensor(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceDlpSettings() *schema.Resource {
 		Delete: resourceDlpSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceDlpSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_settings", obj)
+
 	o, err := c.UpdateDlpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DlpSettings resource: %v", err)
@@ -119,6 +121,8 @@ func resourceDlpSettingsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DlpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dlp_settings", obj)
+
 	_, err = c.UpdateDlpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing DlpSettings resource: %v", err)
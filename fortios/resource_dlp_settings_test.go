@@ -51,7 +51,7 @@ func testAccCheckFortiOSDlpSettingsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpSettings(i)
+		o, err := c.ReadDlpSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DlpSettings: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckDlpSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDlpSettings(i)
+		o, err := c.ReadDlpSettings(i, "")
 
 		if err == nil {
 			if o != nil {
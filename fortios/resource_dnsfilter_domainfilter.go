@@ -26,7 +26,7 @@ func resourceDnsfilterDomainFilter() *schema.Resource {
 		Delete: resourceDnsfilterDomainFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/dnsfilter/domain-filter", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,6 +110,8 @@ func resourceDnsfilterDomainFilterCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating DnsfilterDomainFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dnsfilter_domainfilter", obj)
+
 	o, err := c.CreateDnsfilterDomainFilter(obj, vdomparam)
 
 	if err != nil {
@@ -143,6 +145,8 @@ func resourceDnsfilterDomainFilterUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating DnsfilterDomainFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dnsfilter_domainfilter", obj)
+
 	o, err := c.UpdateDnsfilterDomainFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DnsfilterDomainFilter resource: %v", err)
@@ -54,7 +54,7 @@ func testAccCheckFortiOSDnsfilterDomainFilterExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDnsfilterDomainFilter(i)
+		o, err := c.ReadDnsfilterDomainFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DnsfilterDomainFilter: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckDnsfilterDomainFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDnsfilterDomainFilter(i)
+		o, err := c.ReadDnsfilterDomainFilter(i, "")
 
 		if err == nil {
 			if o != nil {
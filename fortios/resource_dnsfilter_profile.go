@@ -26,7 +26,7 @@ func resourceDnsfilterProfile() *schema.Resource {
 		Delete: resourceDnsfilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -243,6 +243,8 @@ func resourceDnsfilterProfileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating DnsfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dnsfilter_profile", obj)
+
 	o, err := c.CreateDnsfilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -276,6 +278,8 @@ func resourceDnsfilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating DnsfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dnsfilter_profile", obj)
+
 	o, err := c.UpdateDnsfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DnsfilterProfile resource: %v", err)
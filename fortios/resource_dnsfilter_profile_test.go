@@ -73,7 +73,7 @@ func testAccCheckFortiOSDnsfilterProfileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadDnsfilterProfile(i)
+		o, err := c.ReadDnsfilterProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading DnsfilterProfile: %s", err)
@@ -96,7 +96,7 @@ func testAccCheckDnsfilterProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadDnsfilterProfile(i)
+		o, err := c.ReadDnsfilterProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceDpdkCpus() *schema.Resource {
 		Delete: resourceDpdkCpusDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceDpdkCpusUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkCpus resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dpdk_cpus", obj)
+
 	o, err := c.UpdateDpdkCpus(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DpdkCpus resource: %v", err)
@@ -121,6 +123,8 @@ func resourceDpdkCpusDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkCpus resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dpdk_cpus", obj)
+
 	_, err = c.UpdateDpdkCpus(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing DpdkCpus resource: %v", err)
@@ -26,7 +26,7 @@ func resourceDpdkGlobal() *schema.Resource {
 		Delete: resourceDpdkGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceDpdkGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dpdk_global", obj)
+
 	o, err := c.UpdateDpdkGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating DpdkGlobal resource: %v", err)
@@ -147,6 +149,8 @@ func resourceDpdkGlobalDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating DpdkGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_dpdk_global", obj)
+
 	_, err = c.UpdateDpdkGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing DpdkGlobal resource: %v", err)
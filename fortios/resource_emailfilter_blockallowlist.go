@@ -26,7 +26,7 @@ func resourceEmailfilterBlockAllowList() *schema.Resource {
 		Delete: resourceEmailfilterBlockAllowListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/block-allow-list", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -132,6 +132,8 @@ func resourceEmailfilterBlockAllowListCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating EmailfilterBlockAllowList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_blockallowlist", obj)
+
 	o, err := c.CreateEmailfilterBlockAllowList(obj, vdomparam)
 
 	if err != nil {
@@ -165,6 +167,8 @@ func resourceEmailfilterBlockAllowListUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating EmailfilterBlockAllowList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_blockallowlist", obj)
+
 	o, err := c.UpdateEmailfilterBlockAllowList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBlockAllowList resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEmailfilterBwl() *schema.Resource {
 		Delete: resourceEmailfilterBwlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/bwl", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -132,6 +132,8 @@ func resourceEmailfilterBwlCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating EmailfilterBwl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_bwl", obj)
+
 	o, err := c.CreateEmailfilterBwl(obj, vdomparam)
 
 	if err != nil {
@@ -165,6 +167,8 @@ func resourceEmailfilterBwlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating EmailfilterBwl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_bwl", obj)
+
 	o, err := c.UpdateEmailfilterBwl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBwl resource: %v", err)
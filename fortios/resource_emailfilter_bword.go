@@ -26,7 +26,7 @@ func resourceEmailfilterBword() *schema.Resource {
 		Delete: resourceEmailfilterBwordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/bword", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -128,6 +128,8 @@ func resourceEmailfilterBwordCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating EmailfilterBword resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_bword", obj)
+
 	o, err := c.CreateEmailfilterBword(obj, vdomparam)
 
 	if err != nil {
@@ -161,6 +163,8 @@ func resourceEmailfilterBwordUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating EmailfilterBword resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_bword", obj)
+
 	o, err := c.UpdateEmailfilterBword(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterBword resource: %v", err)
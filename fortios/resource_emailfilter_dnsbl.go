@@ -26,7 +26,7 @@ func resourceEmailfilterDnsbl() *schema.Resource {
 		Delete: resourceEmailfilterDnsblDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/dnsbl", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -107,6 +107,8 @@ func resourceEmailfilterDnsblCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating EmailfilterDnsbl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_dnsbl", obj)
+
 	o, err := c.CreateEmailfilterDnsbl(obj, vdomparam)
 
 	if err != nil {
@@ -140,6 +142,8 @@ func resourceEmailfilterDnsblUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating EmailfilterDnsbl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_dnsbl", obj)
+
 	o, err := c.UpdateEmailfilterDnsbl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterDnsbl resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEmailfilterFortishield() *schema.Resource {
 		Delete: resourceEmailfilterFortishieldDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceEmailfilterFortishieldUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EmailfilterFortishield resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_fortishield", obj)
+
 	o, err := c.UpdateEmailfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterFortishield resource: %v", err)
@@ -107,6 +109,8 @@ func resourceEmailfilterFortishieldDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EmailfilterFortishield resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_fortishield", obj)
+
 	_, err = c.UpdateEmailfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing EmailfilterFortishield resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEmailfilterIptrust() *schema.Resource {
 		Delete: resourceEmailfilterIptrustDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/iptrust", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceEmailfilterIptrustCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating EmailfilterIptrust resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_iptrust", obj)
+
 	o, err := c.CreateEmailfilterIptrust(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceEmailfilterIptrustUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterIptrust resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_iptrust", obj)
+
 	o, err := c.UpdateEmailfilterIptrust(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterIptrust resource: %v", err)
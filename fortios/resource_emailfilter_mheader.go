@@ -26,7 +26,7 @@ func resourceEmailfilterMheader() *schema.Resource {
 		Delete: resourceEmailfilterMheaderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/emailfilter/mheader", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +118,8 @@ func resourceEmailfilterMheaderCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating EmailfilterMheader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_mheader", obj)
+
 	o, err := c.CreateEmailfilterMheader(obj, vdomparam)
 
 	if err != nil {
@@ -151,6 +153,8 @@ func resourceEmailfilterMheaderUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterMheader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_mheader", obj)
+
 	o, err := c.UpdateEmailfilterMheader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterMheader resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEmailfilterOptions() *schema.Resource {
 		Delete: resourceEmailfilterOptionsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,6 +63,8 @@ func resourceEmailfilterOptionsUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_options", obj)
+
 	o, err := c.UpdateEmailfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterOptions resource: %v", err)
@@ -97,6 +99,8 @@ func resourceEmailfilterOptionsDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_options", obj)
+
 	_, err = c.UpdateEmailfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing EmailfilterOptions resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEmailfilterProfile() *schema.Resource {
 		Delete: resourceEmailfilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -421,6 +421,8 @@ func resourceEmailfilterProfileCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating EmailfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_profile", obj)
+
 	o, err := c.CreateEmailfilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -454,6 +456,8 @@ func resourceEmailfilterProfileUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating EmailfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_emailfilter_profile", obj)
+
 	o, err := c.UpdateEmailfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EmailfilterProfile resource: %v", err)
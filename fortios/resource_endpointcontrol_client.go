@@ -26,7 +26,7 @@ func resourceEndpointControlClient() *schema.Resource {
 		Delete: resourceEndpointControlClientDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/endpoint-control/client", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,6 +88,8 @@ func resourceEndpointControlClientCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating EndpointControlClient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_client", obj)
+
 	o, err := c.CreateEndpointControlClient(obj, vdomparam)
 
 	if err != nil {
@@ -121,6 +123,8 @@ func resourceEndpointControlClientUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlClient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_client", obj)
+
 	o, err := c.UpdateEndpointControlClient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlClient resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEndpointControlFctems() *schema.Resource {
 		Delete: resourceEndpointControlFctemsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -160,6 +160,8 @@ func resourceEndpointControlFctemsCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating EndpointControlFctems resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_fctems", obj)
+
 	o, err := c.CreateEndpointControlFctems(obj, vdomparam)
 
 	if err != nil {
@@ -193,6 +195,8 @@ func resourceEndpointControlFctemsUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlFctems resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_fctems", obj)
+
 	o, err := c.UpdateEndpointControlFctems(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlFctems resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEndpointControlForticlientEms() *schema.Resource {
 		Delete: resourceEndpointControlForticlientEmsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,6 +112,8 @@ func resourceEndpointControlForticlientEmsCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating EndpointControlForticlientEms resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_forticlientems", obj)
+
 	o, err := c.CreateEndpointControlForticlientEms(obj, vdomparam)
 
 	if err != nil {
@@ -145,6 +147,8 @@ func resourceEndpointControlForticlientEmsUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating EndpointControlForticlientEms resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_forticlientems", obj)
+
 	o, err := c.UpdateEndpointControlForticlientEms(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlForticlientEms resource: %v", err)
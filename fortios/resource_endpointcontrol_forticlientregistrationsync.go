@@ -26,7 +26,7 @@ func resourceEndpointControlForticlientRegistrationSync() *schema.Resource {
 		Delete: resourceEndpointControlForticlientRegistrationSyncDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceEndpointControlForticlientRegistrationSyncCreate(d *schema.Resource
 		return fmt.Errorf("Error creating EndpointControlForticlientRegistrationSync resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_forticlientregistrationsync", obj)
+
 	o, err := c.CreateEndpointControlForticlientRegistrationSync(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceEndpointControlForticlientRegistrationSyncUpdate(d *schema.Resource
 		return fmt.Errorf("Error updating EndpointControlForticlientRegistrationSync resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_forticlientregistrationsync", obj)
+
 	o, err := c.UpdateEndpointControlForticlientRegistrationSync(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlForticlientRegistrationSync resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSEndpointControlForticlientRegistrationSyncExists(n strin
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlForticlientRegistrationSync(i)
+		o, err := c.ReadEndpointControlForticlientRegistrationSync(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading EndpointControlForticlientRegistrationSync: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckEndpointControlForticlientRegistrationSyncDestroy(s *terraform.
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlForticlientRegistrationSync(i)
+		o, err := c.ReadEndpointControlForticlientRegistrationSync(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceEndpointControlProfile() *schema.Resource {
 		Delete: resourceEndpointControlProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -650,6 +650,8 @@ func resourceEndpointControlProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating EndpointControlProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_profile", obj)
+
 	o, err := c.CreateEndpointControlProfile(obj, vdomparam)
 
 	if err != nil {
@@ -683,6 +685,8 @@ func resourceEndpointControlProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating EndpointControlProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_profile", obj)
+
 	o, err := c.UpdateEndpointControlProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlProfile resource: %v", err)
@@ -84,7 +84,7 @@ func testAccCheckFortiOSEndpointControlProfileExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlProfile(i)
+		o, err := c.ReadEndpointControlProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading EndpointControlProfile: %s", err)
@@ -107,7 +107,7 @@ func testAccCheckEndpointControlProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlProfile(i)
+		o, err := c.ReadEndpointControlProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceEndpointControlRegisteredForticlient() *schema.Resource {
 		Delete: resourceEndpointControlRegisteredForticlientDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceEndpointControlRegisteredForticlientCreate(d *schema.ResourceData,
 		return fmt.Errorf("Error creating EndpointControlRegisteredForticlient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_registeredforticlient", obj)
+
 	o, err := c.CreateEndpointControlRegisteredForticlient(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceEndpointControlRegisteredForticlientUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating EndpointControlRegisteredForticlient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_registeredforticlient", obj)
+
 	o, err := c.UpdateEndpointControlRegisteredForticlient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlRegisteredForticlient resource: %v", err)
@@ -26,7 +26,7 @@ func resourceEndpointControlSettings() *schema.Resource {
 		Delete: resourceEndpointControlSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,6 +141,8 @@ func resourceEndpointControlSettingsUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating EndpointControlSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_settings", obj)
+
 	o, err := c.UpdateEndpointControlSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating EndpointControlSettings resource: %v", err)
@@ -175,6 +177,8 @@ func resourceEndpointControlSettingsDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating EndpointControlSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_endpointcontrol_settings", obj)
+
 	_, err = c.UpdateEndpointControlSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing EndpointControlSettings resource: %v", err)
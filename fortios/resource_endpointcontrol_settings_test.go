@@ -59,7 +59,7 @@ func testAccCheckFortiOSEndpointControlSettingsExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlSettings(i)
+		o, err := c.ReadEndpointControlSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading EndpointControlSettings: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckEndpointControlSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadEndpointControlSettings(i)
+		o, err := c.ReadEndpointControlSettings(i, "")
 
 		if err == nil {
 			if o != nil {
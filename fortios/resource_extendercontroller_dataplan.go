@@ -26,7 +26,7 @@ func resourceExtenderControllerDataplan() *schema.Resource {
 		Delete: resourceExtenderControllerDataplanDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -164,6 +164,8 @@ func resourceExtenderControllerDataplanCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating ExtenderControllerDataplan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_dataplan", obj)
+
 	o, err := c.CreateExtenderControllerDataplan(obj, vdomparam)
 
 	if err != nil {
@@ -197,6 +199,8 @@ func resourceExtenderControllerDataplanUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating ExtenderControllerDataplan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_dataplan", obj)
+
 	o, err := c.UpdateExtenderControllerDataplan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerDataplan resource: %v", err)
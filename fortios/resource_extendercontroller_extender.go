@@ -26,7 +26,7 @@ func resourceExtenderControllerExtender() *schema.Resource {
 		Delete: resourceExtenderControllerExtenderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -610,6 +610,8 @@ func resourceExtenderControllerExtenderCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating ExtenderControllerExtender resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extender", obj)
+
 	o, err := c.CreateExtenderControllerExtender(obj, vdomparam)
 
 	if err != nil {
@@ -643,6 +645,8 @@ func resourceExtenderControllerExtenderUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating ExtenderControllerExtender resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extender", obj)
+
 	o, err := c.UpdateExtenderControllerExtender(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtender resource: %v", err)
@@ -26,7 +26,7 @@ func resourceExtenderControllerExtender1() *schema.Resource {
 		Delete: resourceExtenderControllerExtender1Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -359,6 +359,8 @@ func resourceExtenderControllerExtender1Create(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating ExtenderControllerExtender1 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extender1", obj)
+
 	o, err := c.CreateExtenderControllerExtender1(obj, vdomparam)
 
 	if err != nil {
@@ -392,6 +394,8 @@ func resourceExtenderControllerExtender1Update(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating ExtenderControllerExtender1 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extender1", obj)
+
 	o, err := c.UpdateExtenderControllerExtender1(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtender1 resource: %v", err)
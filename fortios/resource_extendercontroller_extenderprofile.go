@@ -26,7 +26,7 @@ func resourceExtenderControllerExtenderProfile() *schema.Resource {
 		Delete: resourceExtenderControllerExtenderProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -536,6 +536,8 @@ func resourceExtenderControllerExtenderProfileCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating ExtenderControllerExtenderProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extenderprofile", obj)
+
 	o, err := c.CreateExtenderControllerExtenderProfile(obj, vdomparam)
 
 	if err != nil {
@@ -569,6 +571,8 @@ func resourceExtenderControllerExtenderProfileUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating ExtenderControllerExtenderProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_extendercontroller_extenderprofile", obj)
+
 	o, err := c.UpdateExtenderControllerExtenderProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ExtenderControllerExtenderProfile resource: %v", err)
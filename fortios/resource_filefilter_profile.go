@@ -26,7 +26,7 @@ func resourceFileFilterProfile() *schema.Resource {
 		Delete: resourceFileFilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -152,6 +152,8 @@ func resourceFileFilterProfileCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating FileFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_filefilter_profile", obj)
+
 	o, err := c.CreateFileFilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -185,6 +187,8 @@ func resourceFileFilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FileFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_filefilter_profile", obj)
+
 	o, err := c.UpdateFileFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FileFilterProfile resource: %v", err)
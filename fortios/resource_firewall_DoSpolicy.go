@@ -26,7 +26,7 @@ func resourceFirewallDosPolicy() *schema.Resource {
 		Delete: resourceFirewallDosPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/DoS-policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -185,6 +185,8 @@ func resourceFirewallDosPolicyCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating FirewallDosPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_DoSpolicy", obj)
+
 	o, err := c.CreateFirewallDosPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -218,6 +220,8 @@ func resourceFirewallDosPolicyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallDosPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_DoSpolicy", obj)
+
 	o, err := c.UpdateFirewallDosPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDosPolicy resource: %v", err)
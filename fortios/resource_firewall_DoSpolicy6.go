@@ -26,7 +26,7 @@ func resourceFirewallDosPolicy6() *schema.Resource {
 		Delete: resourceFirewallDosPolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/DoS-policy6", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -185,6 +185,8 @@ func resourceFirewallDosPolicy6Create(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating FirewallDosPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_DoSpolicy6", obj)
+
 	o, err := c.CreateFirewallDosPolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -218,6 +220,8 @@ func resourceFirewallDosPolicy6Update(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallDosPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_DoSpolicy6", obj)
+
 	o, err := c.UpdateFirewallDosPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDosPolicy6 resource: %v", err)
@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFirewallDosPolicy6Sort() *schema.Resource {
+	return resourceTableSort(orderTableOf("fortios_firewall_DoSpolicy6"))
+}
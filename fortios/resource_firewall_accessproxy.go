@@ -26,7 +26,7 @@ func resourceFirewallAccessProxy() *schema.Resource {
 		Delete: resourceFirewallAccessProxyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -607,6 +607,8 @@ func resourceFirewallAccessProxyCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating FirewallAccessProxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxy", obj)
+
 	o, err := c.CreateFirewallAccessProxy(obj, vdomparam)
 
 	if err != nil {
@@ -640,6 +642,8 @@ func resourceFirewallAccessProxyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallAccessProxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxy", obj)
+
 	o, err := c.UpdateFirewallAccessProxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallAccessProxy6() *schema.Resource {
 		Delete: resourceFirewallAccessProxy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -607,6 +607,8 @@ func resourceFirewallAccessProxy6Create(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallAccessProxy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxy6", obj)
+
 	o, err := c.CreateFirewallAccessProxy6(obj, vdomparam)
 
 	if err != nil {
@@ -640,6 +642,8 @@ func resourceFirewallAccessProxy6Update(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallAccessProxy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxy6", obj)
+
 	o, err := c.UpdateFirewallAccessProxy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxy6 resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallAccessProxySshClientCert() *schema.Resource {
 		Delete: resourceFirewallAccessProxySshClientCertDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,6 +133,8 @@ func resourceFirewallAccessProxySshClientCertCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating FirewallAccessProxySshClientCert resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxysshclientcert", obj)
+
 	o, err := c.CreateFirewallAccessProxySshClientCert(obj, vdomparam)
 
 	if err != nil {
@@ -166,6 +168,8 @@ func resourceFirewallAccessProxySshClientCertUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating FirewallAccessProxySshClientCert resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxysshclientcert", obj)
+
 	o, err := c.UpdateFirewallAccessProxySshClientCert(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxySshClientCert resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallAccessProxyVirtualHost() *schema.Resource {
 		Delete: resourceFirewallAccessProxyVirtualHostDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceFirewallAccessProxyVirtualHostCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error creating FirewallAccessProxyVirtualHost resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxyvirtualhost", obj)
+
 	o, err := c.CreateFirewallAccessProxyVirtualHost(obj, vdomparam)
 
 	if err != nil {
@@ -112,6 +114,8 @@ func resourceFirewallAccessProxyVirtualHostUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallAccessProxyVirtualHost resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_accessproxyvirtualhost", obj)
+
 	o, err := c.UpdateFirewallAccessProxyVirtualHost(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAccessProxyVirtualHost resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallAddress() *schema.Resource {
 		Delete: resourceFirewallAddressDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -340,6 +340,8 @@ func resourceFirewallAddressCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address", obj)
+
 	o, err := c.CreateFirewallAddress(obj, vdomparam)
 
 	if err != nil {
@@ -373,6 +375,8 @@ func resourceFirewallAddressUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address", obj)
+
 	o, err := c.UpdateFirewallAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress resource: %v", err)
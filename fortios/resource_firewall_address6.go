@@ -26,7 +26,7 @@ func resourceFirewallAddress6() *schema.Resource {
 		Delete: resourceFirewallAddress6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -255,6 +255,8 @@ func resourceFirewallAddress6Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallAddress6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address6", obj)
+
 	o, err := c.CreateFirewallAddress6(obj, vdomparam)
 
 	if err != nil {
@@ -288,6 +290,8 @@ func resourceFirewallAddress6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddress6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address6", obj)
+
 	o, err := c.UpdateFirewallAddress6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress6 resource: %v", err)
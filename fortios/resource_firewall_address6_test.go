@@ -57,7 +57,7 @@ func testAccCheckFortiOSFirewallAddress6Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress6(i)
+		o, err := c.ReadFirewallAddress6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAddress6: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckFirewallAddress6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress6(i)
+		o, err := c.ReadFirewallAddress6(i, "")
 
 		if err == nil {
 			if o != nil {
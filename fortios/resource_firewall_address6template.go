@@ -26,7 +26,7 @@ func resourceFirewallAddress6Template() *schema.Resource {
 		Delete: resourceFirewallAddress6TemplateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,6 +130,8 @@ func resourceFirewallAddress6TemplateCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating FirewallAddress6Template resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address6template", obj)
+
 	o, err := c.CreateFirewallAddress6Template(obj, vdomparam)
 
 	if err != nil {
@@ -163,6 +165,8 @@ func resourceFirewallAddress6TemplateUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallAddress6Template resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_address6template", obj)
+
 	o, err := c.UpdateFirewallAddress6Template(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddress6Template resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSFirewallAddress6TemplateExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress6Template(i)
+		o, err := c.ReadFirewallAddress6Template(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAddress6Template: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckFirewallAddress6TemplateDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress6Template(i)
+		o, err := c.ReadFirewallAddress6Template(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -0,0 +1,624 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// addressSetFormat limits the attributes of the addresses read to those the set manages.
+const addressSetFormat = "format=name|type|subnet|start-ip|end-ip|fqdn|comment|color|associated-interface|allow-routing"
+
+// addressSetShared are the attributes shared by the addresses of a set, as the arguments of
+// the resource.
+var addressSetShared = []string{"comment", "color", "associated_interface", "allow_routing"}
+
+var addressSetFQDN = regexp.MustCompile(`^[A-Za-z0-9*_-]+(\.[A-Za-z0-9*_-]+)*\.?$`)
+
+func resourceFirewallAddressSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirewallAddressSetCreate,
+		Read:   resourceFirewallAddressSetRead,
+		Update: resourceFirewallAddressSetUpdate,
+		Delete: resourceFirewallAddressSetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"addresses": &schema.Schema{
+				Type:         schema.TypeMap,
+				Required:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateAddressSetAddresses,
+			},
+			"group": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(0, 79),
+				Optional:     true,
+			},
+			"comment": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Optional:     true,
+			},
+			"color": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 32),
+				Optional:     true,
+			},
+			"associated_interface": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(0, 35),
+				Optional:     true,
+			},
+			"allow_routing": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"enable", "disable"}, false),
+				Optional:     true,
+			},
+			"batch_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Optional:     true,
+				Default:      100,
+			},
+			"transaction": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"adopt_existing": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func validateAddressSetAddresses(v interface{}, k string) (ws []string, es []error) {
+	for name, value := range v.(map[string]interface{}) {
+		if len(name) > 79 {
+			es = append(es, fmt.Errorf("%s: the name %s is longer than 79 characters", k, name))
+		}
+		if _, err := addressSetValue(value.(string)); err != nil {
+			es = append(es, fmt.Errorf("%s: %s: %v", k, name, err))
+		}
+	}
+	return
+}
+
+// addressSetValue converts the value of an address of the set, a subnet, a range or an
+// FQDN, to the attributes of the address.
+func addressSetValue(v string) (map[string]interface{}, error) {
+	v = strings.TrimSpace(v)
+
+	b, err := ipMathParse(v)
+	if err != nil {
+		if addressSetFQDN.MatchString(v) && strings.ContainsAny(strings.ToLower(v), "abcdefghijklmnopqrstuvwxyz") {
+			return map[string]interface{}{"type": "fqdn", "fqdn": v}, nil
+		}
+		return nil, fmt.Errorf("%v, expected a subnet, a range or an FQDN", err)
+	}
+	if b.Bits != 32 {
+		return nil, fmt.Errorf("%s is an IPv6 address, use fortios_firewall_address6", v)
+	}
+
+	lo, hi := uint32(b.Lo.Uint64()), uint32(b.Hi.Uint64())
+	if strings.Contains(v, "-") {
+		return map[string]interface{}{"type": "iprange", "start-ip": ipv4String(lo), "end-ip": ipv4String(hi)}, nil
+	}
+	return map[string]interface{}{"type": "ipmask", "subnet": ipv4String(lo) + " " + ipv4String(^(lo ^ hi))}, nil
+}
+
+// addressSetString converts an address as FortiOS returns it to the value of the set.
+func addressSetString(o map[string]interface{}) string {
+	switch cmdbObjectString(o["type"]) {
+	case "ipmask", "":
+		if r, ok := analysisSubnet(cmdbObjectString(o["subnet"])); ok {
+			return r.ipv4CIDRs()[0]
+		}
+	case "iprange":
+		return cmdbObjectString(o["start-ip"]) + "-" + cmdbObjectString(o["end-ip"])
+	case "fqdn":
+		return cmdbObjectString(o["fqdn"])
+	}
+	return ""
+}
+
+// addressSetValueEqual reports whether the address o as FortiOS returns it has the type and
+// value of want.
+func addressSetValueEqual(want, o map[string]interface{}) bool {
+	t := cmdbObjectString(o["type"])
+	if t == "" {
+		t = "ipmask"
+	}
+	if t != want["type"] {
+		return false
+	}
+
+	switch t {
+	case "ipmask":
+		a, ok1 := analysisSubnet(want["subnet"].(string))
+		b, ok2 := analysisSubnet(cmdbObjectString(o["subnet"]))
+		return ok1 && ok2 && a == b
+	case "iprange":
+		return want["start-ip"] == cmdbObjectString(o["start-ip"]) && want["end-ip"] == cmdbObjectString(o["end-ip"])
+	}
+	return strings.EqualFold(strings.TrimSuffix(want["fqdn"].(string), "."), strings.TrimSuffix(cmdbObjectString(o["fqdn"]), "."))
+}
+
+// addressSetSharedAttributes returns the shared attributes configured, with their FortiOS names.
+func addressSetSharedAttributes(d *schema.ResourceData) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, k := range addressSetShared {
+		if v, ok := d.GetOkExists(k); ok {
+			res[fortiAPIKey(k)] = v
+		}
+	}
+	return res
+}
+
+// addressSetSharedEqual reports whether the address o as FortiOS returns it has the shared
+// attributes.
+func addressSetSharedEqual(shared, o map[string]interface{}) bool {
+	for k, v := range shared {
+		if n, ok := v.(int); ok {
+			if fortiIntValue(o[k]) != n {
+				return false
+			}
+		} else if cmdbObjectString(o[k]) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// addressSetSharedDrift returns the shared attributes, by argument name, which the address o
+// as FortiOS returns it does not have.
+func addressSetSharedDrift(shared, o map[string]interface{}) []string {
+	var res []string
+	for _, k := range addressSetShared {
+		if v, ok := shared[fortiAPIKey(k)]; ok && !addressSetSharedEqual(map[string]interface{}{fortiAPIKey(k): v}, o) {
+			res = append(res, k)
+		}
+	}
+	return res
+}
+
+// addressSetFilterLength limits the length of the filter of a request reading addresses, to
+// keep the URL within the length FortiOS accepts.
+const addressSetFilterLength = 4096
+
+// addressSetFilters returns the filters selecting the addresses named, one per request.
+func addressSetFilters(names []string) []string {
+	var res []string
+	var b strings.Builder
+	for _, n := range names {
+		// commas separate the conditions of a filter
+		v := strings.NewReplacer(`\`, `\\`, ",", `\,`).Replace(n)
+		cond := "name==" + forticlient.EscapeURLString(v)
+		if b.Len() > 0 && b.Len()+1+len(cond) > addressSetFilterLength {
+			res = append(res, b.String())
+			b.Reset()
+		}
+		if b.Len() == 0 {
+			b.WriteString("filter=")
+		} else {
+			b.WriteString(",")
+		}
+		b.WriteString(cond)
+	}
+	if b.Len() > 0 {
+		res = append(res, b.String())
+	}
+	return res
+}
+
+// addressSetTable reads the addresses named by name, with one filtered request unless the
+// names do not fit in one filter.
+func addressSetTable(c *forticlient.FortiSDKClient, names []string, vdomparam string) (map[string]map[string]interface{}, error) {
+	table := make(map[string]map[string]interface{}, len(names))
+	for _, filter := range addressSetFilters(names) {
+		res, err := fortiGenericRequest(c, "GET", "/api/v2/cmdb/firewall/address", addressSetFormat+"&"+filter, nil, vdomparam)
+		if err != nil {
+			return nil, err
+		}
+
+		l, ok := res["results"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected results of /api/v2/cmdb/firewall/address")
+		}
+		for n, o := range analysisByName(l) {
+			table[n] = o
+		}
+	}
+	return table, nil
+}
+
+// addressSetNames returns the names of the addresses of the maps, sorted.
+func addressSetNames(l ...map[string]interface{}) []string {
+	var res []string
+	seen := make(map[string]bool)
+	for _, m := range l {
+		for n := range m {
+			if !seen[n] {
+				seen[n] = true
+				res = append(res, n)
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// addressSetOp is a request changing an address of the set.
+type addressSetOp struct {
+	Method string
+	Name   string
+	Body   map[string]interface{}
+}
+
+func (op addressSetOp) path() string {
+	if op.Method == "POST" {
+		return cmdbObjectURL("firewall/address", "")
+	}
+	return cmdbObjectURL("firewall/address", op.Name)
+}
+
+// addressSetApplyOps sends the requests in batches, each in a transaction if transaction is
+// set and FortiOS supports them. done is called for each request once applied.
+func addressSetApplyOps(c *forticlient.FortiSDKClient, ops []addressSetOp, size int, transaction bool, vdomparam string, done func(addressSetOp)) error {
+	for i := 0; i < len(ops); i += size {
+		batch := ops[i:]
+		if len(batch) > size {
+			batch = batch[:size]
+		}
+
+		t := &cmdbTransaction{c: c, vdomparam: vdomparam}
+		if transaction {
+			var err error
+			if t, err = cmdbTransactionStart(c, vdomparam); err != nil {
+				log.Printf("[WARN] Cannot start a transaction, applying the address set without: %v", err)
+				t = &cmdbTransaction{c: c, vdomparam: vdomparam}
+				transaction = false
+			}
+		}
+
+		for _, op := range batch {
+			var body interface{}
+			if op.Body != nil {
+				body = op.Body
+			}
+			if _, err := t.request(op.Method, op.path(), "", body); err != nil {
+				if aerr := t.abort(); aerr != nil {
+					log.Printf("[WARN] Cannot abort transaction %s: %v", t.id, aerr)
+				}
+				return fmt.Errorf("%s address %s: %v", op.Method, op.Name, err)
+			}
+			if t.id == "" {
+				done(op)
+			}
+		}
+
+		if t.id != "" {
+			if err := t.commit(); err != nil {
+				return fmt.Errorf("cannot commit transaction %s: %v", t.id, err)
+			}
+			for _, op := range batch {
+				done(op)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addressSetGroup creates or updates the group of the set with the members.
+func addressSetGroup(c *forticlient.FortiSDKClient, group string, members []string, vdomparam string) error {
+	l := make([]interface{}, 0, len(members))
+	for _, n := range members {
+		l = append(l, map[string]interface{}{"name": n})
+	}
+
+	g, err := cmdbObjectRead(c, "firewall/addrgrp", group, vdomparam)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		_, err = fortiGenericRequest(c, "POST", cmdbObjectURL("firewall/addrgrp", ""), "", map[string]interface{}{"name": group, "member": l}, vdomparam)
+		return err
+	}
+
+	current := analysisNames(g["member"])
+	sort.Strings(current)
+	if strings.Join(current, "\n") == strings.Join(members, "\n") {
+		return nil
+	}
+
+	_, err = fortiGenericRequest(c, "PUT", cmdbObjectURL("firewall/addrgrp", group), "", map[string]interface{}{"member": l}, vdomparam)
+	return err
+}
+
+// addressSetDeleteGroup deletes the group of the set if it exists.
+func addressSetDeleteGroup(c *forticlient.FortiSDKClient, group, vdomparam string) error {
+	res, err := fortiGenericRequest(c, "DELETE", cmdbObjectURL("firewall/addrgrp", group), "", nil, vdomparam)
+	if err != nil && !fortiGenericNotFound(res) {
+		return err
+	}
+	return nil
+}
+
+// addressSetApply adds, updates and deletes the addresses to match the configuration, from
+// the addresses of the set in old, and sets the addresses applied in the state.
+func addressSetApply(d *schema.ResourceData, m interface{}, old map[string]interface{}, oldGroup string) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	want := d.Get("addresses").(map[string]interface{})
+	shared := addressSetSharedAttributes(d)
+	size := d.Get("batch_size").(int)
+	transaction := d.Get("transaction").(bool)
+
+	table, err := addressSetTable(c, addressSetNames(want, old), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading addresses: %v", err)
+	}
+
+	names := addressSetNames(want)
+
+	var ops []addressSetOp
+	for _, n := range names {
+		body, err := addressSetValue(want[n].(string))
+		if err != nil {
+			return fmt.Errorf("Error in address %s: %v", n, err)
+		}
+		for k, v := range shared {
+			body[k] = v
+		}
+
+		o, ok := table[n]
+		if !ok {
+			body["name"] = n
+			ops = append(ops, addressSetOp{Method: "POST", Name: n, Body: body})
+		} else if !addressSetValueEqual(body, o) || !addressSetSharedEqual(shared, o) {
+			ops = append(ops, addressSetOp{Method: "PUT", Name: n, Body: body})
+		}
+	}
+
+	var deletes []addressSetOp
+	for n := range old {
+		if _, ok := want[n]; ok {
+			continue
+		}
+		if _, ok := table[n]; ok {
+			deletes = append(deletes, addressSetOp{Method: "DELETE", Name: n})
+		}
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].Name < deletes[j].Name })
+
+	// the state keeps the addresses applied when a request fails
+	applied := make(map[string]interface{}, len(old))
+	for n, v := range old {
+		applied[n] = v
+	}
+	done := func(op addressSetOp) {
+		if op.Method == "DELETE" {
+			delete(applied, op.Name)
+		} else {
+			applied[op.Name] = want[op.Name]
+		}
+	}
+	fail := func(err error) error {
+		d.Set("addresses", applied)
+		d.Set("group", oldGroup)
+		return fmt.Errorf("Error applying address set %s: %v", d.Get("name").(string), err)
+	}
+
+	// the addresses which exist but are not of the set are only taken over when adopted,
+	// and are left out of the state otherwise so that they are not deleted with the set
+	if !d.Get("adopt_existing").(bool) {
+		var existing []string
+		for _, n := range names {
+			if _, ok := old[n]; ok {
+				continue
+			}
+			if _, ok := table[n]; ok {
+				existing = append(existing, n)
+			}
+		}
+		if len(existing) > 0 {
+			return fail(fmt.Errorf("the addresses %s already exist, set adopt_existing to manage them", strings.Join(existing, ", ")))
+		}
+	}
+
+	log.Printf("[INFO] Address set %s: %d to add or update, %d to delete", d.Get("name").(string), len(ops), len(deletes))
+
+	if err := addressSetApplyOps(c, ops, size, transaction, vdomparam, done); err != nil {
+		return fail(err)
+	}
+
+	// the deleted addresses must leave the groups first
+	group := d.Get("group").(string)
+	if oldGroup != "" && oldGroup != group {
+		if err := addressSetDeleteGroup(c, oldGroup, vdomparam); err != nil {
+			return fail(fmt.Errorf("cannot delete group %s: %v", oldGroup, err))
+		}
+		oldGroup = ""
+	}
+	if group != "" {
+		if err := addressSetGroup(c, group, names, vdomparam); err != nil {
+			return fail(fmt.Errorf("cannot update group %s: %v", group, err))
+		}
+		oldGroup = group
+	}
+
+	if err := addressSetApplyOps(c, deletes, size, transaction, vdomparam, done); err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
+
+func resourceFirewallAddressSetCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("name").(string))
+
+	if err := addressSetApply(d, m, nil, ""); err != nil {
+		return err
+	}
+
+	return resourceFirewallAddressSetRead(d, m)
+}
+
+func resourceFirewallAddressSetUpdate(d *schema.ResourceData, m interface{}) error {
+	o, _ := d.GetChange("addresses")
+	g, _ := d.GetChange("group")
+
+	if err := addressSetApply(d, m, o.(map[string]interface{}), g.(string)); err != nil {
+		return err
+	}
+
+	return resourceFirewallAddressSetRead(d, m)
+}
+
+func resourceFirewallAddressSetDelete(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	if group := d.Get("group").(string); group != "" {
+		if err := addressSetDeleteGroup(c, group, vdomparam); err != nil {
+			return fmt.Errorf("Error deleting group %s of address set %s: %v", group, d.Id(), err)
+		}
+	}
+
+	addresses := d.Get("addresses").(map[string]interface{})
+	table, err := addressSetTable(c, addressSetNames(addresses), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading addresses: %v", err)
+	}
+
+	var deletes []addressSetOp
+	for n := range addresses {
+		if _, ok := table[n]; ok {
+			deletes = append(deletes, addressSetOp{Method: "DELETE", Name: n})
+		}
+	}
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].Name < deletes[j].Name })
+
+	if err := addressSetApplyOps(c, deletes, d.Get("batch_size").(int), d.Get("transaction").(bool), vdomparam, func(addressSetOp) {}); err != nil {
+		return fmt.Errorf("Error deleting address set %s: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceFirewallAddressSetRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	table, err := addressSetTable(c, addressSetNames(d.Get("addresses").(map[string]interface{})), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading addresses: %v", err)
+	}
+
+	shared := addressSetSharedAttributes(d)
+
+	// the value configured is kept when FortiOS has the same address in another notation,
+	// and the addresses whose shared attributes changed get a value noting it, to be updated
+	// again while still owned by the set
+	addresses := make(map[string]interface{})
+	for n, v := range d.Get("addresses").(map[string]interface{}) {
+		o, ok := table[n]
+		if !ok {
+			continue
+		}
+
+		value := addressSetString(o)
+		if want, err := addressSetValue(v.(string)); err == nil && addressSetValueEqual(want, o) {
+			value = v.(string)
+		}
+		if drift := addressSetSharedDrift(shared, o); len(drift) > 0 {
+			value += " (" + strings.Join(drift, ", ") + " changed)"
+		}
+		addresses[n] = value
+	}
+
+	if err := d.Set("addresses", addresses); err != nil {
+		return fmt.Errorf("Error reading addresses: %v", err)
+	}
+
+	if group := d.Get("group").(string); group != "" {
+		g, err := cmdbObjectRead(c, "firewall/addrgrp", group, vdomparam)
+		if err != nil {
+			return fmt.Errorf("Error reading group %s: %v", group, err)
+		}
+
+		var members []string
+		if g != nil {
+			members = analysisNames(g["member"])
+		}
+		sort.Strings(members)
+
+		names := make([]string, 0, len(addresses))
+		for n := range addresses {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		// a group missing or with other members is created or updated again
+		if strings.Join(members, "\n") != strings.Join(names, "\n") {
+			d.Set("group", "")
+		}
+	}
+
+	return nil
+}
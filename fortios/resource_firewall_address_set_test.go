@@ -0,0 +1,198 @@
+package fortios
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAddressSetFilters(t *testing.T) {
+	filters := addressSetFilters([]string{"a", "b c", `x,y\z`})
+	if len(filters) != 1 || filters[0] != `filter=name==a,name==b%20c,name==x%5C%2Cy%5C%5Cz` {
+		t.Errorf("got %q", filters)
+	}
+
+	if filters := addressSetFilters(nil); len(filters) != 0 {
+		t.Errorf("got %q for no names", filters)
+	}
+
+	// the names which do not fit in one filter are split in several
+	names := make([]string, 1000)
+	for i := range names {
+		names[i] = "threat-intel-" + strings.Repeat("x", i%10)
+	}
+	filters = addressSetFilters(names)
+	if len(filters) < 2 {
+		t.Fatalf("got %d filters for %d names", len(filters), len(names))
+	}
+	n := 0
+	for _, f := range filters {
+		if len(f) > len("filter=")+addressSetFilterLength {
+			t.Errorf("filter of %d characters", len(f))
+		}
+		n += len(strings.Split(strings.TrimPrefix(f, "filter="), ","))
+	}
+	if n != len(names) {
+		t.Errorf("got %d names in the filters, want %d", n, len(names))
+	}
+}
+
+// testAddressSetClient returns a client with the addresses of table, and records the
+// requests changing them in requests.
+func testAddressSetClient(t *testing.T, table map[string]map[string]interface{}, requests *[]string) *FortiClient {
+	return testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+		if method == "GET" && path == "/api/v2/cmdb/firewall/address" {
+			if query.Get("format") == "" {
+				t.Errorf("addresses read without format")
+			}
+			filter := query.Get("filter")
+			if filter == "" {
+				t.Errorf("addresses read without filter")
+			}
+			var l []interface{}
+			for _, cond := range strings.Split(filter, ",") {
+				if o, ok := table[strings.TrimPrefix(cond, "name==")]; ok {
+					l = append(l, o)
+				}
+			}
+			return http.StatusOK, l
+		}
+
+		*requests = append(*requests, method+" "+path)
+		return http.StatusOK, nil
+	})
+}
+
+func TestAddressSetApply(t *testing.T) {
+	table := map[string]map[string]interface{}{
+		"ti-1":  {"name": "ti-1", "type": "ipmask", "subnet": "10.0.0.0 255.255.255.0"},
+		"other": {"name": "other", "type": "fqdn", "fqdn": "example.com"},
+	}
+	raw := map[string]interface{}{
+		"name":        "ti",
+		"addresses":   map[string]interface{}{"ti-1": "10.0.1.0/24", "ti-2": "10.0.2.0/24"},
+		"transaction": false,
+	}
+
+	// an existing address is not taken over, and is left out of the state
+	var requests []string
+	m := testAddressSetClient(t, table, &requests)
+	d := schema.TestResourceDataRaw(t, resourceFirewallAddressSet().Schema, raw)
+	d.SetId("ti")
+	err := addressSetApply(d, m, nil, "")
+	if err == nil || !strings.Contains(err.Error(), "ti-1 already exist") {
+		t.Errorf("got error %v, want ti-1 already existing", err)
+	}
+	if len(requests) != 0 {
+		t.Errorf("got requests %q", requests)
+	}
+	if l := d.Get("addresses").(map[string]interface{}); len(l) != 0 {
+		t.Errorf("got addresses %v in the state", l)
+	}
+
+	// adopted, it is updated
+	raw["adopt_existing"] = true
+	requests = nil
+	m = testAddressSetClient(t, table, &requests)
+	d = schema.TestResourceDataRaw(t, resourceFirewallAddressSet().Schema, raw)
+	d.SetId("ti")
+	if err := addressSetApply(d, m, nil, ""); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	sort.Strings(requests)
+	want := []string{"POST /api/v2/cmdb/firewall/address", "PUT /api/v2/cmdb/firewall/address/ti-1"}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got requests %q, want %q", requests, want)
+	}
+
+	// an address of the set is updated, and an address removed from the set deleted
+	delete(raw, "adopt_existing")
+	raw["addresses"] = map[string]interface{}{"ti-1": "10.0.3.0/24"}
+	requests = nil
+	m = testAddressSetClient(t, table, &requests)
+	d = schema.TestResourceDataRaw(t, resourceFirewallAddressSet().Schema, raw)
+	d.SetId("ti")
+	old := map[string]interface{}{"ti-1": "10.0.0.0/24", "other": "example.com"}
+	if err := addressSetApply(d, m, old, ""); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	want = []string{"PUT /api/v2/cmdb/firewall/address/ti-1", "DELETE /api/v2/cmdb/firewall/address/other"}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got requests %q, want %q", requests, want)
+	}
+}
+
+func TestAddressSetDrift(t *testing.T) {
+	// the comment of ti-1 was changed on the FortiGate
+	table := map[string]map[string]interface{}{
+		"ti-1": {"name": "ti-1", "type": "ipmask", "subnet": "10.0.1.0 255.255.255.0", "comment": "edited"},
+		"ti-2": {"name": "ti-2", "type": "ipmask", "subnet": "10.0.2.0 255.255.255.0", "comment": "feed"},
+	}
+	state := &terraform.InstanceState{
+		ID: "ti",
+		Attributes: map[string]string{
+			"id":             "ti",
+			"name":           "ti",
+			"addresses.%":    "2",
+			"addresses.ti-1": "10.0.1.0/24",
+			"addresses.ti-2": "10.0.2.0/24",
+			"comment":        "feed",
+			"batch_size":     "100",
+			"transaction":    "false",
+			"adopt_existing": "false",
+		},
+	}
+
+	cases := []struct {
+		addresses map[string]interface{}
+		requests  []string
+	}{
+		{
+			addresses: map[string]interface{}{"ti-1": "10.0.1.0/24", "ti-2": "10.0.2.0/24"},
+			requests:  []string{"PUT /api/v2/cmdb/firewall/address/ti-1"},
+		},
+		{
+			addresses: map[string]interface{}{"ti-2": "10.0.2.0/24"},
+			requests:  []string{"DELETE /api/v2/cmdb/firewall/address/ti-1"},
+		},
+	}
+
+	for _, c := range cases {
+		var requests []string
+		m := testAddressSetClient(t, table, &requests)
+		r := resourceFirewallAddressSet()
+
+		s, err := r.Refresh(state, m)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if v := s.Attributes["addresses.ti-1"]; v != "10.0.1.0/24 (comment changed)" {
+			t.Errorf("got ti-1 %q in the state", v)
+		}
+		if v := s.Attributes["addresses.ti-2"]; v != "10.0.2.0/24" {
+			t.Errorf("got ti-2 %q in the state", v)
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":        "ti",
+			"addresses":   c.addresses,
+			"comment":     "feed",
+			"transaction": false,
+		})
+		diff, err := r.Diff(s, config, m)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if _, err := r.Apply(s, diff, m); err != nil {
+			t.Errorf("%v: unexpected error %v", c.addresses, err)
+		}
+		if strings.Join(requests, "\n") != strings.Join(c.requests, "\n") {
+			t.Errorf("%v: got requests %q, want %q", c.addresses, requests, c.requests)
+		}
+	}
+}
@@ -56,7 +56,7 @@ func testAccCheckFortiOSFirewallAddressExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress(i)
+		o, err := c.ReadFirewallAddress(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAddress: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckFirewallAddressDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddress(i)
+		o, err := c.ReadFirewallAddress(i, "")
 
 		if err == nil {
 			if o != nil {
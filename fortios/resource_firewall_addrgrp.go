@@ -26,7 +26,7 @@ func resourceFirewallAddrgrp() *schema.Resource {
 		Delete: resourceFirewallAddrgrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceFirewallAddrgrpCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_addrgrp", obj)
+
 	o, err := c.CreateFirewallAddrgrp(obj, vdomparam)
 
 	if err != nil {
@@ -207,6 +209,8 @@ func resourceFirewallAddrgrpUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_addrgrp", obj)
+
 	o, err := c.UpdateFirewallAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddrgrp resource: %v", err)
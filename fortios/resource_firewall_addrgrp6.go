@@ -26,7 +26,7 @@ func resourceFirewallAddrgrp6() *schema.Resource {
 		Delete: resourceFirewallAddrgrp6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,6 +141,8 @@ func resourceFirewallAddrgrp6Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallAddrgrp6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_addrgrp6", obj)
+
 	o, err := c.CreateFirewallAddrgrp6(obj, vdomparam)
 
 	if err != nil {
@@ -174,6 +176,8 @@ func resourceFirewallAddrgrp6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallAddrgrp6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_addrgrp6", obj)
+
 	o, err := c.UpdateFirewallAddrgrp6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAddrgrp6 resource: %v", err)
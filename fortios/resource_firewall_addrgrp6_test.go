@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallAddrgrp6Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddrgrp6(i)
+		o, err := c.ReadFirewallAddrgrp6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAddrgrp6: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallAddrgrp6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddrgrp6(i)
+		o, err := c.ReadFirewallAddrgrp6(i, "")
 
 		if err == nil {
 			if o != nil {
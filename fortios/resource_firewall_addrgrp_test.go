@@ -55,7 +55,7 @@ func testAccCheckFortiOSFirewallAddrgrpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddrgrp(i)
+		o, err := c.ReadFirewallAddrgrp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAddrgrp: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckFirewallAddrgrpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAddrgrp(i)
+		o, err := c.ReadFirewallAddrgrp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallAuthPortal() *schema.Resource {
 		Delete: resourceFirewallAuthPortalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceFirewallAuthPortalUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallAuthPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_authportal", obj)
+
 	o, err := c.UpdateFirewallAuthPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallAuthPortal resource: %v", err)
@@ -128,6 +130,8 @@ func resourceFirewallAuthPortalDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallAuthPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_authportal", obj)
+
 	_, err = c.UpdateFirewallAuthPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallAuthPortal resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSFirewallAuthPortalExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAuthPortal(i)
+		o, err := c.ReadFirewallAuthPortal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallAuthPortal: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckFirewallAuthPortalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallAuthPortal(i)
+		o, err := c.ReadFirewallAuthPortal(i, "")
 
 		if err == nil {
 			if o != nil {
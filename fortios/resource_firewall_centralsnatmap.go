@@ -26,7 +26,7 @@ func resourceFirewallCentralSnatMap() *schema.Resource {
 		Delete: resourceFirewallCentralSnatMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/central-snat-map", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceFirewallCentralSnatMapCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallCentralSnatMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_centralsnatmap", obj)
+
 	o, err := c.CreateFirewallCentralSnatMap(obj, vdomparam)
 
 	if err != nil {
@@ -260,6 +262,8 @@ func resourceFirewallCentralSnatMapUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallCentralSnatMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_centralsnatmap", obj)
+
 	o, err := c.UpdateFirewallCentralSnatMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCentralSnatMap resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSFirewallCentralSnatMapExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallCentralSnatMap(i)
+		o, err := c.ReadFirewallCentralSnatMap(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallCentralSnatMap: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckFirewallCentralSnatMapDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallCentralSnatMap(i)
+		o, err := c.ReadFirewallCentralSnatMap(i, "")
 
 		if err == nil {
 			if o != nil {
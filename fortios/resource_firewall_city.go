@@ -26,7 +26,7 @@ func resourceFirewallCity() *schema.Resource {
 		Delete: resourceFirewallCityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/city", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceFirewallCityCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallCity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_city", obj)
+
 	o, err := c.CreateFirewallCity(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceFirewallCityUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallCity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_city", obj)
+
 	o, err := c.UpdateFirewallCity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCity resource: %v", err)
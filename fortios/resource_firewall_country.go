@@ -26,7 +26,7 @@ func resourceFirewallCountry() *schema.Resource {
 		Delete: resourceFirewallCountryDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/country", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceFirewallCountryCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallCountry resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_country", obj)
+
 	o, err := c.CreateFirewallCountry(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceFirewallCountryUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallCountry resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_country", obj)
+
 	o, err := c.UpdateFirewallCountry(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallCountry resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallDecryptedTrafficMirror() *schema.Resource {
 		Delete: resourceFirewallDecryptedTrafficMirrorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceFirewallDecryptedTrafficMirrorCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error creating FirewallDecryptedTrafficMirror resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_decryptedtrafficmirror", obj)
+
 	o, err := c.CreateFirewallDecryptedTrafficMirror(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceFirewallDecryptedTrafficMirrorUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallDecryptedTrafficMirror resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_decryptedtrafficmirror", obj)
+
 	o, err := c.UpdateFirewallDecryptedTrafficMirror(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDecryptedTrafficMirror resource: %v", err)
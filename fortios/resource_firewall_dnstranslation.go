@@ -26,7 +26,7 @@ func resourceFirewallDnstranslation() *schema.Resource {
 		Delete: resourceFirewallDnstranslationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/dnstranslation", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +76,8 @@ func resourceFirewallDnstranslationCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallDnstranslation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_dnstranslation", obj)
+
 	o, err := c.CreateFirewallDnstranslation(obj, vdomparam)
 
 	if err != nil {
@@ -109,6 +111,8 @@ func resourceFirewallDnstranslationUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallDnstranslation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_dnstranslation", obj)
+
 	o, err := c.UpdateFirewallDnstranslation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallDnstranslation resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSFirewallDnstranslationExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallDnstranslation(i)
+		o, err := c.ReadFirewallDnstranslation(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallDnstranslation: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckFirewallDnstranslationDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallDnstranslation(i)
+		o, err := c.ReadFirewallDnstranslation(i, "")
 
 		if err == nil {
 			if o != nil {
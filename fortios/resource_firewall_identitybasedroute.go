@@ -26,7 +26,7 @@ func resourceFirewallIdentityBasedRoute() *schema.Resource {
 		Delete: resourceFirewallIdentityBasedRouteDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,6 +110,8 @@ func resourceFirewallIdentityBasedRouteCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating FirewallIdentityBasedRoute resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_identitybasedroute", obj)
+
 	o, err := c.CreateFirewallIdentityBasedRoute(obj, vdomparam)
 
 	if err != nil {
@@ -143,6 +145,8 @@ func resourceFirewallIdentityBasedRouteUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallIdentityBasedRoute resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_identitybasedroute", obj)
+
 	o, err := c.UpdateFirewallIdentityBasedRoute(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIdentityBasedRoute resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSFirewallIdentityBasedRouteExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIdentityBasedRoute(i)
+		o, err := c.ReadFirewallIdentityBasedRoute(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIdentityBasedRoute: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckFirewallIdentityBasedRouteDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIdentityBasedRoute(i)
+		o, err := c.ReadFirewallIdentityBasedRoute(i, "")
 
 		if err == nil {
 			if o != nil {
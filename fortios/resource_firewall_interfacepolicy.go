@@ -26,7 +26,7 @@ func resourceFirewallInterfacePolicy() *schema.Resource {
 		Delete: resourceFirewallInterfacePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/interface-policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceFirewallInterfacePolicyCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating FirewallInterfacePolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_interfacepolicy", obj)
+
 	o, err := c.CreateFirewallInterfacePolicy(obj, vdomparam)
 
 	if err != nil {
@@ -260,6 +262,8 @@ func resourceFirewallInterfacePolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallInterfacePolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_interfacepolicy", obj)
+
 	o, err := c.UpdateFirewallInterfacePolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInterfacePolicy resource: %v", err)
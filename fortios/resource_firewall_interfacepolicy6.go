@@ -26,7 +26,7 @@ func resourceFirewallInterfacePolicy6() *schema.Resource {
 		Delete: resourceFirewallInterfacePolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/interface-policy6", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceFirewallInterfacePolicy6Create(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating FirewallInterfacePolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_interfacepolicy6", obj)
+
 	o, err := c.CreateFirewallInterfacePolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -260,6 +262,8 @@ func resourceFirewallInterfacePolicy6Update(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallInterfacePolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_interfacepolicy6", obj)
+
 	o, err := c.UpdateFirewallInterfacePolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInterfacePolicy6 resource: %v", err)
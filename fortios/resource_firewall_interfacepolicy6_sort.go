@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFirewallInterfacePolicy6Sort() *schema.Resource {
+	return resourceTableSort(orderTableOf("fortios_firewall_interfacepolicy6"))
+}
@@ -63,7 +63,7 @@ func testAccCheckFortiOSFirewallInterfacePolicy6Exists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInterfacePolicy6(i)
+		o, err := c.ReadFirewallInterfacePolicy6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallInterfacePolicy6: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckFirewallInterfacePolicy6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInterfacePolicy6(i)
+		o, err := c.ReadFirewallInterfacePolicy6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -63,7 +63,7 @@ func testAccCheckFortiOSFirewallInterfacePolicyExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInterfacePolicy(i)
+		o, err := c.ReadFirewallInterfacePolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallInterfacePolicy: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckFirewallInterfacePolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInterfacePolicy(i)
+		o, err := c.ReadFirewallInterfacePolicy(i, "")
 
 		if err == nil {
 			if o != nil {
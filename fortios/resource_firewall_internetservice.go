@@ -26,7 +26,7 @@ func resourceFirewallInternetService() *schema.Resource {
 		Delete: resourceFirewallInternetServiceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,6 +119,8 @@ func resourceFirewallInternetServiceCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating FirewallInternetService resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservice", obj)
+
 	o, err := c.CreateFirewallInternetService(obj, vdomparam)
 
 	if err != nil {
@@ -152,6 +154,8 @@ func resourceFirewallInternetServiceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallInternetService resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservice", obj)
+
 	o, err := c.UpdateFirewallInternetService(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetService resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceAddition() *schema.Resource {
 		Delete: resourceFirewallInternetServiceAdditionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-addition", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +116,8 @@ func resourceFirewallInternetServiceAdditionCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating FirewallInternetServiceAddition resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceaddition", obj)
+
 	o, err := c.CreateFirewallInternetServiceAddition(obj, vdomparam)
 
 	if err != nil {
@@ -149,6 +151,8 @@ func resourceFirewallInternetServiceAdditionUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating FirewallInternetServiceAddition resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceaddition", obj)
+
 	o, err := c.UpdateFirewallInternetServiceAddition(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceAddition resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceAppend() *schema.Resource {
 		Delete: resourceFirewallInternetServiceAppendDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +69,8 @@ func resourceFirewallInternetServiceAppendUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceAppend resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceappend", obj)
+
 	o, err := c.UpdateFirewallInternetServiceAppend(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceAppend resource: %v", err)
@@ -103,6 +105,8 @@ func resourceFirewallInternetServiceAppendDelete(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceAppend resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceappend", obj)
+
 	_, err = c.UpdateFirewallInternetServiceAppend(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallInternetServiceAppend resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceBotnet() *schema.Resource {
 		Delete: resourceFirewallInternetServiceBotnetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-botnet", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceBotnetCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating FirewallInternetServiceBotnet resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicebotnet", obj)
+
 	o, err := c.CreateFirewallInternetServiceBotnet(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceBotnetUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceBotnet resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicebotnet", obj)
+
 	o, err := c.UpdateFirewallInternetServiceBotnet(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceBotnet resource: %v", err)
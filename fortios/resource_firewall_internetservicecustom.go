@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceCustom() *schema.Resource {
 		Delete: resourceFirewallInternetServiceCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +136,8 @@ func resourceFirewallInternetServiceCustomCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating FirewallInternetServiceCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicecustom", obj)
+
 	o, err := c.CreateFirewallInternetServiceCustom(obj, vdomparam)
 
 	if err != nil {
@@ -169,6 +171,8 @@ func resourceFirewallInternetServiceCustomUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating FirewallInternetServiceCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicecustom", obj)
+
 	o, err := c.UpdateFirewallInternetServiceCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceCustom resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceCustomGroup() *schema.Resource {
 		Delete: resourceFirewallInternetServiceCustomGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceFirewallInternetServiceCustomGroupCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating FirewallInternetServiceCustomGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicecustomgroup", obj)
+
 	o, err := c.CreateFirewallInternetServiceCustomGroup(obj, vdomparam)
 
 	if err != nil {
@@ -119,6 +121,8 @@ func resourceFirewallInternetServiceCustomGroupUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating FirewallInternetServiceCustomGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicecustomgroup", obj)
+
 	o, err := c.UpdateFirewallInternetServiceCustomGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceCustomGroup resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceDefinition() *schema.Resource {
 		Delete: resourceFirewallInternetServiceDefinitionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-definition", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,6 +127,8 @@ func resourceFirewallInternetServiceDefinitionCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating FirewallInternetServiceDefinition resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicedefinition", obj)
+
 	o, err := c.CreateFirewallInternetServiceDefinition(obj, vdomparam)
 
 	if err != nil {
@@ -160,6 +162,8 @@ func resourceFirewallInternetServiceDefinitionUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceDefinition resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicedefinition", obj)
+
 	o, err := c.UpdateFirewallInternetServiceDefinition(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceDefinition resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceExtension() *schema.Resource {
 		Delete: resourceFirewallInternetServiceExtensionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-extension", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -203,6 +203,8 @@ func resourceFirewallInternetServiceExtensionCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating FirewallInternetServiceExtension resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceextension", obj)
+
 	o, err := c.CreateFirewallInternetServiceExtension(obj, vdomparam)
 
 	if err != nil {
@@ -236,6 +238,8 @@ func resourceFirewallInternetServiceExtensionUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating FirewallInternetServiceExtension resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceextension", obj)
+
 	o, err := c.UpdateFirewallInternetServiceExtension(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceExtension resource: %v", err)
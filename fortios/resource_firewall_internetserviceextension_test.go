@@ -49,7 +49,7 @@ func testAccCheckFortiOSFirewallInternetServiceExtensionExists(n string) resourc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInternetServiceExtension(i)
+		o, err := c.ReadFirewallInternetServiceExtension(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallInternetServiceExtension: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckFirewallInternetServiceExtensionDestroy(s *terraform.State) err
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallInternetServiceExtension(i)
+		o, err := c.ReadFirewallInternetServiceExtension(i, "")
 
 		if err == nil {
 			if o != nil {
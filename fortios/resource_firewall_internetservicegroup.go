@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceGroup() *schema.Resource {
 		Delete: resourceFirewallInternetServiceGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceFirewallInternetServiceGroupCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating FirewallInternetServiceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicegroup", obj)
+
 	o, err := c.CreateFirewallInternetServiceGroup(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceFirewallInternetServiceGroupUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating FirewallInternetServiceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicegroup", obj)
+
 	o, err := c.UpdateFirewallInternetServiceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceGroup resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceIpblReason() *schema.Resource {
 		Delete: resourceFirewallInternetServiceIpblReasonDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-ipbl-reason", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceIpblReasonCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating FirewallInternetServiceIpblReason resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceipblreason", obj)
+
 	o, err := c.CreateFirewallInternetServiceIpblReason(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceIpblReasonUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblReason resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceipblreason", obj)
+
 	o, err := c.UpdateFirewallInternetServiceIpblReason(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblReason resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceIpblVendor() *schema.Resource {
 		Delete: resourceFirewallInternetServiceIpblVendorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-ipbl-vendor", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceIpblVendorCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating FirewallInternetServiceIpblVendor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceipblvendor", obj)
+
 	o, err := c.CreateFirewallInternetServiceIpblVendor(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceIpblVendorUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblVendor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceipblvendor", obj)
+
 	o, err := c.UpdateFirewallInternetServiceIpblVendor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceIpblVendor resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceList() *schema.Resource {
 		Delete: resourceFirewallInternetServiceListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-list", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceListCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating FirewallInternetServiceList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicelist", obj)
+
 	o, err := c.CreateFirewallInternetServiceList(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceListUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallInternetServiceList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicelist", obj)
+
 	o, err := c.UpdateFirewallInternetServiceList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceList resource: %v", err)
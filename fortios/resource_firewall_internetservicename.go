@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceName() *schema.Resource {
 		Delete: resourceFirewallInternetServiceNameDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceFirewallInternetServiceNameCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating FirewallInternetServiceName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicename", obj)
+
 	o, err := c.CreateFirewallInternetServiceName(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceFirewallInternetServiceNameUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallInternetServiceName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicename", obj)
+
 	o, err := c.UpdateFirewallInternetServiceName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceName resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceOwner() *schema.Resource {
 		Delete: resourceFirewallInternetServiceOwnerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-owner", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceOwnerCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating FirewallInternetServiceOwner resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceowner", obj)
+
 	o, err := c.CreateFirewallInternetServiceOwner(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceOwnerUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating FirewallInternetServiceOwner resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetserviceowner", obj)
+
 	o, err := c.UpdateFirewallInternetServiceOwner(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceOwner resource: %v", err)
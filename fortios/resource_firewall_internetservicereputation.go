@@ -26,7 +26,7 @@ func resourceFirewallInternetServiceReputation() *schema.Resource {
 		Delete: resourceFirewallInternetServiceReputationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/internet-service-reputation", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceFirewallInternetServiceReputationCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating FirewallInternetServiceReputation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicereputation", obj)
+
 	o, err := c.CreateFirewallInternetServiceReputation(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceFirewallInternetServiceReputationUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating FirewallInternetServiceReputation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_internetservicereputation", obj)
+
 	o, err := c.UpdateFirewallInternetServiceReputation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallInternetServiceReputation resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallIppool() *schema.Resource {
 		Delete: resourceFirewallIppoolDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -157,6 +157,8 @@ func resourceFirewallIppoolCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallIppool resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ippool", obj)
+
 	o, err := c.CreateFirewallIppool(obj, vdomparam)
 
 	if err != nil {
@@ -190,6 +192,8 @@ func resourceFirewallIppoolUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallIppool resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ippool", obj)
+
 	o, err := c.UpdateFirewallIppool(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIppool resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallIppool6() *schema.Resource {
 		Delete: resourceFirewallIppool6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceFirewallIppool6Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallIppool6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ippool6", obj)
+
 	o, err := c.CreateFirewallIppool6(obj, vdomparam)
 
 	if err != nil {
@@ -118,6 +120,8 @@ func resourceFirewallIppool6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallIppool6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ippool6", obj)
+
 	o, err := c.UpdateFirewallIppool6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIppool6 resource: %v", err)
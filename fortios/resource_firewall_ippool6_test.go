@@ -50,7 +50,7 @@ func testAccCheckFortiOSFirewallIppool6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIppool6(i)
+		o, err := c.ReadFirewallIppool6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIppool6: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckFirewallIppool6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIppool6(i)
+		o, err := c.ReadFirewallIppool6(i, "")
 
 		if err == nil {
 			if o != nil {
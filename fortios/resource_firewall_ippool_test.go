@@ -58,7 +58,7 @@ func testAccCheckFortiOSFirewallIppoolExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIppool(i)
+		o, err := c.ReadFirewallIppool(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIppool: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckFirewallIppoolDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIppool(i)
+		o, err := c.ReadFirewallIppool(i, "")
 
 		if err == nil {
 			if o != nil {
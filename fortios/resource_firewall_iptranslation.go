@@ -26,7 +26,7 @@ func resourceFirewallIpTranslation() *schema.Resource {
 		Delete: resourceFirewallIpTranslationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/ip-translation", "transid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceFirewallIpTranslationCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallIpTranslation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_iptranslation", obj)
+
 	o, err := c.CreateFirewallIpTranslation(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceFirewallIpTranslationUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallIpTranslation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_iptranslation", obj)
+
 	o, err := c.UpdateFirewallIpTranslation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpTranslation resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallIpTranslationExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpTranslation(i)
+		o, err := c.ReadFirewallIpTranslation(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIpTranslation: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallIpTranslationDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpTranslation(i)
+		o, err := c.ReadFirewallIpTranslation(i, "")
 
 		if err == nil {
 			if o != nil {
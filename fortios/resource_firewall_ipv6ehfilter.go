@@ -26,7 +26,7 @@ func resourceFirewallIpv6EhFilter() *schema.Resource {
 		Delete: resourceFirewallIpv6EhFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -99,6 +99,8 @@ func resourceFirewallIpv6EhFilterUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallIpv6EhFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ipv6ehfilter", obj)
+
 	o, err := c.UpdateFirewallIpv6EhFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpv6EhFilter resource: %v", err)
@@ -133,6 +135,8 @@ func resourceFirewallIpv6EhFilterDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallIpv6EhFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ipv6ehfilter", obj)
+
 	_, err = c.UpdateFirewallIpv6EhFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallIpv6EhFilter resource: %v", err)
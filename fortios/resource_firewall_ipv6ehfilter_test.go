@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallIpv6EhFilterExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpv6EhFilter(i)
+		o, err := c.ReadFirewallIpv6EhFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIpv6EhFilter: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallIpv6EhFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpv6EhFilter(i)
+		o, err := c.ReadFirewallIpv6EhFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallLdbMonitor() *schema.Resource {
 		Delete: resourceFirewallLdbMonitorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,6 +129,8 @@ func resourceFirewallLdbMonitorCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating FirewallLdbMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ldbmonitor", obj)
+
 	o, err := c.CreateFirewallLdbMonitor(obj, vdomparam)
 
 	if err != nil {
@@ -162,6 +164,8 @@ func resourceFirewallLdbMonitorUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallLdbMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ldbmonitor", obj)
+
 	o, err := c.UpdateFirewallLdbMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLdbMonitor resource: %v", err)
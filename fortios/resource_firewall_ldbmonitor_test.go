@@ -54,7 +54,7 @@ func testAccCheckFortiOSFirewallLdbMonitorExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLdbMonitor(i)
+		o, err := c.ReadFirewallLdbMonitor(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallLdbMonitor: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckFirewallLdbMonitorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLdbMonitor(i)
+		o, err := c.ReadFirewallLdbMonitor(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallLocalInPolicy() *schema.Resource {
 		Delete: resourceFirewallLocalInPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/local-in-policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -160,6 +160,8 @@ func resourceFirewallLocalInPolicyCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallLocalInPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_localinpolicy", obj)
+
 	o, err := c.CreateFirewallLocalInPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -193,6 +195,8 @@ func resourceFirewallLocalInPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallLocalInPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_localinpolicy", obj)
+
 	o, err := c.UpdateFirewallLocalInPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLocalInPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallLocalInPolicy6() *schema.Resource {
 		Delete: resourceFirewallLocalInPolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/local-in-policy6", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,6 +154,8 @@ func resourceFirewallLocalInPolicy6Create(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallLocalInPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_localinpolicy6", obj)
+
 	o, err := c.CreateFirewallLocalInPolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -187,6 +189,8 @@ func resourceFirewallLocalInPolicy6Update(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallLocalInPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_localinpolicy6", obj)
+
 	o, err := c.UpdateFirewallLocalInPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallLocalInPolicy6 resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSFirewallLocalInPolicy6Exists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLocalInPolicy6(i)
+		o, err := c.ReadFirewallLocalInPolicy6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallLocalInPolicy6: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckFirewallLocalInPolicy6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLocalInPolicy6(i)
+		o, err := c.ReadFirewallLocalInPolicy6(i, "")
 
 		if err == nil {
 			if o != nil {
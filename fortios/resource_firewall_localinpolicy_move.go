@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFirewallLocalInPolicyMove() *schema.Resource {
+	return resourceTableMove(orderTableOf("fortios_firewall_localinpolicy"))
+}
@@ -56,7 +56,7 @@ func testAccCheckFortiOSFirewallLocalInPolicyExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLocalInPolicy(i)
+		o, err := c.ReadFirewallLocalInPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallLocalInPolicy: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckFirewallLocalInPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallLocalInPolicy(i)
+		o, err := c.ReadFirewallLocalInPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallMulticastAddress() *schema.Resource {
 		Delete: resourceFirewallMulticastAddressDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -143,6 +143,8 @@ func resourceFirewallMulticastAddressCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating FirewallMulticastAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastaddress", obj)
+
 	o, err := c.CreateFirewallMulticastAddress(obj, vdomparam)
 
 	if err != nil {
@@ -176,6 +178,8 @@ func resourceFirewallMulticastAddressUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallMulticastAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastaddress", obj)
+
 	o, err := c.UpdateFirewallMulticastAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastAddress resource: %v", err)
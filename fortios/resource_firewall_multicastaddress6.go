@@ -26,7 +26,7 @@ func resourceFirewallMulticastAddress6() *schema.Resource {
 		Delete: resourceFirewallMulticastAddress6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,6 +121,8 @@ func resourceFirewallMulticastAddress6Create(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating FirewallMulticastAddress6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastaddress6", obj)
+
 	o, err := c.CreateFirewallMulticastAddress6(obj, vdomparam)
 
 	if err != nil {
@@ -154,6 +156,8 @@ func resourceFirewallMulticastAddress6Update(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallMulticastAddress6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastaddress6", obj)
+
 	o, err := c.UpdateFirewallMulticastAddress6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastAddress6 resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSFirewallMulticastAddress6Exists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastAddress6(i)
+		o, err := c.ReadFirewallMulticastAddress6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallMulticastAddress6: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckFirewallMulticastAddress6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastAddress6(i)
+		o, err := c.ReadFirewallMulticastAddress6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -54,7 +54,7 @@ func testAccCheckFortiOSFirewallMulticastAddressExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastAddress(i)
+		o, err := c.ReadFirewallMulticastAddress(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallMulticastAddress: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckFirewallMulticastAddressDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastAddress(i)
+		o, err := c.ReadFirewallMulticastAddress(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallMulticastPolicy() *schema.Resource {
 		Delete: resourceFirewallMulticastPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/multicast-policy", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceFirewallMulticastPolicyCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating FirewallMulticastPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastpolicy", obj)
+
 	o, err := c.CreateFirewallMulticastPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -207,6 +209,8 @@ func resourceFirewallMulticastPolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallMulticastPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastpolicy", obj)
+
 	o, err := c.UpdateFirewallMulticastPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastPolicy resource: %v", err)
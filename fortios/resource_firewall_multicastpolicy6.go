@@ -26,7 +26,7 @@ func resourceFirewallMulticastPolicy6() *schema.Resource {
 		Delete: resourceFirewallMulticastPolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/multicast-policy6", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -159,6 +159,8 @@ func resourceFirewallMulticastPolicy6Create(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating FirewallMulticastPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastpolicy6", obj)
+
 	o, err := c.CreateFirewallMulticastPolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -192,6 +194,8 @@ func resourceFirewallMulticastPolicy6Update(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating FirewallMulticastPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_multicastpolicy6", obj)
+
 	o, err := c.UpdateFirewallMulticastPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallMulticastPolicy6 resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSFirewallMulticastPolicy6Exists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastPolicy6(i)
+		o, err := c.ReadFirewallMulticastPolicy6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallMulticastPolicy6: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckFirewallMulticastPolicy6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastPolicy6(i)
+		o, err := c.ReadFirewallMulticastPolicy6(i, "")
 
 		if err == nil {
 			if o != nil {
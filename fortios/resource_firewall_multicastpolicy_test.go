@@ -61,7 +61,7 @@ func testAccCheckFortiOSFirewallMulticastPolicyExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastPolicy(i)
+		o, err := c.ReadFirewallMulticastPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallMulticastPolicy: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckFirewallMulticastPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallMulticastPolicy(i)
+		o, err := c.ReadFirewallMulticastPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallPolicy() *schema.Resource {
 		Delete: resourceFirewallPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1171,6 +1171,8 @@ func resourceFirewallPolicyCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy", obj)
+
 	o, err := c.CreateFirewallPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -1204,6 +1206,8 @@ func resourceFirewallPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy", obj)
+
 	o, err := c.UpdateFirewallPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy resource: %v", err)
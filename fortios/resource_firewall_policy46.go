@@ -26,7 +26,7 @@ func resourceFirewallPolicy46() *schema.Resource {
 		Delete: resourceFirewallPolicy46Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/policy46", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -219,6 +219,8 @@ func resourceFirewallPolicy46Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallPolicy46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy46", obj)
+
 	o, err := c.CreateFirewallPolicy46(obj, vdomparam)
 
 	if err != nil {
@@ -252,6 +254,8 @@ func resourceFirewallPolicy46Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy46", obj)
+
 	o, err := c.UpdateFirewallPolicy46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy46 resource: %v", err)
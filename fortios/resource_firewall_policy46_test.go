@@ -64,7 +64,7 @@ func testAccCheckFortiOSFirewallPolicy46Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy46(i)
+		o, err := c.ReadFirewallPolicy46(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallPolicy46: %s", err)
@@ -87,7 +87,7 @@ func testAccCheckFirewallPolicy46Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy46(i)
+		o, err := c.ReadFirewallPolicy46(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallPolicy6() *schema.Resource {
 		Delete: resourceFirewallPolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/policy6", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -685,6 +685,8 @@ func resourceFirewallPolicy6Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy6", obj)
+
 	o, err := c.CreateFirewallPolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -718,6 +720,8 @@ func resourceFirewallPolicy6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy6", obj)
+
 	o, err := c.UpdateFirewallPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy6 resource: %v", err)
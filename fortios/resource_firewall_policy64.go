@@ -26,7 +26,7 @@ func resourceFirewallPolicy64() *schema.Resource {
 		Delete: resourceFirewallPolicy64Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/policy64", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -219,6 +219,8 @@ func resourceFirewallPolicy64Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallPolicy64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy64", obj)
+
 	o, err := c.CreateFirewallPolicy64(obj, vdomparam)
 
 	if err != nil {
@@ -252,6 +254,8 @@ func resourceFirewallPolicy64Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallPolicy64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_policy64", obj)
+
 	o, err := c.UpdateFirewallPolicy64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallPolicy64 resource: %v", err)
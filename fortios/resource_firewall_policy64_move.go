@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFirewallPolicy64Move() *schema.Resource {
+	return resourceTableMove(orderTableOf("fortios_firewall_policy64"))
+}
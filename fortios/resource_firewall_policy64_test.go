@@ -62,7 +62,7 @@ func testAccCheckFortiOSFirewallPolicy64Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy64(i)
+		o, err := c.ReadFirewallPolicy64(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallPolicy64: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckFirewallPolicy64Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy64(i)
+		o, err := c.ReadFirewallPolicy64(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -88,7 +88,7 @@ func testAccCheckFortiOSFirewallPolicy6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy6(i)
+		o, err := c.ReadFirewallPolicy6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallPolicy6: %s", err)
@@ -111,7 +111,7 @@ func testAccCheckFirewallPolicy6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy6(i)
+		o, err := c.ReadFirewallPolicy6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -64,7 +64,7 @@ func testAccCheckFortiOSFirewallPolicyExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy(i)
+		o, err := c.ReadFirewallPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallPolicy: %s", err)
@@ -87,7 +87,7 @@ func testAccCheckFirewallPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallPolicy(i)
+		o, err := c.ReadFirewallPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
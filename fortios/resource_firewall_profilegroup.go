@@ -26,7 +26,7 @@ func resourceFirewallProfileGroup() *schema.Resource {
 		Delete: resourceFirewallProfileGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,6 +169,8 @@ func resourceFirewallProfileGroupCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallProfileGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_profilegroup", obj)
+
 	o, err := c.CreateFirewallProfileGroup(obj, vdomparam)
 
 	if err != nil {
@@ -202,6 +204,8 @@ func resourceFirewallProfileGroupUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProfileGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_profilegroup", obj)
+
 	o, err := c.UpdateFirewallProfileGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProfileGroup resource: %v", err)
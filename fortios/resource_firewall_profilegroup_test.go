@@ -50,7 +50,7 @@ func testAccCheckFortiOSFirewallProfileGroupExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProfileGroup(i)
+		o, err := c.ReadFirewallProfileGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallProfileGroup: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckFirewallProfileGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProfileGroup(i)
+		o, err := c.ReadFirewallProfileGroup(i, "")
 
 		if err == nil {
 			if o != nil {
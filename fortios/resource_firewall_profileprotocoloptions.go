@@ -26,7 +26,7 @@ func resourceFirewallProfileProtocolOptions() *schema.Resource {
 		Delete: resourceFirewallProfileProtocolOptionsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -880,6 +880,8 @@ func resourceFirewallProfileProtocolOptionsCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error creating FirewallProfileProtocolOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_profileprotocoloptions", obj)
+
 	o, err := c.CreateFirewallProfileProtocolOptions(obj, vdomparam)
 
 	if err != nil {
@@ -913,6 +915,8 @@ func resourceFirewallProfileProtocolOptionsUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating FirewallProfileProtocolOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_profileprotocoloptions", obj)
+
 	o, err := c.UpdateFirewallProfileProtocolOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProfileProtocolOptions resource: %v", err)
@@ -122,7 +122,7 @@ func testAccCheckFortiOSFirewallProfileProtocolOptionsExists(n string) resource.
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProfileProtocolOptions(i)
+		o, err := c.ReadFirewallProfileProtocolOptions(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallProfileProtocolOptions: %s", err)
@@ -145,7 +145,7 @@ func testAccCheckFirewallProfileProtocolOptionsDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProfileProtocolOptions(i)
+		o, err := c.ReadFirewallProfileProtocolOptions(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallProxyAddress() *schema.Resource {
 		Delete: resourceFirewallProxyAddressDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -226,6 +226,8 @@ func resourceFirewallProxyAddressCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallProxyAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxyaddress", obj)
+
 	o, err := c.CreateFirewallProxyAddress(obj, vdomparam)
 
 	if err != nil {
@@ -259,6 +261,8 @@ func resourceFirewallProxyAddressUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProxyAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxyaddress", obj)
+
 	o, err := c.UpdateFirewallProxyAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyAddress resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallProxyAddressExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProxyAddress(i)
+		o, err := c.ReadFirewallProxyAddress(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallProxyAddress: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallProxyAddressDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProxyAddress(i)
+		o, err := c.ReadFirewallProxyAddress(i, "")
 
 		if err == nil {
 			if o != nil {
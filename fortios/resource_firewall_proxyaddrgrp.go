@@ -26,7 +26,7 @@ func resourceFirewallProxyAddrgrp() *schema.Resource {
 		Delete: resourceFirewallProxyAddrgrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,6 +141,8 @@ func resourceFirewallProxyAddrgrpCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallProxyAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxyaddrgrp", obj)
+
 	o, err := c.CreateFirewallProxyAddrgrp(obj, vdomparam)
 
 	if err != nil {
@@ -174,6 +176,8 @@ func resourceFirewallProxyAddrgrpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallProxyAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxyaddrgrp", obj)
+
 	o, err := c.UpdateFirewallProxyAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyAddrgrp resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallProxyPolicy() *schema.Resource {
 		Delete: resourceFirewallProxyPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/proxy-policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -603,6 +603,8 @@ func resourceFirewallProxyPolicyCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating FirewallProxyPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxypolicy", obj)
+
 	o, err := c.CreateFirewallProxyPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -636,6 +638,8 @@ func resourceFirewallProxyPolicyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallProxyPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_proxypolicy", obj)
+
 	o, err := c.UpdateFirewallProxyPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallProxyPolicy resource: %v", err)
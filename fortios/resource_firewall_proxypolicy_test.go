@@ -73,7 +73,7 @@ func testAccCheckFortiOSFirewallProxyPolicyExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProxyPolicy(i)
+		o, err := c.ReadFirewallProxyPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallProxyPolicy: %s", err)
@@ -96,7 +96,7 @@ func testAccCheckFirewallProxyPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallProxyPolicy(i)
+		o, err := c.ReadFirewallProxyPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallRegion() *schema.Resource {
 		Delete: resourceFirewallRegionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/region", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceFirewallRegionCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallRegion resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_region", obj)
+
 	o, err := c.CreateFirewallRegion(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceFirewallRegionUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallRegion resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_region", obj)
+
 	o, err := c.UpdateFirewallRegion(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallRegion resource: %v", err)
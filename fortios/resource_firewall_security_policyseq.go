@@ -102,8 +102,9 @@ func resourceFirewallSecurityPolicySeqCreateUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("<alter_position> param should be only 'after' or 'before'")
 	}
 
-	err := c.CreateUpdateFirewallSecurityPolicySeq(srcId, dstId, alterPos, vdomparam)
-	if err != nil {
+	t := orderTableOf("fortios_firewall_policy")
+	orderForget(m, t, vdomparam)
+	if err := orderApply(c, t, orderMove{ID: srcId, Where: alterPos, Dst: dstId}, vdomparam); err != nil {
 		return fmt.Errorf("Error Altering Firewall Security Policy Sequence: %s", err)
 	}
 
@@ -157,22 +158,15 @@ func resourceFirewallSecurityPolicySeqRead(d *schema.ResourceData, m interface{}
 	}
 
 	if o != nil {
-		i := 1
-		now_sid := -1
-		now_did := -1
+		current := make([]string, 0, len(o))
 
 		var items []interface{}
 		for _, z := range o {
 			m := make(map[string]interface{})
 
 			idn := z.PolicyID
-			if idn == strconv.Itoa(d.Get("policy_src_id").(int)) {
-				now_sid = i
-				idn = "*" + idn
-			}
-
-			if idn == strconv.Itoa(d.Get("policy_dst_id").(int)) {
-				now_did = i
+			current = append(current, idn)
+			if idn == strconv.Itoa(sid) || idn == strconv.Itoa(did) {
 				idn = "*" + idn
 			}
 
@@ -181,50 +175,13 @@ func resourceFirewallSecurityPolicySeqRead(d *schema.ResourceData, m interface{}
 			m["action"] = z.Action
 
 			items = append(items, m)
-			i += 1
 		}
 
 		if err := d.Set("state_policy_list", items); err != nil {
 			log.Printf("[WARN] Error reading Firewall Security Policy List for (%s): %s", d.Id(), err)
 		}
 
-		state_policy_srcdst_pos := ""
-
-		if now_sid == -1 || now_did == -1 {
-			if now_sid == -1 && now_did == -1 {
-				state_policy_srcdst_pos = "policies with policy_src_id(" + strconv.Itoa(sid) + ")  and policy_dst_id(" + strconv.Itoa(did) + ") were deleted"
-			} else if now_sid == -1 {
-				state_policy_srcdst_pos = "policy with policy_src_id(" + strconv.Itoa(sid) + ") was deleted"
-			} else if now_did == -1 {
-				state_policy_srcdst_pos = "policy with policy_dst_id(" + strconv.Itoa(did) + ") was deleted"
-			}
-		} else {
-			bconsistent := true
-			if action == "before" {
-				if now_sid != now_did-1 {
-					bconsistent = false
-				}
-			}
-
-			if action == "after" {
-				if now_sid != now_did+1 {
-					bconsistent = false
-				}
-			}
-
-			if bconsistent == false {
-				relative_pos := now_sid - now_did
-
-				if relative_pos > 0 {
-					state_policy_srcdst_pos = "policy with policy_src_id(" + strconv.Itoa(sid) + ") is " + strconv.Itoa(relative_pos) + " behind policy with policy_dst_id(" + strconv.Itoa(did) + ")"
-				} else {
-					state_policy_srcdst_pos = "policy with policy_src_id(" + strconv.Itoa(sid) + ") is " + strconv.Itoa(-relative_pos) + " ahead of policy with policy_dst_id(" + strconv.Itoa(did) + ")"
-				}
-			}
-		}
-
-		d.Set("state_policy_srcdst_pos", state_policy_srcdst_pos)
-
+		d.Set("state_policy_srcdst_pos", orderMoveState(orderTableOf("fortios_firewall_policy"), current, "policy_src_id", strconv.Itoa(sid), "policy_dst_id", strconv.Itoa(did), action))
 	}
 
 	return nil
@@ -98,8 +98,7 @@ func resourceFirewallSecurityPolicySortCreateUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Unsupported sort direction: " + sortdirection)
 	}
 
-	err := c.CreateUpdateFirewallSecurityPolicySort(sortby, sortdirection, vdomparam)
-	if err != nil {
+	if err := orderSort(m, orderTableOf("fortios_firewall_policy"), sortby, sortdirection, vdomparam); err != nil {
 		return fmt.Errorf("Error Sort Firewall Security Policies: %s", err)
 	}
 
@@ -138,16 +137,12 @@ func resourceFirewallSecurityPolicySortRead(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Unsupported sort direction: " + sortdirection)
 	}
 
-	sorted, err := c.ReadFirewallSecurityPolicySort(sortby, sortdirection, vdomparam)
+	status, err := orderSortStatus(c, orderTableOf("fortios_firewall_policy"), sortby, sortdirection, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error reading Firewall Security Policy Sort Status: %s %s", err, mkey)
 	}
 
-	if sorted == false {
-		d.Set("status", "unsorted")
-	} else {
-		d.Set("status", "")
-	}
+	d.Set("status", status)
 
 	o, err := c.GetSecurityPolicyList(vdomparam)
 	if err != nil {
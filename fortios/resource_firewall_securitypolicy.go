@@ -26,7 +26,7 @@ func resourceFirewallSecurityPolicy() *schema.Resource {
 		Delete: resourceFirewallSecurityPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/security-policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -635,6 +635,8 @@ func resourceFirewallSecurityPolicyCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallSecurityPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_securitypolicy", obj)
+
 	o, err := c.CreateFirewallSecurityPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -668,6 +670,8 @@ func resourceFirewallSecurityPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallSecurityPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_securitypolicy", obj)
+
 	o, err := c.UpdateFirewallSecurityPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSecurityPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallShapingPolicy() *schema.Resource {
 		Delete: resourceFirewallShapingPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/shaping-policy", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -479,6 +479,8 @@ func resourceFirewallShapingPolicyCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallShapingPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_shapingpolicy", obj)
+
 	o, err := c.CreateFirewallShapingPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -512,6 +514,8 @@ func resourceFirewallShapingPolicyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallShapingPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_shapingpolicy", obj)
+
 	o, err := c.UpdateFirewallShapingPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShapingPolicy resource: %v", err)
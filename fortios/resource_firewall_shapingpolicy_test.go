@@ -65,7 +65,7 @@ func testAccCheckFortiOSFirewallShapingPolicyExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShapingPolicy(i)
+		o, err := c.ReadFirewallShapingPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallShapingPolicy: %s", err)
@@ -88,7 +88,7 @@ func testAccCheckFirewallShapingPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShapingPolicy(i)
+		o, err := c.ReadFirewallShapingPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
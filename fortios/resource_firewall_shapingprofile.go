@@ -26,7 +26,7 @@ func resourceFirewallShapingProfile() *schema.Resource {
 		Delete: resourceFirewallShapingProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -151,6 +151,8 @@ func resourceFirewallShapingProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallShapingProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_shapingprofile", obj)
+
 	o, err := c.CreateFirewallShapingProfile(obj, vdomparam)
 
 	if err != nil {
@@ -184,6 +186,8 @@ func resourceFirewallShapingProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallShapingProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_shapingprofile", obj)
+
 	o, err := c.UpdateFirewallShapingProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShapingProfile resource: %v", err)
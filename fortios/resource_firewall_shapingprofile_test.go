@@ -54,7 +54,7 @@ func testAccCheckFortiOSFirewallShapingProfileExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShapingProfile(i)
+		o, err := c.ReadFirewallShapingProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallShapingProfile: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckFirewallShapingProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShapingProfile(i)
+		o, err := c.ReadFirewallShapingProfile(i, "")
 
 		if err == nil {
 			if o != nil {
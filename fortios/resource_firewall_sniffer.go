@@ -26,7 +26,7 @@ func resourceFirewallSniffer() *schema.Resource {
 		Delete: resourceFirewallSnifferDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/sniffer", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -298,6 +298,8 @@ func resourceFirewallSnifferCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallSniffer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sniffer", obj)
+
 	o, err := c.CreateFirewallSniffer(obj, vdomparam)
 
 	if err != nil {
@@ -331,6 +333,8 @@ func resourceFirewallSnifferUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallSniffer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sniffer", obj)
+
 	o, err := c.UpdateFirewallSniffer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSniffer resource: %v", err)
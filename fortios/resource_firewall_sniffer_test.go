@@ -63,7 +63,7 @@ func testAccCheckFortiOSFirewallSnifferExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSniffer(i)
+		o, err := c.ReadFirewallSniffer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallSniffer: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckFirewallSnifferDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSniffer(i)
+		o, err := c.ReadFirewallSniffer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallSslServer() *schema.Resource {
 		Delete: resourceFirewallSslServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,6 +127,8 @@ func resourceFirewallSslServerCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating FirewallSslServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sslserver", obj)
+
 	o, err := c.CreateFirewallSslServer(obj, vdomparam)
 
 	if err != nil {
@@ -160,6 +162,8 @@ func resourceFirewallSslServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallSslServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sslserver", obj)
+
 	o, err := c.UpdateFirewallSslServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslServer resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSFirewallSslServerExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSslServer(i)
+		o, err := c.ReadFirewallSslServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallSslServer: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckFirewallSslServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSslServer(i)
+		o, err := c.ReadFirewallSslServer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallSslSshProfile() *schema.Resource {
 		Delete: resourceFirewallSslSshProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -958,6 +958,8 @@ func resourceFirewallSslSshProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallSslSshProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sslsshprofile", obj)
+
 	o, err := c.CreateFirewallSslSshProfile(obj, vdomparam)
 
 	if err != nil {
@@ -991,6 +993,8 @@ func resourceFirewallSslSshProfileUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallSslSshProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_sslsshprofile", obj)
+
 	o, err := c.UpdateFirewallSslSshProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslSshProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallTrafficClass() *schema.Resource {
 		Delete: resourceFirewallTrafficClassDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/traffic-class", "class-id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceFirewallTrafficClassCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallTrafficClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_trafficclass", obj)
+
 	o, err := c.CreateFirewallTrafficClass(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceFirewallTrafficClassUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallTrafficClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_trafficclass", obj)
+
 	o, err := c.UpdateFirewallTrafficClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallTrafficClass resource: %v", err)
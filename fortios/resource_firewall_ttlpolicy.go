@@ -26,7 +26,7 @@ func resourceFirewallTtlPolicy() *schema.Resource {
 		Delete: resourceFirewallTtlPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/ttl-policy", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +118,8 @@ func resourceFirewallTtlPolicyCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating FirewallTtlPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ttlpolicy", obj)
+
 	o, err := c.CreateFirewallTtlPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -151,6 +153,8 @@ func resourceFirewallTtlPolicyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallTtlPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_ttlpolicy", obj)
+
 	o, err := c.UpdateFirewallTtlPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallTtlPolicy resource: %v", err)
@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFirewallTtlPolicyOrder() *schema.Resource {
+	return resourceTableOrder(orderTableOf("fortios_firewall_ttlpolicy"))
+}
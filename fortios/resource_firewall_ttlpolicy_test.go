@@ -55,7 +55,7 @@ func testAccCheckFortiOSFirewallTtlPolicyExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallTtlPolicy(i)
+		o, err := c.ReadFirewallTtlPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallTtlPolicy: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckFirewallTtlPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallTtlPolicy(i)
+		o, err := c.ReadFirewallTtlPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
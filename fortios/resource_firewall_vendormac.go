@@ -26,7 +26,7 @@ func resourceFirewallVendorMac() *schema.Resource {
 		Delete: resourceFirewallVendorMacDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall/vendor-mac", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceFirewallVendorMacCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating FirewallVendorMac resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vendormac", obj)
+
 	o, err := c.CreateFirewallVendorMac(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceFirewallVendorMacUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating FirewallVendorMac resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vendormac", obj)
+
 	o, err := c.UpdateFirewallVendorMac(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVendorMac resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallVip() *schema.Resource {
 		Delete: resourceFirewallVipDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -662,6 +662,8 @@ func resourceFirewallVipCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallVip resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip", obj)
+
 	o, err := c.CreateFirewallVip(obj, vdomparam)
 
 	if err != nil {
@@ -695,6 +697,8 @@ func resourceFirewallVipUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip", obj)
+
 	o, err := c.UpdateFirewallVip(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip resource: %v", err)
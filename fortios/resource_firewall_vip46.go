@@ -26,7 +26,7 @@ func resourceFirewallVip46() *schema.Resource {
 		Delete: resourceFirewallVip46Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -241,6 +241,8 @@ func resourceFirewallVip46Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallVip46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip46", obj)
+
 	o, err := c.CreateFirewallVip46(obj, vdomparam)
 
 	if err != nil {
@@ -274,6 +276,8 @@ func resourceFirewallVip46Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip46", obj)
+
 	o, err := c.UpdateFirewallVip46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip46 resource: %v", err)
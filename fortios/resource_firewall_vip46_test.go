@@ -59,7 +59,7 @@ func testAccCheckFortiOSFirewallVip46Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip46(i)
+		o, err := c.ReadFirewallVip46(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVip46: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckFirewallVip46Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip46(i)
+		o, err := c.ReadFirewallVip46(i, "")
 
 		if err == nil {
 			if o != nil {
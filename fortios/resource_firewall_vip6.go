@@ -26,7 +26,7 @@ func resourceFirewallVip6() *schema.Resource {
 		Delete: resourceFirewallVip6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -569,6 +569,8 @@ func resourceFirewallVip6Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallVip6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip6", obj)
+
 	o, err := c.CreateFirewallVip6(obj, vdomparam)
 
 	if err != nil {
@@ -602,6 +604,8 @@ func resourceFirewallVip6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip6", obj)
+
 	o, err := c.UpdateFirewallVip6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip6 resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallVip64() *schema.Resource {
 		Delete: resourceFirewallVip64Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceFirewallVip64Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallVip64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip64", obj)
+
 	o, err := c.CreateFirewallVip64(obj, vdomparam)
 
 	if err != nil {
@@ -260,6 +262,8 @@ func resourceFirewallVip64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVip64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vip64", obj)
+
 	o, err := c.UpdateFirewallVip64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVip64 resource: %v", err)
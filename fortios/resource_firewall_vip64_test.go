@@ -59,7 +59,7 @@ func testAccCheckFortiOSFirewallVip64Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip64(i)
+		o, err := c.ReadFirewallVip64(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVip64: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckFirewallVip64Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip64(i)
+		o, err := c.ReadFirewallVip64(i, "")
 
 		if err == nil {
 			if o != nil {
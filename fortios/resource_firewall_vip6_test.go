@@ -97,7 +97,7 @@ func testAccCheckFortiOSFirewallVip6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip6(i)
+		o, err := c.ReadFirewallVip6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVip6: %s", err)
@@ -120,7 +120,7 @@ func testAccCheckFirewallVip6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip6(i)
+		o, err := c.ReadFirewallVip6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -101,7 +101,7 @@ func testAccCheckFortiOSFirewallVipExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip(i)
+		o, err := c.ReadFirewallVip(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVip: %s", err)
@@ -124,7 +124,7 @@ func testAccCheckFirewallVipDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVip(i)
+		o, err := c.ReadFirewallVip(i, "")
 
 		if err == nil {
 			if o != nil {
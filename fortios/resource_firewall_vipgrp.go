@@ -26,7 +26,7 @@ func resourceFirewallVipgrp() *schema.Resource {
 		Delete: resourceFirewallVipgrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,6 +102,8 @@ func resourceFirewallVipgrpCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating FirewallVipgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp", obj)
+
 	o, err := c.CreateFirewallVipgrp(obj, vdomparam)
 
 	if err != nil {
@@ -135,6 +137,8 @@ func resourceFirewallVipgrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating FirewallVipgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp", obj)
+
 	o, err := c.UpdateFirewallVipgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallVipgrp46() *schema.Resource {
 		Delete: resourceFirewallVipgrp46Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceFirewallVipgrp46Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallVipgrp46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp46", obj)
+
 	o, err := c.CreateFirewallVipgrp46(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceFirewallVipgrp46Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp46 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp46", obj)
+
 	o, err := c.UpdateFirewallVipgrp46(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp46 resource: %v", err)
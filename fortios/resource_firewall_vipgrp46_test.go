@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallVipgrp46Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp46(i)
+		o, err := c.ReadFirewallVipgrp46(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVipgrp46: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallVipgrp46Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp46(i)
+		o, err := c.ReadFirewallVipgrp46(i, "")
 
 		if err == nil {
 			if o != nil {
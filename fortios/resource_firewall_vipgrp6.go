@@ -26,7 +26,7 @@ func resourceFirewallVipgrp6() *schema.Resource {
 		Delete: resourceFirewallVipgrp6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceFirewallVipgrp6Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallVipgrp6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp6", obj)
+
 	o, err := c.CreateFirewallVipgrp6(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceFirewallVipgrp6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp6", obj)
+
 	o, err := c.UpdateFirewallVipgrp6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp6 resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallVipgrp64() *schema.Resource {
 		Delete: resourceFirewallVipgrp64Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceFirewallVipgrp64Create(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating FirewallVipgrp64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp64", obj)
+
 	o, err := c.CreateFirewallVipgrp64(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceFirewallVipgrp64Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FirewallVipgrp64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewall_vipgrp64", obj)
+
 	o, err := c.UpdateFirewallVipgrp64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallVipgrp64 resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallVipgrp64Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp64(i)
+		o, err := c.ReadFirewallVipgrp64(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVipgrp64: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallVipgrp64Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp64(i)
+		o, err := c.ReadFirewallVipgrp64(i, "")
 
 		if err == nil {
 			if o != nil {
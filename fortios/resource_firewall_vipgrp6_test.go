@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallVipgrp6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp6(i)
+		o, err := c.ReadFirewallVipgrp6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVipgrp6: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallVipgrp6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp6(i)
+		o, err := c.ReadFirewallVipgrp6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallVipgrpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp(i)
+		o, err := c.ReadFirewallVipgrp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallVipgrp: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallVipgrpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallVipgrp(i)
+		o, err := c.ReadFirewallVipgrp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallConsolidatedPolicy() *schema.Resource {
 		Delete: resourceFirewallConsolidatedPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall.consolidated/policy", "policyid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -770,6 +770,8 @@ func resourceFirewallConsolidatedPolicyCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating FirewallConsolidatedPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallconsolidated_policy", obj)
+
 	o, err := c.CreateFirewallConsolidatedPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -803,6 +805,8 @@ func resourceFirewallConsolidatedPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallConsolidatedPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallconsolidated_policy", obj)
+
 	o, err := c.UpdateFirewallConsolidatedPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallConsolidatedPolicy resource: %v", err)
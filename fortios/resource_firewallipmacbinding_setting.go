@@ -26,7 +26,7 @@ func resourceFirewallIpmacbindingSetting() *schema.Resource {
 		Delete: resourceFirewallIpmacbindingSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceFirewallIpmacbindingSettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallIpmacbindingSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallipmacbinding_setting", obj)
+
 	o, err := c.UpdateFirewallIpmacbindingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpmacbindingSetting resource: %v", err)
@@ -106,6 +108,8 @@ func resourceFirewallIpmacbindingSettingDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallIpmacbindingSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallipmacbinding_setting", obj)
+
 	_, err = c.UpdateFirewallIpmacbindingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallIpmacbindingSetting resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSFirewallIpmacbindingSettingExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpmacbindingSetting(i)
+		o, err := c.ReadFirewallIpmacbindingSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIpmacbindingSetting: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckFirewallIpmacbindingSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpmacbindingSetting(i)
+		o, err := c.ReadFirewallIpmacbindingSetting(i, "")
 
 		if err == nil {
 			if o != nil {
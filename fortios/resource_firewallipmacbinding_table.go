@@ -26,7 +26,7 @@ func resourceFirewallIpmacbindingTable() *schema.Resource {
 		Delete: resourceFirewallIpmacbindingTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/firewall.ipmacbinding/table", "seq-num"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,6 +81,8 @@ func resourceFirewallIpmacbindingTableCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating FirewallIpmacbindingTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallipmacbinding_table", obj)
+
 	o, err := c.CreateFirewallIpmacbindingTable(obj, vdomparam)
 
 	if err != nil {
@@ -114,6 +116,8 @@ func resourceFirewallIpmacbindingTableUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallIpmacbindingTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallipmacbinding_table", obj)
+
 	o, err := c.UpdateFirewallIpmacbindingTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallIpmacbindingTable resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallIpmacbindingTableExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpmacbindingTable(i)
+		o, err := c.ReadFirewallIpmacbindingTable(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallIpmacbindingTable: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallIpmacbindingTableDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallIpmacbindingTable(i)
+		o, err := c.ReadFirewallIpmacbindingTable(i, "")
 
 		if err == nil {
 			if o != nil {
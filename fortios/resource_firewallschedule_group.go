@@ -26,7 +26,7 @@ func resourceFirewallScheduleGroup() *schema.Resource {
 		Delete: resourceFirewallScheduleGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,6 +91,8 @@ func resourceFirewallScheduleGroupCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallScheduleGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_group", obj)
+
 	o, err := c.CreateFirewallScheduleGroup(obj, vdomparam)
 
 	if err != nil {
@@ -124,6 +126,8 @@ func resourceFirewallScheduleGroupUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallScheduleGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_group", obj)
+
 	o, err := c.UpdateFirewallScheduleGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleGroup resource: %v", err)
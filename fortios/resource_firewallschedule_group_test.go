@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallScheduleGroupExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleGroup(i)
+		o, err := c.ReadFirewallScheduleGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallScheduleGroup: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallScheduleGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleGroup(i)
+		o, err := c.ReadFirewallScheduleGroup(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -13,6 +13,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -25,8 +26,10 @@ func resourceFirewallScheduleOnetime() *schema.Resource {
 		Update: resourceFirewallScheduleOnetimeUpdate,
 		Delete: resourceFirewallScheduleOnetimeDelete,
 
+		CustomizeDiff: validateScheduleOnetime,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,12 +44,29 @@ func resourceFirewallScheduleOnetime() *schema.Resource {
 				Required:     true,
 			},
 			"start": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validateScheduleTime,
+				DiffSuppressFunc: suppressScheduleTimeDiff,
+				Required:         true,
 			},
 			"end": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validateScheduleTime,
+				DiffSuppressFunc: suppressScheduleTimeDiff,
+				Required:         true,
+			},
+			"timezone": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateScheduleTimezone,
+				Optional:     true,
+			},
+			"expired": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"active_now": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"color": &schema.Schema{
 				Type:         schema.TypeInt,
@@ -86,6 +106,8 @@ func resourceFirewallScheduleOnetimeCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating FirewallScheduleOnetime resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_onetime", obj)
+
 	o, err := c.CreateFirewallScheduleOnetime(obj, vdomparam)
 
 	if err != nil {
@@ -119,6 +141,8 @@ func resourceFirewallScheduleOnetimeUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallScheduleOnetime resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_onetime", obj)
+
 	o, err := c.UpdateFirewallScheduleOnetime(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleOnetime resource: %v", err)
@@ -235,6 +259,11 @@ func refreshObjectFirewallScheduleOnetime(d *schema.ResourceData, o map[string]i
 		}
 	}
 
+	// without the time zone of the FortiGate, neither is set
+	expired, active, _ := scheduleStatus(cmdbObjectString(o["start"]), cmdbObjectString(o["end"]), d.Get("timezone").(string), time.Now())
+	d.Set("expired", expired)
+	d.Set("active_now", active)
+
 	if err = d.Set("color", flattenFirewallScheduleOnetimeColor(o["color"], d, "color", sv)); err != nil {
 		if !fortiAPIPatch(o["color"]) {
 			return fmt.Errorf("Error reading color: %v", err)
@@ -267,11 +296,11 @@ func expandFirewallScheduleOnetimeName(d *schema.ResourceData, v interface{}, pr
 }
 
 func expandFirewallScheduleOnetimeStart(d *schema.ResourceData, v interface{}, pre string, sv string) (interface{}, error) {
-	return v, nil
+	return scheduleTimeFortiOS(v.(string), d.Get("timezone").(string))
 }
 
 func expandFirewallScheduleOnetimeEnd(d *schema.ResourceData, v interface{}, pre string, sv string) (interface{}, error) {
-	return v, nil
+	return scheduleTimeFortiOS(v.(string), d.Get("timezone").(string))
 }
 
 func expandFirewallScheduleOnetimeColor(d *schema.ResourceData, v interface{}, pre string, sv string) (interface{}, error) {
@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallScheduleOnetimeExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleOnetime(i)
+		o, err := c.ReadFirewallScheduleOnetime(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallScheduleOnetime: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallScheduleOnetimeDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleOnetime(i)
+		o, err := c.ReadFirewallScheduleOnetime(i, "")
 
 		if err == nil {
 			if o != nil {
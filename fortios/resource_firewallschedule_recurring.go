@@ -26,7 +26,7 @@ func resourceFirewallScheduleRecurring() *schema.Resource {
 		Delete: resourceFirewallScheduleRecurringDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceFirewallScheduleRecurringCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating FirewallScheduleRecurring resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_recurring", obj)
+
 	o, err := c.CreateFirewallScheduleRecurring(obj, vdomparam)
 
 	if err != nil {
@@ -118,6 +120,8 @@ func resourceFirewallScheduleRecurringUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallScheduleRecurring resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallschedule_recurring", obj)
+
 	o, err := c.UpdateFirewallScheduleRecurring(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallScheduleRecurring resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSFirewallScheduleRecurringExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleRecurring(i)
+		o, err := c.ReadFirewallScheduleRecurring(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallScheduleRecurring: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckFirewallScheduleRecurringDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallScheduleRecurring(i)
+		o, err := c.ReadFirewallScheduleRecurring(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallServiceCategory() *schema.Resource {
 		Delete: resourceFirewallServiceCategoryDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceFirewallServiceCategoryCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating FirewallServiceCategory resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_category", obj)
+
 	o, err := c.CreateFirewallServiceCategory(obj, vdomparam)
 
 	if err != nil {
@@ -105,6 +107,8 @@ func resourceFirewallServiceCategoryUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating FirewallServiceCategory resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_category", obj)
+
 	o, err := c.UpdateFirewallServiceCategory(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceCategory resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSFirewallServiceCategoryExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceCategory(i)
+		o, err := c.ReadFirewallServiceCategory(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallServiceCategory: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckFirewallServiceCategoryDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceCategory(i)
+		o, err := c.ReadFirewallServiceCategory(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -25,8 +25,10 @@ func resourceFirewallServiceCustom() *schema.Resource {
 		Update: resourceFirewallServiceCustomUpdate,
 		Delete: resourceFirewallServiceCustomDelete,
 
+		CustomizeDiff: validateServicePorts,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,20 +93,29 @@ func resourceFirewallServiceCustom() *schema.Resource {
 				Computed:     true,
 			},
 			"tcp_portrange": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validateServicePortRanges,
+				DiffSuppressFunc: suppressServicePortRangesDiff,
+				Optional:         true,
+				Computed:         true,
 			},
+			"tcp_ports": servicePortsSchema("tcp_portrange"),
 			"udp_portrange": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validateServicePortRanges,
+				DiffSuppressFunc: suppressServicePortRangesDiff,
+				Optional:         true,
+				Computed:         true,
 			},
+			"udp_ports": servicePortsSchema("udp_portrange"),
 			"sctp_portrange": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validateServicePortRanges,
+				DiffSuppressFunc: suppressServicePortRangesDiff,
+				Optional:         true,
+				Computed:         true,
 			},
+			"sctp_ports": servicePortsSchema("sctp_portrange"),
 			"tcp_halfclose_timer": &schema.Schema{
 				Type:         schema.TypeInt,
 				ValidateFunc: validation.IntBetween(0, 86400),
@@ -224,6 +235,8 @@ func resourceFirewallServiceCustomCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating FirewallServiceCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_custom", obj)
+
 	o, err := c.CreateFirewallServiceCustom(obj, vdomparam)
 
 	if err != nil {
@@ -257,6 +270,8 @@ func resourceFirewallServiceCustomUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating FirewallServiceCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_custom", obj)
+
 	o, err := c.UpdateFirewallServiceCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceCustom resource: %v", err)
@@ -585,6 +600,14 @@ func refreshObjectFirewallServiceCustom(d *schema.ResourceData, o map[string]int
 		}
 	}
 
+	for k, portrange := range servicePortsArgs {
+		if v, ok := d.GetOk(k); ok {
+			if err = d.Set(k, flattenServicePorts(o[fortiAPIKey(portrange)], v.([]interface{}))); err != nil {
+				return fmt.Errorf("Error reading %s: %v", k, err)
+			}
+		}
+	}
+
 	if err = d.Set("tcp_halfclose_timer", flattenFirewallServiceCustomTcpHalfcloseTimer(o["tcp-halfclose-timer"], d, "tcp_halfclose_timer", sv)); err != nil {
 		if !fortiAPIPatch(o["tcp-halfclose-timer"]) {
 			return fmt.Errorf("Error reading tcp_halfclose_timer: %v", err)
@@ -1007,6 +1030,16 @@ func getObjectFirewallServiceCustom(d *schema.ResourceData, sv string) (*map[str
 		}
 	}
 
+	for k, portrange := range servicePortsArgs {
+		if v, ok := d.GetOk(k); ok {
+			t, err := servicePortsString(v.([]interface{}))
+			if err != nil {
+				return &obj, fmt.Errorf("invalid %s: %v", k, err)
+			}
+			obj[fortiAPIKey(portrange)] = t
+		}
+	}
+
 	if v, ok := d.GetOkExists("tcp_halfclose_timer"); ok {
 
 		t, err := expandFirewallServiceCustomTcpHalfcloseTimer(d, v, "tcp_halfclose_timer", sv)
@@ -63,7 +63,7 @@ func testAccCheckFortiOSFirewallServiceCustomExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceCustom(i)
+		o, err := c.ReadFirewallServiceCustom(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallServiceCustom: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckFirewallServiceCustomDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceCustom(i)
+		o, err := c.ReadFirewallServiceCustom(i, "")
 
 		if err == nil {
 			if o != nil {
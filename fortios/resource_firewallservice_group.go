@@ -26,7 +26,7 @@ func resourceFirewallServiceGroup() *schema.Resource {
 		Delete: resourceFirewallServiceGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceFirewallServiceGroupCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating FirewallServiceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_group", obj)
+
 	o, err := c.CreateFirewallServiceGroup(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceFirewallServiceGroupUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating FirewallServiceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallservice_group", obj)
+
 	o, err := c.UpdateFirewallServiceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallServiceGroup resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallServiceGroupExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceGroup(i)
+		o, err := c.ReadFirewallServiceGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallServiceGroup: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallServiceGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallServiceGroup(i)
+		o, err := c.ReadFirewallServiceGroup(i, "")
 
 		if err == nil {
 			if o != nil {
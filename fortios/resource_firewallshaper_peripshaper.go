@@ -26,7 +26,7 @@ func resourceFirewallShaperPerIpShaper() *schema.Resource {
 		Delete: resourceFirewallShaperPerIpShaperDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceFirewallShaperPerIpShaperCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating FirewallShaperPerIpShaper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallshaper_peripshaper", obj)
+
 	o, err := c.CreateFirewallShaperPerIpShaper(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceFirewallShaperPerIpShaperUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallShaperPerIpShaper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallshaper_peripshaper", obj)
+
 	o, err := c.UpdateFirewallShaperPerIpShaper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShaperPerIpShaper resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSFirewallShaperPerIpShaperExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShaperPerIpShaper(i)
+		o, err := c.ReadFirewallShaperPerIpShaper(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallShaperPerIpShaper: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckFirewallShaperPerIpShaperDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShaperPerIpShaper(i)
+		o, err := c.ReadFirewallShaperPerIpShaper(i, "")
 
 		if err == nil {
 			if o != nil {
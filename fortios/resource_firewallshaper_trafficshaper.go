@@ -26,7 +26,7 @@ func resourceFirewallShaperTrafficShaper() *schema.Resource {
 		Delete: resourceFirewallShaperTrafficShaperDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceFirewallShaperTrafficShaperCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating FirewallShaperTrafficShaper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallshaper_trafficshaper", obj)
+
 	o, err := c.CreateFirewallShaperTrafficShaper(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceFirewallShaperTrafficShaperUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating FirewallShaperTrafficShaper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallshaper_trafficshaper", obj)
+
 	o, err := c.UpdateFirewallShaperTrafficShaper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallShaperTrafficShaper resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSFirewallShaperTrafficShaperExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShaperTrafficShaper(i)
+		o, err := c.ReadFirewallShaperTrafficShaper(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallShaperTrafficShaper: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckFirewallShaperTrafficShaperDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallShaperTrafficShaper(i)
+		o, err := c.ReadFirewallShaperTrafficShaper(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallSshHostKey() *schema.Resource {
 		Delete: resourceFirewallSshHostKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,6 +104,8 @@ func resourceFirewallSshHostKeyCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating FirewallSshHostKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_hostkey", obj)
+
 	o, err := c.CreateFirewallSshHostKey(obj, vdomparam)
 
 	if err != nil {
@@ -137,6 +139,8 @@ func resourceFirewallSshHostKeyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshHostKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_hostkey", obj)
+
 	o, err := c.UpdateFirewallSshHostKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshHostKey resource: %v", err)
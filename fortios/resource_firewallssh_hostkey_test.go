@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallSshHostKeyExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSshHostKey(i)
+		o, err := c.ReadFirewallSshHostKey(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallSshHostKey: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallSshHostKeyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSshHostKey(i)
+		o, err := c.ReadFirewallSshHostKey(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallSshLocalCa() *schema.Resource {
 		Delete: resourceFirewallSshLocalCaDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,6 +83,8 @@ func resourceFirewallSshLocalCaCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating FirewallSshLocalCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_localca", obj)
+
 	o, err := c.CreateFirewallSshLocalCa(obj, vdomparam)
 
 	if err != nil {
@@ -116,6 +118,8 @@ func resourceFirewallSshLocalCaUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshLocalCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_localca", obj)
+
 	o, err := c.UpdateFirewallSshLocalCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshLocalCa resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallSshLocalKey() *schema.Resource {
 		Delete: resourceFirewallSshLocalKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,6 +83,8 @@ func resourceFirewallSshLocalKeyCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating FirewallSshLocalKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_localkey", obj)
+
 	o, err := c.CreateFirewallSshLocalKey(obj, vdomparam)
 
 	if err != nil {
@@ -116,6 +118,8 @@ func resourceFirewallSshLocalKeyUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating FirewallSshLocalKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_localkey", obj)
+
 	o, err := c.UpdateFirewallSshLocalKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshLocalKey resource: %v", err)
@@ -26,7 +26,7 @@ func resourceFirewallSshSetting() *schema.Resource {
 		Delete: resourceFirewallSshSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,6 +110,8 @@ func resourceFirewallSshSettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_setting", obj)
+
 	o, err := c.UpdateFirewallSshSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSshSetting resource: %v", err)
@@ -144,6 +146,8 @@ func resourceFirewallSshSettingDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSshSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssh_setting", obj)
+
 	_, err = c.UpdateFirewallSshSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallSshSetting resource: %v", err)
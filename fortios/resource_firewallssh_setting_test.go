@@ -56,7 +56,7 @@ func testAccCheckFortiOSFirewallSshSettingExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSshSetting(i)
+		o, err := c.ReadFirewallSshSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallSshSetting: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckFirewallSshSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSshSetting(i)
+		o, err := c.ReadFirewallSshSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceFirewallSslSetting() *schema.Resource {
 		Delete: resourceFirewallSslSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceFirewallSslSettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSslSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssl_setting", obj)
+
 	o, err := c.UpdateFirewallSslSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallSslSetting resource: %v", err)
@@ -145,6 +147,8 @@ func resourceFirewallSslSettingDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating FirewallSslSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallssl_setting", obj)
+
 	_, err = c.UpdateFirewallSslSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FirewallSslSetting resource: %v", err)
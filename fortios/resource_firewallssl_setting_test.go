@@ -58,7 +58,7 @@ func testAccCheckFortiOSFirewallSslSettingExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSslSetting(i)
+		o, err := c.ReadFirewallSslSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallSslSetting: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckFirewallSslSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallSslSetting(i)
+		o, err := c.ReadFirewallSslSetting(i, "")
 
 		if err == nil {
 			if o != nil {
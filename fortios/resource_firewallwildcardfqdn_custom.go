@@ -26,7 +26,7 @@ func resourceFirewallWildcardFqdnCustom() *schema.Resource {
 		Delete: resourceFirewallWildcardFqdnCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceFirewallWildcardFqdnCustomCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating FirewallWildcardFqdnCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallwildcardfqdn_custom", obj)
+
 	o, err := c.CreateFirewallWildcardFqdnCustom(obj, vdomparam)
 
 	if err != nil {
@@ -122,6 +124,8 @@ func resourceFirewallWildcardFqdnCustomUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating FirewallWildcardFqdnCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallwildcardfqdn_custom", obj)
+
 	o, err := c.UpdateFirewallWildcardFqdnCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallWildcardFqdnCustom resource: %v", err)
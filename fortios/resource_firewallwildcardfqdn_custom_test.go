@@ -51,7 +51,7 @@ func testAccCheckFortiOSFirewallWildcardFqdnCustomExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallWildcardFqdnCustom(i)
+		o, err := c.ReadFirewallWildcardFqdnCustom(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallWildcardFqdnCustom: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckFirewallWildcardFqdnCustomDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallWildcardFqdnCustom(i)
+		o, err := c.ReadFirewallWildcardFqdnCustom(i, "")
 
 		if err == nil {
 			if o != nil {
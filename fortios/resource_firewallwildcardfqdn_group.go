@@ -26,7 +26,7 @@ func resourceFirewallWildcardFqdnGroup() *schema.Resource {
 		Delete: resourceFirewallWildcardFqdnGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,6 +102,8 @@ func resourceFirewallWildcardFqdnGroupCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating FirewallWildcardFqdnGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallwildcardfqdn_group", obj)
+
 	o, err := c.CreateFirewallWildcardFqdnGroup(obj, vdomparam)
 
 	if err != nil {
@@ -135,6 +137,8 @@ func resourceFirewallWildcardFqdnGroupUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating FirewallWildcardFqdnGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_firewallwildcardfqdn_group", obj)
+
 	o, err := c.UpdateFirewallWildcardFqdnGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FirewallWildcardFqdnGroup resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSFirewallWildcardFqdnGroupExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallWildcardFqdnGroup(i)
+		o, err := c.ReadFirewallWildcardFqdnGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FirewallWildcardFqdnGroup: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckFirewallWildcardFqdnGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFirewallWildcardFqdnGroup(i)
+		o, err := c.ReadFirewallWildcardFqdnGroup(i, "")
 
 		if err == nil {
 			if o != nil {
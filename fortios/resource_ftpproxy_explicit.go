@@ -26,7 +26,7 @@ func resourceFtpProxyExplicit() *schema.Resource {
 		Delete: resourceFtpProxyExplicitDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +103,8 @@ func resourceFtpProxyExplicitUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FtpProxyExplicit resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ftpproxy_explicit", obj)
+
 	o, err := c.UpdateFtpProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating FtpProxyExplicit resource: %v", err)
@@ -137,6 +139,8 @@ func resourceFtpProxyExplicitDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating FtpProxyExplicit resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ftpproxy_explicit", obj)
+
 	_, err = c.UpdateFtpProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing FtpProxyExplicit resource: %v", err)
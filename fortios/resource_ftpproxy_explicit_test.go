@@ -50,7 +50,7 @@ func testAccCheckFortiOSFtpProxyExplicitExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadFtpProxyExplicit(i)
+		o, err := c.ReadFtpProxyExplicit(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading FtpProxyExplicit: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckFtpProxyExplicitDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadFtpProxyExplicit(i)
+		o, err := c.ReadFtpProxyExplicit(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceIcapProfile() *schema.Resource {
 		Delete: resourceIcapProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -269,6 +269,8 @@ func resourceIcapProfileCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IcapProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_icap_profile", obj)
+
 	o, err := c.CreateIcapProfile(obj, vdomparam)
 
 	if err != nil {
@@ -302,6 +304,8 @@ func resourceIcapProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IcapProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_icap_profile", obj)
+
 	o, err := c.UpdateIcapProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IcapProfile resource: %v", err)
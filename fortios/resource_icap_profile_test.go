@@ -58,7 +58,7 @@ func testAccCheckFortiOSIcapProfileExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadIcapProfile(i)
+		o, err := c.ReadIcapProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading IcapProfile: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckIcapProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadIcapProfile(i)
+		o, err := c.ReadIcapProfile(i, "")
 
 		if err == nil {
 			if o != nil {
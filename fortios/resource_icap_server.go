@@ -26,7 +26,7 @@ func resourceIcapServer() *schema.Resource {
 		Delete: resourceIcapServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceIcapServerCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IcapServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_icap_server", obj)
+
 	o, err := c.CreateIcapServer(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceIcapServerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IcapServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_icap_server", obj)
+
 	o, err := c.UpdateIcapServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IcapServer resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSIcapServerExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadIcapServer(i)
+		o, err := c.ReadIcapServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading IcapServer: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckIcapServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadIcapServer(i)
+		o, err := c.ReadIcapServer(i, "")
 
 		if err == nil {
 			if o != nil {
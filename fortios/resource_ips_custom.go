@@ -26,7 +26,7 @@ func resourceIpsCustom() *schema.Resource {
 		Delete: resourceIpsCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceIpsCustomCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IpsCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_custom", obj)
+
 	o, err := c.CreateIpsCustom(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceIpsCustomUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_custom", obj)
+
 	o, err := c.UpdateIpsCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsCustom resource: %v", err)
@@ -26,7 +26,7 @@ func resourceIpsDecoder() *schema.Resource {
 		Delete: resourceIpsDecoderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,6 +88,8 @@ func resourceIpsDecoderCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IpsDecoder resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_decoder", obj)
+
 	o, err := c.CreateIpsDecoder(obj, vdomparam)
 
 	if err != nil {
@@ -121,6 +123,8 @@ func resourceIpsDecoderUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsDecoder resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_decoder", obj)
+
 	o, err := c.UpdateIpsDecoder(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsDecoder resource: %v", err)
@@ -26,7 +26,7 @@ func resourceIpsGlobal() *schema.Resource {
 		Delete: resourceIpsGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -186,6 +186,8 @@ func resourceIpsGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_global", obj)
+
 	o, err := c.UpdateIpsGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsGlobal resource: %v", err)
@@ -220,6 +222,8 @@ func resourceIpsGlobalDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_global", obj)
+
 	_, err = c.UpdateIpsGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing IpsGlobal resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSIpsGlobalExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadIpsGlobal(i)
+		o, err := c.ReadIpsGlobal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading IpsGlobal: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckIpsGlobalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadIpsGlobal(i)
+		o, err := c.ReadIpsGlobal(i, "")
 
 		if err == nil {
 			if o != nil {
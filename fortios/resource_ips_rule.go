@@ -26,7 +26,7 @@ func resourceIpsRule() *schema.Resource {
 		Delete: resourceIpsRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -157,6 +157,8 @@ func resourceIpsRuleCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IpsRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_rule", obj)
+
 	o, err := c.CreateIpsRule(obj, vdomparam)
 
 	if err != nil {
@@ -190,6 +192,8 @@ func resourceIpsRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_rule", obj)
+
 	o, err := c.UpdateIpsRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsRule resource: %v", err)
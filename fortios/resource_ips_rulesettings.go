@@ -26,7 +26,7 @@ func resourceIpsRuleSettings() *schema.Resource {
 		Delete: resourceIpsRuleSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/ips/rule-settings", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,6 +61,8 @@ func resourceIpsRuleSettingsCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating IpsRuleSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_rulesettings", obj)
+
 	o, err := c.CreateIpsRuleSettings(obj, vdomparam)
 
 	if err != nil {
@@ -94,6 +96,8 @@ func resourceIpsRuleSettingsUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating IpsRuleSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_rulesettings", obj)
+
 	o, err := c.UpdateIpsRuleSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsRuleSettings resource: %v", err)
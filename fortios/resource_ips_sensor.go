@@ -26,7 +26,7 @@ func resourceIpsSensor() *schema.Resource {
 		Delete: resourceIpsSensorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -388,6 +388,8 @@ func resourceIpsSensorCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IpsSensor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_sensor", obj)
+
 	o, err := c.CreateIpsSensor(obj, vdomparam)
 
 	if err != nil {
@@ -421,6 +423,8 @@ func resourceIpsSensorUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsSensor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_sensor", obj)
+
 	o, err := c.UpdateIpsSensor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsSensor resource: %v", err)
@@ -26,7 +26,7 @@ func resourceIpsSettings() *schema.Resource {
 		Delete: resourceIpsSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +80,8 @@ func resourceIpsSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_settings", obj)
+
 	o, err := c.UpdateIpsSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsSettings resource: %v", err)
@@ -114,6 +116,8 @@ func resourceIpsSettingsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_settings", obj)
+
 	_, err = c.UpdateIpsSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing IpsSettings resource: %v", err)
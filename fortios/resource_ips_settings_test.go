@@ -51,7 +51,7 @@ func testAccCheckFortiOSIpsSettingsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadIpsSettings(i)
+		o, err := c.ReadIpsSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading IpsSettings: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckIpsSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadIpsSettings(i)
+		o, err := c.ReadIpsSettings(i, "")
 
 		if err == nil {
 			if o != nil {
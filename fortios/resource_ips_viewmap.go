@@ -26,7 +26,7 @@ func resourceIpsViewMap() *schema.Resource {
 		Delete: resourceIpsViewMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/ips/view-map", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceIpsViewMapCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating IpsViewMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_viewmap", obj)
+
 	o, err := c.CreateIpsViewMap(obj, vdomparam)
 
 	if err != nil {
@@ -115,6 +117,8 @@ func resourceIpsViewMapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating IpsViewMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_ips_viewmap", obj)
+
 	o, err := c.UpdateIpsViewMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating IpsViewMap resource: %v", err)
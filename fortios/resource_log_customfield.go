@@ -26,7 +26,7 @@ func resourceLogCustomField() *schema.Resource {
 		Delete: resourceLogCustomFieldDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceLogCustomFieldCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating LogCustomField resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_customfield", obj)
+
 	o, err := c.CreateLogCustomField(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceLogCustomFieldUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogCustomField resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_customfield", obj)
+
 	o, err := c.UpdateLogCustomField(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogCustomField resource: %v", err)
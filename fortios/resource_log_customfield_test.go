@@ -50,7 +50,7 @@ func testAccCheckFortiOSLogCustomFieldExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogCustomField(i)
+		o, err := c.ReadLogCustomField(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogCustomField: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckLogCustomFieldDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogCustomField(i)
+		o, err := c.ReadLogCustomField(i, "")
 
 		if err == nil {
 			if o != nil {
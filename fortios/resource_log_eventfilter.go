@@ -26,7 +26,7 @@ func resourceLogEventfilter() *schema.Resource {
 		Delete: resourceLogEventfilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,6 +142,8 @@ func resourceLogEventfilterUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogEventfilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_eventfilter", obj)
+
 	o, err := c.UpdateLogEventfilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogEventfilter resource: %v", err)
@@ -176,6 +178,8 @@ func resourceLogEventfilterDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogEventfilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_eventfilter", obj)
+
 	_, err = c.UpdateLogEventfilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogEventfilter resource: %v", err)
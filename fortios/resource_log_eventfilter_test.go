@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogEventfilterExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogEventfilter(i)
+		o, err := c.ReadLogEventfilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogEventfilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogEventfilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogEventfilter(i)
+		o, err := c.ReadLogEventfilter(i, "")
 
 		if err == nil {
 			if o != nil {
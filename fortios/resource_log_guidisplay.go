@@ -26,7 +26,7 @@ func resourceLogGuiDisplay() *schema.Resource {
 		Delete: resourceLogGuiDisplayDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceLogGuiDisplayUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogGuiDisplay resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_guidisplay", obj)
+
 	o, err := c.UpdateLogGuiDisplay(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogGuiDisplay resource: %v", err)
@@ -106,6 +108,8 @@ func resourceLogGuiDisplayDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogGuiDisplay resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_guidisplay", obj)
+
 	_, err = c.UpdateLogGuiDisplay(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogGuiDisplay resource: %v", err)
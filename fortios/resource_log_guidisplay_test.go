@@ -50,7 +50,7 @@ func testAccCheckFortiOSLogGuiDisplayExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogGuiDisplay(i)
+		o, err := c.ReadLogGuiDisplay(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogGuiDisplay: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckLogGuiDisplayDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogGuiDisplay(i)
+		o, err := c.ReadLogGuiDisplay(i, "")
 
 		if err == nil {
 			if o != nil {
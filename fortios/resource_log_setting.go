@@ -26,7 +26,7 @@ func resourceLogSetting() *schema.Resource {
 		Delete: resourceLogSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -187,6 +187,8 @@ func resourceLogSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_setting", obj)
+
 	o, err := c.UpdateLogSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSetting resource: %v", err)
@@ -221,6 +223,8 @@ func resourceLogSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_setting", obj)
+
 	_, err = c.UpdateLogSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSetting resource: %v", err)
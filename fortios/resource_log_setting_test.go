@@ -66,7 +66,7 @@ func testAccCheckFortiOSLogSettingExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSetting(i)
+		o, err := c.ReadLogSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSetting: %s", err)
@@ -89,7 +89,7 @@ func testAccCheckLogSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSetting(i)
+		o, err := c.ReadLogSetting(i, "")
 
 		if err == nil {
 			if o != nil {
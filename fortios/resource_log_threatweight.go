@@ -26,7 +26,7 @@ func resourceLogThreatWeight() *schema.Resource {
 		Delete: resourceLogThreatWeightDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -323,6 +323,8 @@ func resourceLogThreatWeightUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogThreatWeight resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_threatweight", obj)
+
 	o, err := c.UpdateLogThreatWeight(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogThreatWeight resource: %v", err)
@@ -357,6 +359,8 @@ func resourceLogThreatWeightDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogThreatWeight resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_log_threatweight", obj)
+
 	_, err = c.UpdateLogThreatWeight(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogThreatWeight resource: %v", err)
@@ -119,7 +119,7 @@ func testAccCheckFortiOSLogThreatWeightExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogThreatWeight(i)
+		o, err := c.ReadLogThreatWeight(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogThreatWeight: %s", err)
@@ -142,7 +142,7 @@ func testAccCheckLogThreatWeightDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogThreatWeight(i)
+		o, err := c.ReadLogThreatWeight(i, "")
 
 		if err == nil {
 			if o != nil {
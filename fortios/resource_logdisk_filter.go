@@ -26,7 +26,7 @@ func resourceLogDiskFilter() *schema.Resource {
 		Delete: resourceLogDiskFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -262,6 +262,8 @@ func resourceLogDiskFilterUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logdisk_filter", obj)
+
 	o, err := c.UpdateLogDiskFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogDiskFilter resource: %v", err)
@@ -296,6 +298,8 @@ func resourceLogDiskFilterDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logdisk_filter", obj)
+
 	_, err = c.UpdateLogDiskFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogDiskFilter resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogDiskFilterExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogDiskFilter(i)
+		o, err := c.ReadLogDiskFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogDiskFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogDiskFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogDiskFilter(i)
+		o, err := c.ReadLogDiskFilter(i, "")
 
 		if err == nil {
 			if o != nil {
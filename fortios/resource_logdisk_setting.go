@@ -26,7 +26,7 @@ func resourceLogDiskSetting() *schema.Resource {
 		Delete: resourceLogDiskSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -216,6 +216,8 @@ func resourceLogDiskSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logdisk_setting", obj)
+
 	o, err := c.UpdateLogDiskSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogDiskSetting resource: %v", err)
@@ -250,6 +252,8 @@ func resourceLogDiskSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating LogDiskSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logdisk_setting", obj)
+
 	_, err = c.UpdateLogDiskSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogDiskSetting resource: %v", err)
@@ -72,7 +72,7 @@ func testAccCheckFortiOSLogDiskSettingExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogDiskSetting(i)
+		o, err := c.ReadLogDiskSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogDiskSetting: %s", err)
@@ -95,7 +95,7 @@ func testAccCheckLogDiskSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogDiskSetting(i)
+		o, err := c.ReadLogDiskSetting(i, "")
 
 		if err == nil {
 			if o != nil {
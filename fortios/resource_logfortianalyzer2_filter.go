@@ -26,7 +26,7 @@ func resourceLogFortianalyzer2Filter() *schema.Resource {
 		Delete: resourceLogFortianalyzer2FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer2FilterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzer2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2Filter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzer2FilterDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_filter", obj)
+
 	_, err = c.UpdateLogFortianalyzer2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer2Filter resource: %v", err)
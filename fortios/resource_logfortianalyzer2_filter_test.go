@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzer2FilterExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2Filter(i)
+		o, err := c.ReadLogFortianalyzer2Filter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer2Filter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzer2FilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2Filter(i)
+		o, err := c.ReadLogFortianalyzer2Filter(i, "")
 
 		if err == nil {
 			if o != nil {
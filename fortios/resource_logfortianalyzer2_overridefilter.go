@@ -26,7 +26,7 @@ func resourceLogFortianalyzer2OverrideFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzer2OverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer2OverrideFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzer2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzer2OverrideFilterDelete(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_overridefilter", obj)
+
 	_, err = c.UpdateLogFortianalyzer2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer2OverrideFilter resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzer2OverrideFilterExists(n string) resource
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2OverrideFilter(i)
+		o, err := c.ReadLogFortianalyzer2OverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer2OverrideFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzer2OverrideFilterDestroy(s *terraform.State) erro
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2OverrideFilter(i)
+		o, err := c.ReadLogFortianalyzer2OverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
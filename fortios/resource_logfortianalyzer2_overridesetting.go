@@ -26,7 +26,7 @@ func resourceLogFortianalyzer2OverrideSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzer2OverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceLogFortianalyzer2OverrideSettingUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzer2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideSetting resource: %v", err)
@@ -261,6 +263,8 @@ func resourceLogFortianalyzer2OverrideSettingDelete(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating LogFortianalyzer2OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_overridesetting", obj)
+
 	_, err = c.UpdateLogFortianalyzer2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer2OverrideSetting resource: %v", err)
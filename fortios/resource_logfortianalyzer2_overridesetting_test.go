@@ -63,7 +63,7 @@ func testAccCheckFortiOSLogFortianalyzer2OverrideSettingExists(n string) resourc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2OverrideSetting(i)
+		o, err := c.ReadLogFortianalyzer2OverrideSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer2OverrideSetting: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckLogFortianalyzer2OverrideSettingDestroy(s *terraform.State) err
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2OverrideSetting(i)
+		o, err := c.ReadLogFortianalyzer2OverrideSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortianalyzer2Setting() *schema.Resource {
 		Delete: resourceLogFortianalyzer2SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -217,6 +217,8 @@ func resourceLogFortianalyzer2SettingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzer2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer2Setting resource: %v", err)
@@ -251,6 +253,8 @@ func resourceLogFortianalyzer2SettingDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer2_setting", obj)
+
 	_, err = c.UpdateLogFortianalyzer2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer2Setting resource: %v", err)
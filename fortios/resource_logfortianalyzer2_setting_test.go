@@ -62,7 +62,7 @@ func testAccCheckFortiOSLogFortianalyzer2SettingExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2Setting(i)
+		o, err := c.ReadLogFortianalyzer2Setting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer2Setting: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckLogFortianalyzer2SettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer2Setting(i)
+		o, err := c.ReadLogFortianalyzer2Setting(i, "")
 
 		if err == nil {
 			if o != nil {
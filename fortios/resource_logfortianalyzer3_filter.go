@@ -26,7 +26,7 @@ func resourceLogFortianalyzer3Filter() *schema.Resource {
 		Delete: resourceLogFortianalyzer3FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer3FilterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzer3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3Filter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzer3FilterDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzer3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_filter", obj)
+
 	_, err = c.UpdateLogFortianalyzer3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer3Filter resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzer3FilterExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3Filter(i)
+		o, err := c.ReadLogFortianalyzer3Filter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer3Filter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzer3FilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3Filter(i)
+		o, err := c.ReadLogFortianalyzer3Filter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortianalyzer3OverrideFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzer3OverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzer3OverrideFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzer3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzer3OverrideFilterDelete(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_overridefilter", obj)
+
 	_, err = c.UpdateLogFortianalyzer3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer3OverrideFilter resource: %v", err)
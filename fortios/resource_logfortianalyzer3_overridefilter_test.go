@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzer3OverrideFilterExists(n string) resource
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3OverrideFilter(i)
+		o, err := c.ReadLogFortianalyzer3OverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer3OverrideFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzer3OverrideFilterDestroy(s *terraform.State) erro
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3OverrideFilter(i)
+		o, err := c.ReadLogFortianalyzer3OverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
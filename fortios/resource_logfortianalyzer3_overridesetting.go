@@ -26,7 +26,7 @@ func resourceLogFortianalyzer3OverrideSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzer3OverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceLogFortianalyzer3OverrideSettingUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzer3OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideSetting resource: %v", err)
@@ -261,6 +263,8 @@ func resourceLogFortianalyzer3OverrideSettingDelete(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating LogFortianalyzer3OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_overridesetting", obj)
+
 	_, err = c.UpdateLogFortianalyzer3OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer3OverrideSetting resource: %v", err)
@@ -63,7 +63,7 @@ func testAccCheckFortiOSLogFortianalyzer3OverrideSettingExists(n string) resourc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3OverrideSetting(i)
+		o, err := c.ReadLogFortianalyzer3OverrideSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer3OverrideSetting: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckLogFortianalyzer3OverrideSettingDestroy(s *terraform.State) err
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3OverrideSetting(i)
+		o, err := c.ReadLogFortianalyzer3OverrideSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortianalyzer3Setting() *schema.Resource {
 		Delete: resourceLogFortianalyzer3SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -217,6 +217,8 @@ func resourceLogFortianalyzer3SettingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzer3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzer3Setting resource: %v", err)
@@ -251,6 +253,8 @@ func resourceLogFortianalyzer3SettingDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogFortianalyzer3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer3_setting", obj)
+
 	_, err = c.UpdateLogFortianalyzer3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzer3Setting resource: %v", err)
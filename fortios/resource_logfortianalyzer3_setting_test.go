@@ -62,7 +62,7 @@ func testAccCheckFortiOSLogFortianalyzer3SettingExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3Setting(i)
+		o, err := c.ReadLogFortianalyzer3Setting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzer3Setting: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckLogFortianalyzer3SettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzer3Setting(i)
+		o, err := c.ReadLogFortianalyzer3Setting(i, "")
 
 		if err == nil {
 			if o != nil {
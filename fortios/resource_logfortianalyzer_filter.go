@@ -26,7 +26,7 @@ func resourceLogFortianalyzerFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzerFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzerFilterUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogFortianalyzerFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzerFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzerFilterDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogFortianalyzerFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_filter", obj)
+
 	_, err = c.UpdateLogFortianalyzerFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerFilter resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzerFilterExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerFilter(i)
+		o, err := c.ReadLogFortianalyzerFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzerFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzerFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerFilter(i)
+		o, err := c.ReadLogFortianalyzerFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortianalyzerOverrideFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzerOverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortianalyzerOverrideFilterUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzerOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortianalyzerOverrideFilterDelete(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_overridefilter", obj)
+
 	_, err = c.UpdateLogFortianalyzerOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerOverrideFilter resource: %v", err)
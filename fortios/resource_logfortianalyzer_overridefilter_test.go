@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortianalyzerOverrideFilterExists(n string) resource.
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerOverrideFilter(i)
+		o, err := c.ReadLogFortianalyzerOverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzerOverrideFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortianalyzerOverrideFilterDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerOverrideFilter(i)
+		o, err := c.ReadLogFortianalyzerOverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
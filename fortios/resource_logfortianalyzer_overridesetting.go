@@ -26,7 +26,7 @@ func resourceLogFortianalyzerOverrideSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzerOverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -227,6 +227,8 @@ func resourceLogFortianalyzerOverrideSettingUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzerOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideSetting resource: %v", err)
@@ -261,6 +263,8 @@ func resourceLogFortianalyzerOverrideSettingDelete(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating LogFortianalyzerOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_overridesetting", obj)
+
 	_, err = c.UpdateLogFortianalyzerOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerOverrideSetting resource: %v", err)
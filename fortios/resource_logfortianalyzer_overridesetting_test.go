@@ -63,7 +63,7 @@ func testAccCheckFortiOSLogFortianalyzerOverrideSettingExists(n string) resource
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerOverrideSetting(i)
+		o, err := c.ReadLogFortianalyzerOverrideSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzerOverrideSetting: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckLogFortianalyzerOverrideSettingDestroy(s *terraform.State) erro
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerOverrideSetting(i)
+		o, err := c.ReadLogFortianalyzerOverrideSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortianalyzerSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzerSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -217,6 +217,8 @@ func resourceLogFortianalyzerSettingUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzerSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerSetting resource: %v", err)
@@ -251,6 +253,8 @@ func resourceLogFortianalyzerSettingDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating LogFortianalyzerSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzer_setting", obj)
+
 	_, err = c.UpdateLogFortianalyzerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerSetting resource: %v", err)
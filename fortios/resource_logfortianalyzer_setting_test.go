@@ -62,7 +62,7 @@ func testAccCheckFortiOSLogFortianalyzerSettingExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerSetting(i)
+		o, err := c.ReadLogFortianalyzerSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortianalyzerSetting: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckLogFortianalyzerSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortianalyzerSetting(i)
+		o, err := c.ReadLogFortianalyzerSetting(i, "")
 
 		if err == nil {
 			if o != nil {
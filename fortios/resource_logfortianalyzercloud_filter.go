@@ -26,7 +26,7 @@ func resourceLogFortianalyzerCloudFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzerCloudFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -152,6 +152,8 @@ func resourceLogFortianalyzerCloudFilterUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortianalyzerCloudFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_filter", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudFilter resource: %v", err)
@@ -186,6 +188,8 @@ func resourceLogFortianalyzerCloudFilterDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortianalyzerCloudFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_filter", obj)
+
 	_, err = c.UpdateLogFortianalyzerCloudFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerCloudFilter resource: %v", err)
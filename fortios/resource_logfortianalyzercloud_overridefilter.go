@@ -26,7 +26,7 @@ func resourceLogFortianalyzerCloudOverrideFilter() *schema.Resource {
 		Delete: resourceLogFortianalyzerCloudOverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -152,6 +152,8 @@ func resourceLogFortianalyzerCloudOverrideFilterUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_overridefilter", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideFilter resource: %v", err)
@@ -186,6 +188,8 @@ func resourceLogFortianalyzerCloudOverrideFilterDelete(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_overridefilter", obj)
+
 	_, err = c.UpdateLogFortianalyzerCloudOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerCloudOverrideFilter resource: %v", err)
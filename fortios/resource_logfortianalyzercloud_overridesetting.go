@@ -26,7 +26,7 @@ func resourceLogFortianalyzerCloudOverrideSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzerCloudOverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceLogFortianalyzerCloudOverrideSettingUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_overridesetting", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideSetting resource: %v", err)
@@ -96,6 +98,8 @@ func resourceLogFortianalyzerCloudOverrideSettingDelete(d *schema.ResourceData,
 		return fmt.Errorf("Error updating LogFortianalyzerCloudOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_overridesetting", obj)
+
 	_, err = c.UpdateLogFortianalyzerCloudOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerCloudOverrideSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogFortianalyzerCloudSetting() *schema.Resource {
 		Delete: resourceLogFortianalyzerCloudSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +189,8 @@ func resourceLogFortianalyzerCloudSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortianalyzerCloudSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_setting", obj)
+
 	o, err := c.UpdateLogFortianalyzerCloudSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortianalyzerCloudSetting resource: %v", err)
@@ -223,6 +225,8 @@ func resourceLogFortianalyzerCloudSettingDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortianalyzerCloudSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortianalyzercloud_setting", obj)
+
 	_, err = c.UpdateLogFortianalyzerCloudSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortianalyzerCloudSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogFortiguardFilter() *schema.Resource {
 		Delete: resourceLogFortiguardFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortiguardFilterUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogFortiguardFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_filter", obj)
+
 	o, err := c.UpdateLogFortiguardFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortiguardFilterDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogFortiguardFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_filter", obj)
+
 	_, err = c.UpdateLogFortiguardFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortiguardFilter resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortiguardFilterExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardFilter(i)
+		o, err := c.ReadLogFortiguardFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortiguardFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortiguardFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardFilter(i)
+		o, err := c.ReadLogFortiguardFilter(i, "")
 
 		if err == nil {
 			if o != nil {
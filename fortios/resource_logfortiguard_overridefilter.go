@@ -26,7 +26,7 @@ func resourceLogFortiguardOverrideFilter() *schema.Resource {
 		Delete: resourceLogFortiguardOverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceLogFortiguardOverrideFilterUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortiguardOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_overridefilter", obj)
+
 	o, err := c.UpdateLogFortiguardOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardOverrideFilter resource: %v", err)
@@ -206,6 +208,8 @@ func resourceLogFortiguardOverrideFilterDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogFortiguardOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_overridefilter", obj)
+
 	_, err = c.UpdateLogFortiguardOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortiguardOverrideFilter resource: %v", err)
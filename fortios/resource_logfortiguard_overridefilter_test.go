@@ -59,7 +59,7 @@ func testAccCheckFortiOSLogFortiguardOverrideFilterExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardOverrideFilter(i)
+		o, err := c.ReadLogFortiguardOverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortiguardOverrideFilter: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckLogFortiguardOverrideFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardOverrideFilter(i)
+		o, err := c.ReadLogFortiguardOverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortiguardOverrideSetting() *schema.Resource {
 		Delete: resourceLogFortiguardOverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +103,8 @@ func resourceLogFortiguardOverrideSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortiguardOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_overridesetting", obj)
+
 	o, err := c.UpdateLogFortiguardOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardOverrideSetting resource: %v", err)
@@ -137,6 +139,8 @@ func resourceLogFortiguardOverrideSettingDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating LogFortiguardOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_overridesetting", obj)
+
 	_, err = c.UpdateLogFortiguardOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortiguardOverrideSetting resource: %v", err)
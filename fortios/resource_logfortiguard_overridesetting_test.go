@@ -52,7 +52,7 @@ func testAccCheckFortiOSLogFortiguardOverrideSettingExists(n string) resource.Te
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardOverrideSetting(i)
+		o, err := c.ReadLogFortiguardOverrideSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortiguardOverrideSetting: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckLogFortiguardOverrideSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardOverrideSetting(i)
+		o, err := c.ReadLogFortiguardOverrideSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogFortiguardSetting() *schema.Resource {
 		Delete: resourceLogFortiguardSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,6 +130,8 @@ func resourceLogFortiguardSettingUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogFortiguardSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_setting", obj)
+
 	o, err := c.UpdateLogFortiguardSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogFortiguardSetting resource: %v", err)
@@ -164,6 +166,8 @@ func resourceLogFortiguardSettingDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogFortiguardSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logfortiguard_setting", obj)
+
 	_, err = c.UpdateLogFortiguardSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogFortiguardSetting resource: %v", err)
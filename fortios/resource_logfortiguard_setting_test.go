@@ -54,7 +54,7 @@ func testAccCheckFortiOSLogFortiguardSettingExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardSetting(i)
+		o, err := c.ReadLogFortiguardSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogFortiguardSetting: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckLogFortiguardSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogFortiguardSetting(i)
+		o, err := c.ReadLogFortiguardSetting(i, "")
 
 		if err == nil {
 			if o != nil {
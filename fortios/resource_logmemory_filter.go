@@ -26,7 +26,7 @@ func resourceLogMemoryFilter() *schema.Resource {
 		Delete: resourceLogMemoryFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -257,6 +257,8 @@ func resourceLogMemoryFilterUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemoryFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_filter", obj)
+
 	o, err := c.UpdateLogMemoryFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemoryFilter resource: %v", err)
@@ -291,6 +293,8 @@ func resourceLogMemoryFilterDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemoryFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_filter", obj)
+
 	_, err = c.UpdateLogMemoryFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogMemoryFilter resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogMemoryFilterExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemoryFilter(i)
+		o, err := c.ReadLogMemoryFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogMemoryFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogMemoryFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemoryFilter(i)
+		o, err := c.ReadLogMemoryFilter(i, "")
 
 		if err == nil {
 			if o != nil {
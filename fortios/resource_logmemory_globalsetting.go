@@ -26,7 +26,7 @@ func resourceLogMemoryGlobalSetting() *schema.Resource {
 		Delete: resourceLogMemoryGlobalSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +80,8 @@ func resourceLogMemoryGlobalSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogMemoryGlobalSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_globalsetting", obj)
+
 	o, err := c.UpdateLogMemoryGlobalSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemoryGlobalSetting resource: %v", err)
@@ -114,6 +116,8 @@ func resourceLogMemoryGlobalSettingDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating LogMemoryGlobalSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_globalsetting", obj)
+
 	_, err = c.UpdateLogMemoryGlobalSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogMemoryGlobalSetting resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSLogMemoryGlobalSettingExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemoryGlobalSetting(i)
+		o, err := c.ReadLogMemoryGlobalSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogMemoryGlobalSetting: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckLogMemoryGlobalSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemoryGlobalSetting(i)
+		o, err := c.ReadLogMemoryGlobalSetting(i, "")
 
 		if err == nil {
 			if o != nil {
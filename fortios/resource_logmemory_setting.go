@@ -26,7 +26,7 @@ func resourceLogMemorySetting() *schema.Resource {
 		Delete: resourceLogMemorySettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceLogMemorySettingUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemorySetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_setting", obj)
+
 	o, err := c.UpdateLogMemorySetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogMemorySetting resource: %v", err)
@@ -101,6 +103,8 @@ func resourceLogMemorySettingDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogMemorySetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logmemory_setting", obj)
+
 	_, err = c.UpdateLogMemorySetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogMemorySetting resource: %v", err)
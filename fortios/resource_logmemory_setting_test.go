@@ -49,7 +49,7 @@ func testAccCheckFortiOSLogMemorySettingExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemorySetting(i)
+		o, err := c.ReadLogMemorySetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogMemorySetting: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckLogMemorySettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogMemorySetting(i)
+		o, err := c.ReadLogMemorySetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogNullDeviceFilter() *schema.Resource {
 		Delete: resourceLogNullDeviceFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogNullDeviceFilterUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogNullDeviceFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_lognulldevice_filter", obj)
+
 	o, err := c.UpdateLogNullDeviceFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogNullDeviceFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogNullDeviceFilterDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogNullDeviceFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_lognulldevice_filter", obj)
+
 	_, err = c.UpdateLogNullDeviceFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogNullDeviceFilter resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogNullDeviceFilterExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogNullDeviceFilter(i)
+		o, err := c.ReadLogNullDeviceFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogNullDeviceFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogNullDeviceFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogNullDeviceFilter(i)
+		o, err := c.ReadLogNullDeviceFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogNullDeviceSetting() *schema.Resource {
 		Delete: resourceLogNullDeviceSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,6 +61,8 @@ func resourceLogNullDeviceSettingUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogNullDeviceSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_lognulldevice_setting", obj)
+
 	o, err := c.UpdateLogNullDeviceSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogNullDeviceSetting resource: %v", err)
@@ -95,6 +97,8 @@ func resourceLogNullDeviceSettingDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating LogNullDeviceSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_lognulldevice_setting", obj)
+
 	_, err = c.UpdateLogNullDeviceSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogNullDeviceSetting resource: %v", err)
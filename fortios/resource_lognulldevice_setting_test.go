@@ -48,7 +48,7 @@ func testAccCheckFortiOSLogNullDeviceSettingExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogNullDeviceSetting(i)
+		o, err := c.ReadLogNullDeviceSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogNullDeviceSetting: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckLogNullDeviceSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogNullDeviceSetting(i)
+		o, err := c.ReadLogNullDeviceSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd2Filter() *schema.Resource {
 		Delete: resourceLogSyslogd2FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd2FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_filter", obj)
+
 	o, err := c.UpdateLogSyslogd2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2Filter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd2FilterDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_filter", obj)
+
 	_, err = c.UpdateLogSyslogd2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd2Filter resource: %v", err)
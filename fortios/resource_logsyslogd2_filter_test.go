@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogSyslogd2FilterExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2Filter(i)
+		o, err := c.ReadLogSyslogd2Filter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogd2Filter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogSyslogd2FilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2Filter(i)
+		o, err := c.ReadLogSyslogd2Filter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd2OverrideFilter() *schema.Resource {
 		Delete: resourceLogSyslogd2OverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd2OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd2OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2OverrideFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd2OverrideFilterDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd2OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_overridefilter", obj)
+
 	_, err = c.UpdateLogSyslogd2OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd2OverrideFilter resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogSyslogd2OverrideFilterExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2OverrideFilter(i)
+		o, err := c.ReadLogSyslogd2OverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogd2OverrideFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogSyslogd2OverrideFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2OverrideFilter(i)
+		o, err := c.ReadLogSyslogd2OverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd2OverrideSetting() *schema.Resource {
 		Delete: resourceLogSyslogd2OverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceLogSyslogd2OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd2OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2OverrideSetting resource: %v", err)
@@ -208,6 +210,8 @@ func resourceLogSyslogd2OverrideSettingDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd2OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_overridesetting", obj)
+
 	_, err = c.UpdateLogSyslogd2OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd2OverrideSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogSyslogd2Setting() *schema.Resource {
 		Delete: resourceLogSyslogd2SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,6 +169,8 @@ func resourceLogSyslogd2SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_setting", obj)
+
 	o, err := c.UpdateLogSyslogd2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd2Setting resource: %v", err)
@@ -203,6 +205,8 @@ func resourceLogSyslogd2SettingDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd2_setting", obj)
+
 	_, err = c.UpdateLogSyslogd2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd2Setting resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSLogSyslogd2SettingExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2Setting(i)
+		o, err := c.ReadLogSyslogd2Setting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogd2Setting: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckLogSyslogd2SettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd2Setting(i)
+		o, err := c.ReadLogSyslogd2Setting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd3Filter() *schema.Resource {
 		Delete: resourceLogSyslogd3FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd3FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_filter", obj)
+
 	o, err := c.UpdateLogSyslogd3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3Filter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd3FilterDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_filter", obj)
+
 	_, err = c.UpdateLogSyslogd3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd3Filter resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogSyslogd3OverrideFilter() *schema.Resource {
 		Delete: resourceLogSyslogd3OverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd3OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd3OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3OverrideFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd3OverrideFilterDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd3OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_overridefilter", obj)
+
 	_, err = c.UpdateLogSyslogd3OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd3OverrideFilter resource: %v", err)
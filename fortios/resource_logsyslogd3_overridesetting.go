@@ -26,7 +26,7 @@ func resourceLogSyslogd3OverrideSetting() *schema.Resource {
 		Delete: resourceLogSyslogd3OverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceLogSyslogd3OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd3OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd3OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3OverrideSetting resource: %v", err)
@@ -208,6 +210,8 @@ func resourceLogSyslogd3OverrideSettingDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd3OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_overridesetting", obj)
+
 	_, err = c.UpdateLogSyslogd3OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd3OverrideSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogSyslogd3Setting() *schema.Resource {
 		Delete: resourceLogSyslogd3SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,6 +169,8 @@ func resourceLogSyslogd3SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_setting", obj)
+
 	o, err := c.UpdateLogSyslogd3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd3Setting resource: %v", err)
@@ -203,6 +205,8 @@ func resourceLogSyslogd3SettingDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd3_setting", obj)
+
 	_, err = c.UpdateLogSyslogd3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd3Setting resource: %v", err)
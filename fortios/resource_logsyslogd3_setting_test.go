@@ -55,7 +55,7 @@ func testAccCheckFortiOSLogSyslogd3SettingExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd3Setting(i)
+		o, err := c.ReadLogSyslogd3Setting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogd3Setting: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckLogSyslogd3SettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd3Setting(i)
+		o, err := c.ReadLogSyslogd3Setting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd4Filter() *schema.Resource {
 		Delete: resourceLogSyslogd4FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd4FilterUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd4Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_filter", obj)
+
 	o, err := c.UpdateLogSyslogd4Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4Filter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd4FilterDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogd4Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_filter", obj)
+
 	_, err = c.UpdateLogSyslogd4Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd4Filter resource: %v", err)
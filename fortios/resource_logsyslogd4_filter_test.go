@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogSyslogd4FilterExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd4Filter(i)
+		o, err := c.ReadLogSyslogd4Filter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogd4Filter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogSyslogd4FilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogd4Filter(i)
+		o, err := c.ReadLogSyslogd4Filter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogd4OverrideFilter() *schema.Resource {
 		Delete: resourceLogSyslogd4OverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogd4OverrideFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd4OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogd4OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4OverrideFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogd4OverrideFilterDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogd4OverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_overridefilter", obj)
+
 	_, err = c.UpdateLogSyslogd4OverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd4OverrideFilter resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogSyslogd4OverrideSetting() *schema.Resource {
 		Delete: resourceLogSyslogd4OverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceLogSyslogd4OverrideSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd4OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogd4OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4OverrideSetting resource: %v", err)
@@ -208,6 +210,8 @@ func resourceLogSyslogd4OverrideSettingDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogSyslogd4OverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_overridesetting", obj)
+
 	_, err = c.UpdateLogSyslogd4OverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd4OverrideSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogSyslogd4Setting() *schema.Resource {
 		Delete: resourceLogSyslogd4SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,6 +169,8 @@ func resourceLogSyslogd4SettingUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd4Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_setting", obj)
+
 	o, err := c.UpdateLogSyslogd4Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogd4Setting resource: %v", err)
@@ -203,6 +205,8 @@ func resourceLogSyslogd4SettingDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogSyslogd4Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd4_setting", obj)
+
 	_, err = c.UpdateLogSyslogd4Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogd4Setting resource: %v", err)
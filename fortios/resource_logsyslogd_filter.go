@@ -26,7 +26,7 @@ func resourceLogSyslogdFilter() *schema.Resource {
 		Delete: resourceLogSyslogdFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogdFilterUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogSyslogdFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_filter", obj)
+
 	o, err := c.UpdateLogSyslogdFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogdFilterDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating LogSyslogdFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_filter", obj)
+
 	_, err = c.UpdateLogSyslogdFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogdFilter resource: %v", err)
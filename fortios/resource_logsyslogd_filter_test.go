@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogSyslogdFilterExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdFilter(i)
+		o, err := c.ReadLogSyslogdFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogdFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogSyslogdFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdFilter(i)
+		o, err := c.ReadLogSyslogdFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogSyslogdOverrideFilter() *schema.Resource {
 		Delete: resourceLogSyslogdOverrideFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogSyslogdOverrideFilterUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogSyslogdOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_overridefilter", obj)
+
 	o, err := c.UpdateLogSyslogdOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdOverrideFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogSyslogdOverrideFilterDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating LogSyslogdOverrideFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_overridefilter", obj)
+
 	_, err = c.UpdateLogSyslogdOverrideFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogdOverrideFilter resource: %v", err)
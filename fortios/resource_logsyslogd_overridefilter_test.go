@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogSyslogdOverrideFilterExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdOverrideFilter(i)
+		o, err := c.ReadLogSyslogdOverrideFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogdOverrideFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogSyslogdOverrideFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdOverrideFilter(i)
+		o, err := c.ReadLogSyslogdOverrideFilter(i, "")
 
 		if err == nil {
 			if o != nil {
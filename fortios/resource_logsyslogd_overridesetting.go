@@ -26,7 +26,7 @@ func resourceLogSyslogdOverrideSetting() *schema.Resource {
 		Delete: resourceLogSyslogdOverrideSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,6 +174,8 @@ func resourceLogSyslogdOverrideSettingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogdOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_overridesetting", obj)
+
 	o, err := c.UpdateLogSyslogdOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdOverrideSetting resource: %v", err)
@@ -208,6 +210,8 @@ func resourceLogSyslogdOverrideSettingDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogSyslogdOverrideSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_overridesetting", obj)
+
 	_, err = c.UpdateLogSyslogdOverrideSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogdOverrideSetting resource: %v", err)
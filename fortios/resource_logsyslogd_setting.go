@@ -26,7 +26,7 @@ func resourceLogSyslogdSetting() *schema.Resource {
 		Delete: resourceLogSyslogdSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,6 +169,8 @@ func resourceLogSyslogdSettingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogdSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_setting", obj)
+
 	o, err := c.UpdateLogSyslogdSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogSyslogdSetting resource: %v", err)
@@ -203,6 +205,8 @@ func resourceLogSyslogdSettingDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating LogSyslogdSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logsyslogd_setting", obj)
+
 	_, err = c.UpdateLogSyslogdSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogSyslogdSetting resource: %v", err)
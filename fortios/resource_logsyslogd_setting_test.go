@@ -55,7 +55,7 @@ func testAccCheckFortiOSLogSyslogdSettingExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdSetting(i)
+		o, err := c.ReadLogSyslogdSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogSyslogdSetting: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckLogSyslogdSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogSyslogdSetting(i)
+		o, err := c.ReadLogSyslogdSetting(i, "")
 
 		if err == nil {
 			if o != nil {
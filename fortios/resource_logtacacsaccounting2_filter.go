@@ -26,7 +26,7 @@ func resourceLogTacacsAccounting2Filter() *schema.Resource {
 		Delete: resourceLogTacacsAccounting2FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceLogTacacsAccounting2FilterUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting2_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccounting2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting2Filter resource: %v", err)
@@ -106,6 +108,8 @@ func resourceLogTacacsAccounting2FilterDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting2Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting2_filter", obj)
+
 	_, err = c.UpdateLogTacacsAccounting2Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccounting2Filter resource: %v", err)
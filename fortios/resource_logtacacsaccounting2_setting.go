@@ -26,7 +26,7 @@ func resourceLogTacacsAccounting2Setting() *schema.Resource {
 		Delete: resourceLogTacacsAccounting2SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceLogTacacsAccounting2SettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting2_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccounting2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting2Setting resource: %v", err)
@@ -107,6 +109,8 @@ func resourceLogTacacsAccounting2SettingDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting2Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting2_setting", obj)
+
 	_, err = c.UpdateLogTacacsAccounting2Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccounting2Setting resource: %v", err)
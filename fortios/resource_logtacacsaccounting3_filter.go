@@ -26,7 +26,7 @@ func resourceLogTacacsAccounting3Filter() *schema.Resource {
 		Delete: resourceLogTacacsAccounting3FilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceLogTacacsAccounting3FilterUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting3_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccounting3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting3Filter resource: %v", err)
@@ -106,6 +108,8 @@ func resourceLogTacacsAccounting3FilterDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccounting3Filter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting3_filter", obj)
+
 	_, err = c.UpdateLogTacacsAccounting3Filter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccounting3Filter resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogTacacsAccounting3Setting() *schema.Resource {
 		Delete: resourceLogTacacsAccounting3SettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceLogTacacsAccounting3SettingUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting3_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccounting3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccounting3Setting resource: %v", err)
@@ -107,6 +109,8 @@ func resourceLogTacacsAccounting3SettingDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating LogTacacsAccounting3Setting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting3_setting", obj)
+
 	_, err = c.UpdateLogTacacsAccounting3Setting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccounting3Setting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogTacacsAccountingFilter() *schema.Resource {
 		Delete: resourceLogTacacsAccountingFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceLogTacacsAccountingFilterUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogTacacsAccountingFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting_filter", obj)
+
 	o, err := c.UpdateLogTacacsAccountingFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccountingFilter resource: %v", err)
@@ -106,6 +108,8 @@ func resourceLogTacacsAccountingFilterDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating LogTacacsAccountingFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting_filter", obj)
+
 	_, err = c.UpdateLogTacacsAccountingFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccountingFilter resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogTacacsAccountingSetting() *schema.Resource {
 		Delete: resourceLogTacacsAccountingSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceLogTacacsAccountingSettingUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccountingSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting_setting", obj)
+
 	o, err := c.UpdateLogTacacsAccountingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogTacacsAccountingSetting resource: %v", err)
@@ -107,6 +109,8 @@ func resourceLogTacacsAccountingSettingDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating LogTacacsAccountingSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logtacacsaccounting_setting", obj)
+
 	_, err = c.UpdateLogTacacsAccountingSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogTacacsAccountingSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceLogWebtrendsFilter() *schema.Resource {
 		Delete: resourceLogWebtrendsFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceLogWebtrendsFilterUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogWebtrendsFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logwebtrends_filter", obj)
+
 	o, err := c.UpdateLogWebtrendsFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogWebtrendsFilter resource: %v", err)
@@ -201,6 +203,8 @@ func resourceLogWebtrendsFilterDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating LogWebtrendsFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logwebtrends_filter", obj)
+
 	_, err = c.UpdateLogWebtrendsFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogWebtrendsFilter resource: %v", err)
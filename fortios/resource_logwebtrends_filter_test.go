@@ -58,7 +58,7 @@ func testAccCheckFortiOSLogWebtrendsFilterExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogWebtrendsFilter(i)
+		o, err := c.ReadLogWebtrendsFilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogWebtrendsFilter: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckLogWebtrendsFilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogWebtrendsFilter(i)
+		o, err := c.ReadLogWebtrendsFilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceLogWebtrendsSetting() *schema.Resource {
 		Delete: resourceLogWebtrendsSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceLogWebtrendsSettingUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogWebtrendsSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logwebtrends_setting", obj)
+
 	o, err := c.UpdateLogWebtrendsSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating LogWebtrendsSetting resource: %v", err)
@@ -102,6 +104,8 @@ func resourceLogWebtrendsSettingDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating LogWebtrendsSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_logwebtrends_setting", obj)
+
 	_, err = c.UpdateLogWebtrendsSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing LogWebtrendsSetting resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSLogWebtrendsSettingExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogWebtrendsSetting(i)
+		o, err := c.ReadLogWebtrendsSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading LogWebtrendsSetting: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckLogWebtrendsSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadLogWebtrendsSetting(i)
+		o, err := c.ReadLogWebtrendsSetting(i, "")
 
 		if err == nil {
 			if o != nil {
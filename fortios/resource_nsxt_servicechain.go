@@ -26,7 +26,7 @@ func resourceNsxtServiceChain() *schema.Resource {
 		Delete: resourceNsxtServiceChainDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/nsxt/service-chain", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceNsxtServiceChainCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating NsxtServiceChain resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_nsxt_servicechain", obj)
+
 	o, err := c.CreateNsxtServiceChain(obj, vdomparam)
 
 	if err != nil {
@@ -139,6 +141,8 @@ func resourceNsxtServiceChainUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating NsxtServiceChain resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_nsxt_servicechain", obj)
+
 	o, err := c.UpdateNsxtServiceChain(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating NsxtServiceChain resource: %v", err)
@@ -26,7 +26,7 @@ func resourceNsxtSetting() *schema.Resource {
 		Delete: resourceNsxtSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceNsxtSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating NsxtSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_nsxt_setting", obj)
+
 	o, err := c.UpdateNsxtSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating NsxtSetting resource: %v", err)
@@ -102,6 +104,8 @@ func resourceNsxtSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating NsxtSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_nsxt_setting", obj)
+
 	_, err = c.UpdateNsxtSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing NsxtSetting resource: %v", err)
@@ -26,7 +26,7 @@ func resourceReportChart() *schema.Resource {
 		Delete: resourceReportChartDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -437,6 +437,8 @@ func resourceReportChartCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating ReportChart resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_chart", obj)
+
 	o, err := c.CreateReportChart(obj, vdomparam)
 
 	if err != nil {
@@ -470,6 +472,8 @@ func resourceReportChartUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportChart resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_chart", obj)
+
 	o, err := c.UpdateReportChart(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportChart resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSReportChartExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportChart(i)
+		o, err := c.ReadReportChart(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportChart: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckReportChartDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportChart(i)
+		o, err := c.ReadReportChart(i, "")
 
 		if err == nil {
 			if o != nil {
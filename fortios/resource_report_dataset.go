@@ -26,7 +26,7 @@ func resourceReportDataset() *schema.Resource {
 		Delete: resourceReportDatasetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -138,6 +138,8 @@ func resourceReportDatasetCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating ReportDataset resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_dataset", obj)
+
 	o, err := c.CreateReportDataset(obj, vdomparam)
 
 	if err != nil {
@@ -171,6 +173,8 @@ func resourceReportDatasetUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportDataset resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_dataset", obj)
+
 	o, err := c.UpdateReportDataset(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportDataset resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSReportDatasetExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportDataset(i)
+		o, err := c.ReadReportDataset(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportDataset: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckReportDatasetDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportDataset(i)
+		o, err := c.ReadReportDataset(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceReportLayout() *schema.Resource {
 		Delete: resourceReportLayoutDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -461,6 +461,8 @@ func resourceReportLayoutCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating ReportLayout resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_layout", obj)
+
 	o, err := c.CreateReportLayout(obj, vdomparam)
 
 	if err != nil {
@@ -494,6 +496,8 @@ func resourceReportLayoutUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportLayout resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_layout", obj)
+
 	o, err := c.UpdateReportLayout(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportLayout resource: %v", err)
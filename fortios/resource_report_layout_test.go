@@ -59,7 +59,7 @@ func testAccCheckFortiOSReportLayoutExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportLayout(i)
+		o, err := c.ReadReportLayout(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportLayout: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckReportLayoutDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportLayout(i)
+		o, err := c.ReadReportLayout(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceReportSetting() *schema.Resource {
 		Delete: resourceReportSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,6 +84,8 @@ func resourceReportSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_setting", obj)
+
 	o, err := c.UpdateReportSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportSetting resource: %v", err)
@@ -118,6 +120,8 @@ func resourceReportSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_setting", obj)
+
 	_, err = c.UpdateReportSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing ReportSetting resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSReportSettingExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportSetting(i)
+		o, err := c.ReadReportSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportSetting: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckReportSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportSetting(i)
+		o, err := c.ReadReportSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceReportStyle() *schema.Resource {
 		Delete: resourceReportStyleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -203,6 +203,8 @@ func resourceReportStyleCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating ReportStyle resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_style", obj)
+
 	o, err := c.CreateReportStyle(obj, vdomparam)
 
 	if err != nil {
@@ -236,6 +238,8 @@ func resourceReportStyleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportStyle resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_style", obj)
+
 	o, err := c.UpdateReportStyle(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportStyle resource: %v", err)
@@ -56,7 +56,7 @@ func testAccCheckFortiOSReportStyleExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportStyle(i)
+		o, err := c.ReadReportStyle(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportStyle: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckReportStyleDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportStyle(i)
+		o, err := c.ReadReportStyle(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceReportTheme() *schema.Resource {
 		Delete: resourceReportThemeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -235,6 +235,8 @@ func resourceReportThemeCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating ReportTheme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_theme", obj)
+
 	o, err := c.CreateReportTheme(obj, vdomparam)
 
 	if err != nil {
@@ -268,6 +270,8 @@ func resourceReportThemeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating ReportTheme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_report_theme", obj)
+
 	o, err := c.UpdateReportTheme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating ReportTheme resource: %v", err)
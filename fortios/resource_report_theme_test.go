@@ -51,7 +51,7 @@ func testAccCheckFortiOSReportThemeExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportTheme(i)
+		o, err := c.ReadReportTheme(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading ReportTheme: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckReportThemeDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadReportTheme(i)
+		o, err := c.ReadReportTheme(i, "")
 
 		if err == nil {
 			if o != nil {
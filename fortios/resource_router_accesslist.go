@@ -26,7 +26,7 @@ func resourceRouterAccessList() *schema.Resource {
 		Delete: resourceRouterAccessListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceRouterAccessListCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating RouterAccessList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_accesslist", obj)
+
 	o, err := c.CreateRouterAccessList(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceRouterAccessListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterAccessList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_accesslist", obj)
+
 	o, err := c.UpdateRouterAccessList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAccessList resource: %v", err)
@@ -26,7 +26,7 @@ func resourceRouterAccessList6() *schema.Resource {
 		Delete: resourceRouterAccessList6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceRouterAccessList6Create(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating RouterAccessList6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_accesslist6", obj)
+
 	o, err := c.CreateRouterAccessList6(obj, vdomparam)
 
 	if err != nil {
@@ -139,6 +141,8 @@ func resourceRouterAccessList6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterAccessList6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_accesslist6", obj)
+
 	o, err := c.UpdateRouterAccessList6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAccessList6 resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSRouterAccessList6Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAccessList6(i)
+		o, err := c.ReadRouterAccessList6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterAccessList6: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckRouterAccessList6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAccessList6(i)
+		o, err := c.ReadRouterAccessList6(i, "")
 
 		if err == nil {
 			if o != nil {
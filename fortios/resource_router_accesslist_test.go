@@ -49,7 +49,7 @@ func testAccCheckFortiOSRouterAccessListExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAccessList(i)
+		o, err := c.ReadRouterAccessList(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterAccessList: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckRouterAccessListDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAccessList(i)
+		o, err := c.ReadRouterAccessList(i, "")
 
 		if err == nil {
 			if o != nil {
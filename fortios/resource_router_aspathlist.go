@@ -26,7 +26,7 @@ func resourceRouterAspathList() *schema.Resource {
 		Delete: resourceRouterAspathListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,6 +91,8 @@ func resourceRouterAspathListCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating RouterAspathList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_aspathlist", obj)
+
 	o, err := c.CreateRouterAspathList(obj, vdomparam)
 
 	if err != nil {
@@ -124,6 +126,8 @@ func resourceRouterAspathListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterAspathList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_aspathlist", obj)
+
 	o, err := c.UpdateRouterAspathList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAspathList resource: %v", err)
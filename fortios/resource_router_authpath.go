@@ -26,7 +26,7 @@ func resourceRouterAuthPath() *schema.Resource {
 		Delete: resourceRouterAuthPathDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceRouterAuthPathCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterAuthPath resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_authpath", obj)
+
 	o, err := c.CreateRouterAuthPath(obj, vdomparam)
 
 	if err != nil {
@@ -105,6 +107,8 @@ func resourceRouterAuthPathUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterAuthPath resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_authpath", obj)
+
 	o, err := c.UpdateRouterAuthPath(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterAuthPath resource: %v", err)
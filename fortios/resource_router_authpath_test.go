@@ -50,7 +50,7 @@ func testAccCheckFortiOSRouterAuthPathExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAuthPath(i)
+		o, err := c.ReadRouterAuthPath(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterAuthPath: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckRouterAuthPathDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterAuthPath(i)
+		o, err := c.ReadRouterAuthPath(i, "")
 
 		if err == nil {
 			if o != nil {
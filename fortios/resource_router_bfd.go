@@ -26,7 +26,7 @@ func resourceRouterBfd() *schema.Resource {
 		Delete: resourceRouterBfdDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,6 +81,8 @@ func resourceRouterBfdUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bfd", obj)
+
 	o, err := c.UpdateRouterBfd(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBfd resource: %v", err)
@@ -115,6 +117,8 @@ func resourceRouterBfdDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bfd", obj)
+
 	_, err = c.UpdateRouterBfd(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterBfd resource: %v", err)
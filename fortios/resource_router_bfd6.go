@@ -26,7 +26,7 @@ func resourceRouterBfd6() *schema.Resource {
 		Delete: resourceRouterBfd6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,6 +81,8 @@ func resourceRouterBfd6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bfd6", obj)
+
 	o, err := c.UpdateRouterBfd6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBfd6 resource: %v", err)
@@ -115,6 +117,8 @@ func resourceRouterBfd6Delete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBfd6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bfd6", obj)
+
 	_, err = c.UpdateRouterBfd6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterBfd6 resource: %v", err)
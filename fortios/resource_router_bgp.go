@@ -26,7 +26,7 @@ func resourceRouterBgp() *schema.Resource {
 		Delete: resourceRouterBgpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1708,6 +1708,8 @@ func resourceRouterBgpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBgp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bgp", obj)
+
 	o, err := c.UpdateRouterBgp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterBgp resource: %v", err)
@@ -1742,6 +1744,8 @@ func resourceRouterBgpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterBgp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_bgp", obj)
+
 	_, err = c.UpdateRouterBgp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterBgp resource: %v", err)
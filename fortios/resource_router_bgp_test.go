@@ -95,7 +95,7 @@ func testAccCheckFortiOSRouterBgpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterBgp(i)
+		o, err := c.ReadRouterBgp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterBgp: %s", err)
@@ -118,7 +118,7 @@ func testAccCheckRouterBgpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterBgp(i)
+		o, err := c.ReadRouterBgp(i, "")
 
 		if err == nil {
 			if o != nil {
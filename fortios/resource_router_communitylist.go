@@ -26,7 +26,7 @@ func resourceRouterCommunityList() *schema.Resource {
 		Delete: resourceRouterCommunityListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceRouterCommunityListCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating RouterCommunityList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_communitylist", obj)
+
 	o, err := c.CreateRouterCommunityList(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceRouterCommunityListUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating RouterCommunityList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_communitylist", obj)
+
 	o, err := c.UpdateRouterCommunityList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterCommunityList resource: %v", err)
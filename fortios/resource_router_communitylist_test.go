@@ -52,7 +52,7 @@ func testAccCheckFortiOSRouterCommunityListExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterCommunityList(i)
+		o, err := c.ReadRouterCommunityList(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterCommunityList: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckRouterCommunityListDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterCommunityList(i)
+		o, err := c.ReadRouterCommunityList(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterIsis() *schema.Resource {
 		Delete: resourceRouterIsisDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -570,6 +570,8 @@ func resourceRouterIsisUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterIsis resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_isis", obj)
+
 	o, err := c.UpdateRouterIsis(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterIsis resource: %v", err)
@@ -604,6 +606,8 @@ func resourceRouterIsisDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterIsis resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_isis", obj)
+
 	_, err = c.UpdateRouterIsis(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterIsis resource: %v", err)
@@ -72,7 +72,7 @@ func testAccCheckFortiOSRouterIsisExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterIsis(i)
+		o, err := c.ReadRouterIsis(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterIsis: %s", err)
@@ -95,7 +95,7 @@ func testAccCheckRouterIsisDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterIsis(i)
+		o, err := c.ReadRouterIsis(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterKeyChain() *schema.Resource {
 		Delete: resourceRouterKeyChainDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,6 +102,8 @@ func resourceRouterKeyChainCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterKeyChain resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_keychain", obj)
+
 	o, err := c.CreateRouterKeyChain(obj, vdomparam)
 
 	if err != nil {
@@ -135,6 +137,8 @@ func resourceRouterKeyChainUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterKeyChain resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_keychain", obj)
+
 	o, err := c.UpdateRouterKeyChain(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterKeyChain resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSRouterKeyChainExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterKeyChain(i)
+		o, err := c.ReadRouterKeyChain(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterKeyChain: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckRouterKeyChainDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterKeyChain(i)
+		o, err := c.ReadRouterKeyChain(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterMulticast() *schema.Resource {
 		Delete: resourceRouterMulticastDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -445,6 +445,8 @@ func resourceRouterMulticastUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicast", obj)
+
 	o, err := c.UpdateRouterMulticast(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticast resource: %v", err)
@@ -479,6 +481,8 @@ func resourceRouterMulticastDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicast", obj)
+
 	_, err = c.UpdateRouterMulticast(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterMulticast resource: %v", err)
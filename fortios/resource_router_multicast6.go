@@ -26,7 +26,7 @@ func resourceRouterMulticast6() *schema.Resource {
 		Delete: resourceRouterMulticast6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceRouterMulticast6Update(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicast6", obj)
+
 	o, err := c.UpdateRouterMulticast6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticast6 resource: %v", err)
@@ -165,6 +167,8 @@ func resourceRouterMulticast6Delete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterMulticast6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicast6", obj)
+
 	_, err = c.UpdateRouterMulticast6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterMulticast6 resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSRouterMulticast6Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticast6(i)
+		o, err := c.ReadRouterMulticast6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterMulticast6: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckRouterMulticast6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticast6(i)
+		o, err := c.ReadRouterMulticast6(i, "")
 
 		if err == nil {
 			if o != nil {
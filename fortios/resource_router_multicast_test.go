@@ -68,7 +68,7 @@ func testAccCheckFortiOSRouterMulticastExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticast(i)
+		o, err := c.ReadRouterMulticast(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterMulticast: %s", err)
@@ -91,7 +91,7 @@ func testAccCheckRouterMulticastDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticast(i)
+		o, err := c.ReadRouterMulticast(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterMulticastFlow() *schema.Resource {
 		Delete: resourceRouterMulticastFlowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceRouterMulticastFlowCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating RouterMulticastFlow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicastflow", obj)
+
 	o, err := c.CreateRouterMulticastFlow(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceRouterMulticastFlowUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating RouterMulticastFlow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_multicastflow", obj)
+
 	o, err := c.UpdateRouterMulticastFlow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterMulticastFlow resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSRouterMulticastFlowExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticastFlow(i)
+		o, err := c.ReadRouterMulticastFlow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterMulticastFlow: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckRouterMulticastFlowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterMulticastFlow(i)
+		o, err := c.ReadRouterMulticastFlow(i, "")
 
 		if err == nil {
 			if o != nil {
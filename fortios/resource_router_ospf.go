@@ -26,7 +26,7 @@ func resourceRouterOspf() *schema.Resource {
 		Delete: resourceRouterOspfDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -741,6 +741,8 @@ func resourceRouterOspfUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ospf", obj)
+
 	o, err := c.UpdateRouterOspf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterOspf resource: %v", err)
@@ -775,6 +777,8 @@ func resourceRouterOspfDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ospf", obj)
+
 	_, err = c.UpdateRouterOspf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterOspf resource: %v", err)
@@ -26,7 +26,7 @@ func resourceRouterOspf6() *schema.Resource {
 		Delete: resourceRouterOspf6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -575,6 +575,8 @@ func resourceRouterOspf6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ospf6", obj)
+
 	o, err := c.UpdateRouterOspf6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterOspf6 resource: %v", err)
@@ -609,6 +611,8 @@ func resourceRouterOspf6Delete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterOspf6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ospf6", obj)
+
 	_, err = c.UpdateRouterOspf6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterOspf6 resource: %v", err)
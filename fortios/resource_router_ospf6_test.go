@@ -77,7 +77,7 @@ func testAccCheckFortiOSRouterOspf6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterOspf6(i)
+		o, err := c.ReadRouterOspf6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterOspf6: %s", err)
@@ -100,7 +100,7 @@ func testAccCheckRouterOspf6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterOspf6(i)
+		o, err := c.ReadRouterOspf6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -92,7 +92,7 @@ func testAccCheckFortiOSRouterOspfExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterOspf(i)
+		o, err := c.ReadRouterOspf(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterOspf: %s", err)
@@ -115,7 +115,7 @@ func testAccCheckRouterOspfDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterOspf(i)
+		o, err := c.ReadRouterOspf(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterPolicy() *schema.Resource {
 		Delete: resourceRouterPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/policy", "seq-num"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -246,6 +246,8 @@ func resourceRouterPolicyCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_policy", obj)
+
 	o, err := c.CreateRouterPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -279,6 +281,8 @@ func resourceRouterPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_policy", obj)
+
 	o, err := c.UpdateRouterPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPolicy resource: %v", err)
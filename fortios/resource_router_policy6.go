@@ -26,7 +26,7 @@ func resourceRouterPolicy6() *schema.Resource {
 		Delete: resourceRouterPolicy6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/policy6", "seq-num"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,6 +126,8 @@ func resourceRouterPolicy6Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_policy6", obj)
+
 	o, err := c.CreateRouterPolicy6(obj, vdomparam)
 
 	if err != nil {
@@ -159,6 +161,8 @@ func resourceRouterPolicy6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterPolicy6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_policy6", obj)
+
 	o, err := c.UpdateRouterPolicy6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPolicy6 resource: %v", err)
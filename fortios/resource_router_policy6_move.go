@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRouterPolicy6Move() *schema.Resource {
+	return resourceTableMove(orderTableOf("fortios_router_policy6"))
+}
@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRouterPolicy6Order() *schema.Resource {
+	return resourceTableOrder(orderTableOf("fortios_router_policy6"))
+}
@@ -59,7 +59,7 @@ func testAccCheckFortiOSRouterPolicy6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPolicy6(i)
+		o, err := c.ReadRouterPolicy6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterPolicy6: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckRouterPolicy6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPolicy6(i)
+		o, err := c.ReadRouterPolicy6(i, "")
 
 		if err == nil {
 			if o != nil {
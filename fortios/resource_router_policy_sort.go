@@ -0,0 +1,9 @@
+package fortios
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRouterPolicySort() *schema.Resource {
+	return resourceTableSort(orderTableOf("fortios_router_policy"))
+}
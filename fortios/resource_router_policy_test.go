@@ -62,7 +62,7 @@ func testAccCheckFortiOSRouterPolicyExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPolicy(i)
+		o, err := c.ReadRouterPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterPolicy: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckRouterPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPolicy(i)
+		o, err := c.ReadRouterPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterPrefixList() *schema.Resource {
 		Delete: resourceRouterPrefixListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceRouterPrefixListCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating RouterPrefixList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_prefixlist", obj)
+
 	o, err := c.CreateRouterPrefixList(obj, vdomparam)
 
 	if err != nil {
@@ -146,6 +148,8 @@ func resourceRouterPrefixListUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterPrefixList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_prefixlist", obj)
+
 	o, err := c.UpdateRouterPrefixList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPrefixList resource: %v", err)
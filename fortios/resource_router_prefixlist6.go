@@ -26,7 +26,7 @@ func resourceRouterPrefixList6() *schema.Resource {
 		Delete: resourceRouterPrefixList6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceRouterPrefixList6Create(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating RouterPrefixList6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_prefixlist6", obj)
+
 	o, err := c.CreateRouterPrefixList6(obj, vdomparam)
 
 	if err != nil {
@@ -146,6 +148,8 @@ func resourceRouterPrefixList6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterPrefixList6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_prefixlist6", obj)
+
 	o, err := c.UpdateRouterPrefixList6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterPrefixList6 resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSRouterPrefixList6Exists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPrefixList6(i)
+		o, err := c.ReadRouterPrefixList6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterPrefixList6: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckRouterPrefixList6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPrefixList6(i)
+		o, err := c.ReadRouterPrefixList6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -48,7 +48,7 @@ func testAccCheckFortiOSRouterPrefixListExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPrefixList(i)
+		o, err := c.ReadRouterPrefixList(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterPrefixList: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckRouterPrefixListDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterPrefixList(i)
+		o, err := c.ReadRouterPrefixList(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterRip() *schema.Resource {
 		Delete: resourceRouterRipDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -353,6 +353,8 @@ func resourceRouterRipUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRip resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_rip", obj)
+
 	o, err := c.UpdateRouterRip(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRip resource: %v", err)
@@ -387,6 +389,8 @@ func resourceRouterRipDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRip resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_rip", obj)
+
 	_, err = c.UpdateRouterRip(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterRip resource: %v", err)
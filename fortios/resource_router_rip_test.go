@@ -70,7 +70,7 @@ func testAccCheckFortiOSRouterRipExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRip(i)
+		o, err := c.ReadRouterRip(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterRip: %s", err)
@@ -93,7 +93,7 @@ func testAccCheckRouterRipDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRip(i)
+		o, err := c.ReadRouterRip(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceRouterRipng() *schema.Resource {
 		Delete: resourceRouterRipngDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -335,6 +335,8 @@ func resourceRouterRipngUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRipng resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ripng", obj)
+
 	o, err := c.UpdateRouterRipng(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRipng resource: %v", err)
@@ -369,6 +371,8 @@ func resourceRouterRipngDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRipng resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_ripng", obj)
+
 	_, err = c.UpdateRouterRipng(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterRipng resource: %v", err)
@@ -68,7 +68,7 @@ func testAccCheckFortiOSRouterRipngExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRipng(i)
+		o, err := c.ReadRouterRipng(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterRipng: %s", err)
@@ -91,7 +91,7 @@ func testAccCheckRouterRipngDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRipng(i)
+		o, err := c.ReadRouterRipng(i, "")
 
 		if err == nil {
 			if o != nil {
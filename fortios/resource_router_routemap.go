@@ -26,7 +26,7 @@ func resourceRouterRouteMap() *schema.Resource {
 		Delete: resourceRouterRouteMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -348,6 +348,8 @@ func resourceRouterRouteMapCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterRouteMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_routemap", obj)
+
 	o, err := c.CreateRouterRouteMap(obj, vdomparam)
 
 	if err != nil {
@@ -381,6 +383,8 @@ func resourceRouterRouteMapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterRouteMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_routemap", obj)
+
 	o, err := c.UpdateRouterRouteMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterRouteMap resource: %v", err)
@@ -77,7 +77,7 @@ func testAccCheckFortiOSRouterRouteMapExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRouteMap(i)
+		o, err := c.ReadRouterRouteMap(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterRouteMap: %s", err)
@@ -100,7 +100,7 @@ func testAccCheckRouterRouteMapDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterRouteMap(i)
+		o, err := c.ReadRouterRouteMap(i, "")
 
 		if err == nil {
 			if o != nil {
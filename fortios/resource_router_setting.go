@@ -26,7 +26,7 @@ func resourceRouterSetting() *schema.Resource {
 		Delete: resourceRouterSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +189,8 @@ func resourceRouterSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_setting", obj)
+
 	o, err := c.UpdateRouterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterSetting resource: %v", err)
@@ -223,6 +225,8 @@ func resourceRouterSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_setting", obj)
+
 	_, err = c.UpdateRouterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing RouterSetting resource: %v", err)
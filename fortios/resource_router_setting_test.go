@@ -48,7 +48,7 @@ func testAccCheckFortiOSRouterSettingExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterSetting(i)
+		o, err := c.ReadRouterSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterSetting: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckRouterSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterSetting(i)
+		o, err := c.ReadRouterSetting(i, "")
 
 		if err == nil {
 			if o != nil {
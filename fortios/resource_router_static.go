@@ -26,7 +26,7 @@ func resourceRouterStatic() *schema.Resource {
 		Delete: resourceRouterStaticDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/static", "seq-num"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -182,6 +182,8 @@ func resourceRouterStaticCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterStatic resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_static", obj)
+
 	o, err := c.CreateRouterStatic(obj, vdomparam)
 
 	if err != nil {
@@ -215,6 +217,8 @@ func resourceRouterStaticUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterStatic resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_static", obj)
+
 	o, err := c.UpdateRouterStatic(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterStatic resource: %v", err)
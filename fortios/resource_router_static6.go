@@ -26,7 +26,7 @@ func resourceRouterStatic6() *schema.Resource {
 		Delete: resourceRouterStatic6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/static6", "seq-num"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -158,6 +158,8 @@ func resourceRouterStatic6Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating RouterStatic6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_static6", obj)
+
 	o, err := c.CreateRouterStatic6(obj, vdomparam)
 
 	if err != nil {
@@ -191,6 +193,8 @@ func resourceRouterStatic6Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating RouterStatic6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_router_static6", obj)
+
 	o, err := c.UpdateRouterStatic6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterStatic6 resource: %v", err)
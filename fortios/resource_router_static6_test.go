@@ -58,7 +58,7 @@ func testAccCheckFortiOSRouterStatic6Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterStatic6(i)
+		o, err := c.ReadRouterStatic6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterStatic6: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckRouterStatic6Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterStatic6(i)
+		o, err := c.ReadRouterStatic6(i, "")
 
 		if err == nil {
 			if o != nil {
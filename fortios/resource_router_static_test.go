@@ -63,7 +63,7 @@ func testAccCheckFortiOSRouterStaticExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterStatic(i)
+		o, err := c.ReadRouterStatic(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading RouterStatic: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckRouterStaticDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadRouterStatic(i)
+		o, err := c.ReadRouterStatic(i, "")
 
 		if err == nil {
 			if o != nil {
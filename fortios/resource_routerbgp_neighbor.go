@@ -26,7 +26,7 @@ func resourceRouterbgpNeighbor() *schema.Resource {
 		Delete: resourceRouterbgpNeighborDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomChild("bgp"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -602,6 +602,8 @@ func resourceRouterbgpNeighborCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating RouterbgpNeighbor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_neighbor", obj)
+
 	o, err := c.CreateRouterbgpNeighbor(obj, vdomparam)
 
 	if err != nil {
@@ -635,6 +637,8 @@ func resourceRouterbgpNeighborUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterbgpNeighbor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_neighbor", obj)
+
 	o, err := c.UpdateRouterbgpNeighbor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNeighbor resource: %v", err)
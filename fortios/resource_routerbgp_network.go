@@ -26,7 +26,7 @@ func resourceRouterbgpNetwork() *schema.Resource {
 		Delete: resourceRouterbgpNetworkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/bgp/network", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceRouterbgpNetworkCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating RouterbgpNetwork resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_network", obj)
+
 	o, err := c.CreateRouterbgpNetwork(obj, vdomparam)
 
 	if err != nil {
@@ -115,6 +117,8 @@ func resourceRouterbgpNetworkUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating RouterbgpNetwork resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_network", obj)
+
 	o, err := c.UpdateRouterbgpNetwork(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNetwork resource: %v", err)
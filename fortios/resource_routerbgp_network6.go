@@ -26,7 +26,7 @@ func resourceRouterbgpNetwork6() *schema.Resource {
 		Delete: resourceRouterbgpNetwork6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/bgp/network6", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,6 +83,8 @@ func resourceRouterbgpNetwork6Create(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating RouterbgpNetwork6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_network6", obj)
+
 	o, err := c.CreateRouterbgpNetwork6(obj, vdomparam)
 
 	if err != nil {
@@ -116,6 +118,8 @@ func resourceRouterbgpNetwork6Update(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterbgpNetwork6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerbgp_network6", obj)
+
 	o, err := c.UpdateRouterbgpNetwork6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterbgpNetwork6 resource: %v", err)
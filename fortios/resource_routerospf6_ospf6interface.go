@@ -26,7 +26,7 @@ func resourceRouterospf6Ospf6Interface() *schema.Resource {
 		Delete: resourceRouterospf6Ospf6InterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomChild("ospf6"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -216,6 +216,8 @@ func resourceRouterospf6Ospf6InterfaceCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating Routerospf6Ospf6Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf6_ospf6interface", obj)
+
 	o, err := c.CreateRouterospf6Ospf6Interface(obj, vdomparam)
 
 	if err != nil {
@@ -249,6 +251,8 @@ func resourceRouterospf6Ospf6InterfaceUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating Routerospf6Ospf6Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf6_ospf6interface", obj)
+
 	o, err := c.UpdateRouterospf6Ospf6Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating Routerospf6Ospf6Interface resource: %v", err)
@@ -26,7 +26,7 @@ func resourceRouterospfNeighbor() *schema.Resource {
 		Delete: resourceRouterospfNeighborDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/ospf/neighbor", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceRouterospfNeighborCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating RouterospfNeighbor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_neighbor", obj)
+
 	o, err := c.CreateRouterospfNeighbor(obj, vdomparam)
 
 	if err != nil {
@@ -118,6 +120,8 @@ func resourceRouterospfNeighborUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating RouterospfNeighbor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_neighbor", obj)
+
 	o, err := c.UpdateRouterospfNeighbor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfNeighbor resource: %v", err)
@@ -26,7 +26,7 @@ func resourceRouterospfNetwork() *schema.Resource {
 		Delete: resourceRouterospfNetworkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/router/ospf/network", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,6 +77,8 @@ func resourceRouterospfNetworkCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating RouterospfNetwork resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_network", obj)
+
 	o, err := c.CreateRouterospfNetwork(obj, vdomparam)
 
 	if err != nil {
@@ -110,6 +112,8 @@ func resourceRouterospfNetworkUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating RouterospfNetwork resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_network", obj)
+
 	o, err := c.UpdateRouterospfNetwork(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfNetwork resource: %v", err)
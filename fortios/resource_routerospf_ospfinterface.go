@@ -26,7 +26,7 @@ func resourceRouterospfOspfInterface() *schema.Resource {
 		Delete: resourceRouterospfOspfInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomChild("ospf"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -215,6 +215,8 @@ func resourceRouterospfOspfInterfaceCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating RouterospfOspfInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_ospfinterface", obj)
+
 	o, err := c.CreateRouterospfOspfInterface(obj, vdomparam)
 
 	if err != nil {
@@ -248,6 +250,8 @@ func resourceRouterospfOspfInterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating RouterospfOspfInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_routerospf_ospfinterface", obj)
+
 	o, err := c.UpdateRouterospfOspfInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating RouterospfOspfInterface resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSctpFilterProfile() *schema.Resource {
 		Delete: resourceSctpFilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceSctpFilterProfileCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SctpFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_sctpfilter_profile", obj)
+
 	o, err := c.CreateSctpFilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceSctpFilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SctpFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_sctpfilter_profile", obj)
+
 	o, err := c.UpdateSctpFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SctpFilterProfile resource: %v", err)
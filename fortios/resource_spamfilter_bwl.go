@@ -26,7 +26,7 @@ func resourceSpamfilterBwl() *schema.Resource {
 		Delete: resourceSpamfilterBwlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/spamfilter/bwl", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,6 +130,8 @@ func resourceSpamfilterBwlCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SpamfilterBwl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_bwl", obj)
+
 	o, err := c.CreateSpamfilterBwl(obj, vdomparam)
 
 	if err != nil {
@@ -163,6 +165,8 @@ func resourceSpamfilterBwlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SpamfilterBwl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_bwl", obj)
+
 	o, err := c.UpdateSpamfilterBwl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterBwl resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSSpamfilterBwlExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterBwl(i)
+		o, err := c.ReadSpamfilterBwl(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterBwl: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckSpamfilterBwlDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterBwl(i)
+		o, err := c.ReadSpamfilterBwl(i, "")
 
 		if err == nil {
 			if o != nil {
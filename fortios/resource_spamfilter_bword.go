@@ -26,7 +26,7 @@ func resourceSpamfilterBword() *schema.Resource {
 		Delete: resourceSpamfilterBwordDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/spamfilter/bword", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,6 +126,8 @@ func resourceSpamfilterBwordCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SpamfilterBword resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_bword", obj)
+
 	o, err := c.CreateSpamfilterBword(obj, vdomparam)
 
 	if err != nil {
@@ -159,6 +161,8 @@ func resourceSpamfilterBwordUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SpamfilterBword resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_bword", obj)
+
 	o, err := c.UpdateSpamfilterBword(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterBword resource: %v", err)
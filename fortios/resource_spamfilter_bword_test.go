@@ -57,7 +57,7 @@ func testAccCheckFortiOSSpamfilterBwordExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterBword(i)
+		o, err := c.ReadSpamfilterBword(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterBword: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckSpamfilterBwordDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterBword(i)
+		o, err := c.ReadSpamfilterBword(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSpamfilterDnsbl() *schema.Resource {
 		Delete: resourceSpamfilterDnsblDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/spamfilter/dnsbl", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -105,6 +105,8 @@ func resourceSpamfilterDnsblCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SpamfilterDnsbl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_dnsbl", obj)
+
 	o, err := c.CreateSpamfilterDnsbl(obj, vdomparam)
 
 	if err != nil {
@@ -138,6 +140,8 @@ func resourceSpamfilterDnsblUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SpamfilterDnsbl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_dnsbl", obj)
+
 	o, err := c.UpdateSpamfilterDnsbl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterDnsbl resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSpamfilterDnsblExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterDnsbl(i)
+		o, err := c.ReadSpamfilterDnsbl(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterDnsbl: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSpamfilterDnsblDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterDnsbl(i)
+		o, err := c.ReadSpamfilterDnsbl(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSpamfilterFortishield() *schema.Resource {
 		Delete: resourceSpamfilterFortishieldDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSpamfilterFortishieldUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SpamfilterFortishield resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_fortishield", obj)
+
 	o, err := c.UpdateSpamfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterFortishield resource: %v", err)
@@ -107,6 +109,8 @@ func resourceSpamfilterFortishieldDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SpamfilterFortishield resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_fortishield", obj)
+
 	_, err = c.UpdateSpamfilterFortishield(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SpamfilterFortishield resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSpamfilterFortishieldExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterFortishield(i)
+		o, err := c.ReadSpamfilterFortishield(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterFortishield: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSpamfilterFortishieldDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterFortishield(i)
+		o, err := c.ReadSpamfilterFortishield(i, "")
 
 		if err == nil {
 			if o != nil {
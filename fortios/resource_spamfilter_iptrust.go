@@ -26,7 +26,7 @@ func resourceSpamfilterIptrust() *schema.Resource {
 		Delete: resourceSpamfilterIptrustDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/spamfilter/iptrust", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -109,6 +109,8 @@ func resourceSpamfilterIptrustCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SpamfilterIptrust resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_iptrust", obj)
+
 	o, err := c.CreateSpamfilterIptrust(obj, vdomparam)
 
 	if err != nil {
@@ -142,6 +144,8 @@ func resourceSpamfilterIptrustUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterIptrust resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_iptrust", obj)
+
 	o, err := c.UpdateSpamfilterIptrust(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterIptrust resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSpamfilterMheader() *schema.Resource {
 		Delete: resourceSpamfilterMheaderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/spamfilter/mheader", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +116,8 @@ func resourceSpamfilterMheaderCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SpamfilterMheader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_mheader", obj)
+
 	o, err := c.CreateSpamfilterMheader(obj, vdomparam)
 
 	if err != nil {
@@ -149,6 +151,8 @@ func resourceSpamfilterMheaderUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterMheader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_mheader", obj)
+
 	o, err := c.UpdateSpamfilterMheader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterMheader resource: %v", err)
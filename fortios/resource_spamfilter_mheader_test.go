@@ -56,7 +56,7 @@ func testAccCheckFortiOSSpamfilterMheaderExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterMheader(i)
+		o, err := c.ReadSpamfilterMheader(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterMheader: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckSpamfilterMheaderDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterMheader(i)
+		o, err := c.ReadSpamfilterMheader(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSpamfilterOptions() *schema.Resource {
 		Delete: resourceSpamfilterOptionsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,6 +63,8 @@ func resourceSpamfilterOptionsUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_options", obj)
+
 	o, err := c.UpdateSpamfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterOptions resource: %v", err)
@@ -97,6 +99,8 @@ func resourceSpamfilterOptionsDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterOptions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_options", obj)
+
 	_, err = c.UpdateSpamfilterOptions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SpamfilterOptions resource: %v", err)
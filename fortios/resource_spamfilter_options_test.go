@@ -48,7 +48,7 @@ func testAccCheckFortiOSSpamfilterOptionsExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterOptions(i)
+		o, err := c.ReadSpamfilterOptions(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterOptions: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSpamfilterOptionsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterOptions(i)
+		o, err := c.ReadSpamfilterOptions(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSpamfilterProfile() *schema.Resource {
 		Delete: resourceSpamfilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -294,6 +294,8 @@ func resourceSpamfilterProfileCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SpamfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_profile", obj)
+
 	o, err := c.CreateSpamfilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -327,6 +329,8 @@ func resourceSpamfilterProfileUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SpamfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_spamfilter_profile", obj)
+
 	o, err := c.UpdateSpamfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SpamfilterProfile resource: %v", err)
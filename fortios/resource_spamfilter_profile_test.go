@@ -79,7 +79,7 @@ func testAccCheckFortiOSSpamfilterProfileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterProfile(i)
+		o, err := c.ReadSpamfilterProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SpamfilterProfile: %s", err)
@@ -102,7 +102,7 @@ func testAccCheckSpamfilterProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSpamfilterProfile(i)
+		o, err := c.ReadSpamfilterProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSshFilterProfile() *schema.Resource {
 		Delete: resourceSshFilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -199,6 +199,8 @@ func resourceSshFilterProfileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SshFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_sshfilter_profile", obj)
+
 	o, err := c.CreateSshFilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -232,6 +234,8 @@ func resourceSshFilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SshFilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_sshfilter_profile", obj)
+
 	o, err := c.UpdateSshFilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SshFilterProfile resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSshFilterProfileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSshFilterProfile(i)
+		o, err := c.ReadSshFilterProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SshFilterProfile: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSshFilterProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSshFilterProfile(i)
+		o, err := c.ReadSshFilterProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSwitchController8021XSettings() *schema.Resource {
 		Delete: resourceSwitchController8021XSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +80,8 @@ func resourceSwitchController8021XSettingsUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchController8021XSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_8021Xsettings", obj)
+
 	o, err := c.UpdateSwitchController8021XSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchController8021XSettings resource: %v", err)
@@ -114,6 +116,8 @@ func resourceSwitchController8021XSettingsDelete(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchController8021XSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_8021Xsettings", obj)
+
 	_, err = c.UpdateSwitchController8021XSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchController8021XSettings resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSwitchController8021XSettingsExists(n string) resource.T
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchController8021XSettings(i)
+		o, err := c.ReadSwitchController8021XSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchController8021XSettings: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSwitchController8021XSettingsDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchController8021XSettings(i)
+		o, err := c.ReadSwitchController8021XSettings(i, "")
 
 		if err == nil {
 			if o != nil {
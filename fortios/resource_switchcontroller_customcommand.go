@@ -26,7 +26,7 @@ func resourceSwitchControllerCustomCommand() *schema.Resource {
 		Delete: resourceSwitchControllerCustomCommandDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSwitchControllerCustomCommandCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating SwitchControllerCustomCommand resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_customcommand", obj)
+
 	o, err := c.CreateSwitchControllerCustomCommand(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceSwitchControllerCustomCommandUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerCustomCommand resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_customcommand", obj)
+
 	o, err := c.UpdateSwitchControllerCustomCommand(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerCustomCommand resource: %v", err)
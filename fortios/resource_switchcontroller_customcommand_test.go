@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerCustomCommandExists(n string) resource.T
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerCustomCommand(i)
+		o, err := c.ReadSwitchControllerCustomCommand(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerCustomCommand: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerCustomCommandDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerCustomCommand(i)
+		o, err := c.ReadSwitchControllerCustomCommand(i, "")
 
 		if err == nil {
 			if o != nil {
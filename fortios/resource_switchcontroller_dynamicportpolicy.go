@@ -26,7 +26,7 @@ func resourceSwitchControllerDynamicPortPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerDynamicPortPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -183,6 +183,8 @@ func resourceSwitchControllerDynamicPortPolicyCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating SwitchControllerDynamicPortPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_dynamicportpolicy", obj)
+
 	o, err := c.CreateSwitchControllerDynamicPortPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -216,6 +218,8 @@ func resourceSwitchControllerDynamicPortPolicyUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerDynamicPortPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_dynamicportpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerDynamicPortPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerDynamicPortPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerFlowTracking() *schema.Resource {
 		Delete: resourceSwitchControllerFlowTrackingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -165,6 +165,8 @@ func resourceSwitchControllerFlowTrackingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerFlowTracking resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_flowtracking", obj)
+
 	o, err := c.UpdateSwitchControllerFlowTracking(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerFlowTracking resource: %v", err)
@@ -199,6 +201,8 @@ func resourceSwitchControllerFlowTrackingDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerFlowTracking resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_flowtracking", obj)
+
 	_, err = c.UpdateSwitchControllerFlowTracking(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerFlowTracking resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerFortilinkSettings() *schema.Resource {
 		Delete: resourceSwitchControllerFortilinkSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -137,6 +137,8 @@ func resourceSwitchControllerFortilinkSettingsCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating SwitchControllerFortilinkSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_fortilinksettings", obj)
+
 	o, err := c.CreateSwitchControllerFortilinkSettings(obj, vdomparam)
 
 	if err != nil {
@@ -170,6 +172,8 @@ func resourceSwitchControllerFortilinkSettingsUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerFortilinkSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_fortilinksettings", obj)
+
 	o, err := c.UpdateSwitchControllerFortilinkSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerFortilinkSettings resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerGlobal() *schema.Resource {
 		Delete: resourceSwitchControllerGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -184,6 +184,8 @@ func resourceSwitchControllerGlobalUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_global", obj)
+
 	o, err := c.UpdateSwitchControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerGlobal resource: %v", err)
@@ -218,6 +220,8 @@ func resourceSwitchControllerGlobalDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_global", obj)
+
 	_, err = c.UpdateSwitchControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerGlobal resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSwitchControllerGlobalExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerGlobal(i)
+		o, err := c.ReadSwitchControllerGlobal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerGlobal: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSwitchControllerGlobalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerGlobal(i)
+		o, err := c.ReadSwitchControllerGlobal(i, "")
 
 		if err == nil {
 			if o != nil {
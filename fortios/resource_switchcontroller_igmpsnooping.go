@@ -26,7 +26,7 @@ func resourceSwitchControllerIgmpSnooping() *schema.Resource {
 		Delete: resourceSwitchControllerIgmpSnoopingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceSwitchControllerIgmpSnoopingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerIgmpSnooping resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_igmpsnooping", obj)
+
 	o, err := c.UpdateSwitchControllerIgmpSnooping(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerIgmpSnooping resource: %v", err)
@@ -102,6 +104,8 @@ func resourceSwitchControllerIgmpSnoopingDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerIgmpSnooping resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_igmpsnooping", obj)
+
 	_, err = c.UpdateSwitchControllerIgmpSnooping(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerIgmpSnooping resource: %v", err)
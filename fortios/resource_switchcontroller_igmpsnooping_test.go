@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerIgmpSnoopingExists(n string) resource.Te
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerIgmpSnooping(i)
+		o, err := c.ReadSwitchControllerIgmpSnooping(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerIgmpSnooping: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerIgmpSnoopingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerIgmpSnooping(i)
+		o, err := c.ReadSwitchControllerIgmpSnooping(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSwitchControllerLldpProfile() *schema.Resource {
 		Delete: resourceSwitchControllerLldpProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -214,6 +214,8 @@ func resourceSwitchControllerLldpProfileCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SwitchControllerLldpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_lldpprofile", obj)
+
 	o, err := c.CreateSwitchControllerLldpProfile(obj, vdomparam)
 
 	if err != nil {
@@ -247,6 +249,8 @@ func resourceSwitchControllerLldpProfileUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerLldpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_lldpprofile", obj)
+
 	o, err := c.UpdateSwitchControllerLldpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLldpProfile resource: %v", err)
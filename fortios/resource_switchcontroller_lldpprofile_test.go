@@ -53,7 +53,7 @@ func testAccCheckFortiOSSwitchControllerLldpProfileExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerLldpProfile(i)
+		o, err := c.ReadSwitchControllerLldpProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerLldpProfile: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSwitchControllerLldpProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerLldpProfile(i)
+		o, err := c.ReadSwitchControllerLldpProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSwitchControllerLldpSettings() *schema.Resource {
 		Delete: resourceSwitchControllerLldpSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,6 +90,8 @@ func resourceSwitchControllerLldpSettingsUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerLldpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_lldpsettings", obj)
+
 	o, err := c.UpdateSwitchControllerLldpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLldpSettings resource: %v", err)
@@ -124,6 +126,8 @@ func resourceSwitchControllerLldpSettingsDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerLldpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_lldpsettings", obj)
+
 	_, err = c.UpdateSwitchControllerLldpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerLldpSettings resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSSwitchControllerLldpSettingsExists(n string) resource.Te
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerLldpSettings(i)
+		o, err := c.ReadSwitchControllerLldpSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerLldpSettings: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckSwitchControllerLldpSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerLldpSettings(i)
+		o, err := c.ReadSwitchControllerLldpSettings(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSwitchControllerLocation() *schema.Resource {
 		Delete: resourceSwitchControllerLocationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -340,6 +340,8 @@ func resourceSwitchControllerLocationCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating SwitchControllerLocation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_location", obj)
+
 	o, err := c.CreateSwitchControllerLocation(obj, vdomparam)
 
 	if err != nil {
@@ -373,6 +375,8 @@ func resourceSwitchControllerLocationUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SwitchControllerLocation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_location", obj)
+
 	o, err := c.UpdateSwitchControllerLocation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerLocation resource: %v", err)
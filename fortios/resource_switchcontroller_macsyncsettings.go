@@ -26,7 +26,7 @@ func resourceSwitchControllerMacSyncSettings() *schema.Resource {
 		Delete: resourceSwitchControllerMacSyncSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,6 +63,8 @@ func resourceSwitchControllerMacSyncSettingsUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating SwitchControllerMacSyncSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_macsyncsettings", obj)
+
 	o, err := c.UpdateSwitchControllerMacSyncSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerMacSyncSettings resource: %v", err)
@@ -97,6 +99,8 @@ func resourceSwitchControllerMacSyncSettingsDelete(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating SwitchControllerMacSyncSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_macsyncsettings", obj)
+
 	_, err = c.UpdateSwitchControllerMacSyncSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerMacSyncSettings resource: %v", err)
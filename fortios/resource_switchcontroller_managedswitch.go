@@ -26,7 +26,7 @@ func resourceSwitchControllerManagedSwitch() *schema.Resource {
 		Delete: resourceSwitchControllerManagedSwitchDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1478,6 +1478,8 @@ func resourceSwitchControllerManagedSwitchCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating SwitchControllerManagedSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_managedswitch", obj)
+
 	o, err := c.CreateSwitchControllerManagedSwitch(obj, vdomparam)
 
 	if err != nil {
@@ -1511,6 +1513,8 @@ func resourceSwitchControllerManagedSwitchUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerManagedSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_managedswitch", obj)
+
 	o, err := c.UpdateSwitchControllerManagedSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerManagedSwitch resource: %v", err)
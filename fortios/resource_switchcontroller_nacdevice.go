@@ -26,7 +26,7 @@ func resourceSwitchControllerNacDevice() *schema.Resource {
 		Delete: resourceSwitchControllerNacDeviceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/switch-controller/nac-device", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceSwitchControllerNacDeviceCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating SwitchControllerNacDevice resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_nacdevice", obj)
+
 	o, err := c.CreateSwitchControllerNacDevice(obj, vdomparam)
 
 	if err != nil {
@@ -146,6 +148,8 @@ func resourceSwitchControllerNacDeviceUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerNacDevice resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_nacdevice", obj)
+
 	o, err := c.UpdateSwitchControllerNacDevice(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNacDevice resource: %v", err)
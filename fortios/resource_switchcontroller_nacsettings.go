@@ -26,7 +26,7 @@ func resourceSwitchControllerNacSettings() *schema.Resource {
 		Delete: resourceSwitchControllerNacSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,6 +95,8 @@ func resourceSwitchControllerNacSettingsCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SwitchControllerNacSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_nacsettings", obj)
+
 	o, err := c.CreateSwitchControllerNacSettings(obj, vdomparam)
 
 	if err != nil {
@@ -128,6 +130,8 @@ func resourceSwitchControllerNacSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerNacSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_nacsettings", obj)
+
 	o, err := c.UpdateSwitchControllerNacSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNacSettings resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerNetworkMonitorSettings() *schema.Resource {
 		Delete: resourceSwitchControllerNetworkMonitorSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceSwitchControllerNetworkMonitorSettingsUpdate(d *schema.ResourceData
 		return fmt.Errorf("Error updating SwitchControllerNetworkMonitorSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_networkmonitorsettings", obj)
+
 	o, err := c.UpdateSwitchControllerNetworkMonitorSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerNetworkMonitorSettings resource: %v", err)
@@ -96,6 +98,8 @@ func resourceSwitchControllerNetworkMonitorSettingsDelete(d *schema.ResourceData
 		return fmt.Errorf("Error updating SwitchControllerNetworkMonitorSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_networkmonitorsettings", obj)
+
 	_, err = c.UpdateSwitchControllerNetworkMonitorSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerNetworkMonitorSettings resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSSwitchControllerNetworkMonitorSettingsExists(n string) r
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerNetworkMonitorSettings(i)
+		o, err := c.ReadSwitchControllerNetworkMonitorSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerNetworkMonitorSettings: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSwitchControllerNetworkMonitorSettingsDestroy(s *terraform.Stat
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerNetworkMonitorSettings(i)
+		o, err := c.ReadSwitchControllerNetworkMonitorSettings(i, "")
 
 		if err == nil {
 			if o != nil {
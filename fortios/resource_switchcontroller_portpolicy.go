@@ -26,7 +26,7 @@ func resourceSwitchControllerPortPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerPortPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,6 +104,8 @@ func resourceSwitchControllerPortPolicyCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating SwitchControllerPortPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_portpolicy", obj)
+
 	o, err := c.CreateSwitchControllerPortPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -137,6 +139,8 @@ func resourceSwitchControllerPortPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerPortPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_portpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerPortPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPortPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerQuarantine() *schema.Resource {
 		Delete: resourceSwitchControllerQuarantineDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -105,6 +105,8 @@ func resourceSwitchControllerQuarantineUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_quarantine", obj)
+
 	o, err := c.UpdateSwitchControllerQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQuarantine resource: %v", err)
@@ -139,6 +141,8 @@ func resourceSwitchControllerQuarantineDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_quarantine", obj)
+
 	_, err = c.UpdateSwitchControllerQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerQuarantine resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerRemoteLog() *schema.Resource {
 		Delete: resourceSwitchControllerRemoteLogDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,6 +95,8 @@ func resourceSwitchControllerRemoteLogCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating SwitchControllerRemoteLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_remotelog", obj)
+
 	o, err := c.CreateSwitchControllerRemoteLog(obj, vdomparam)
 
 	if err != nil {
@@ -128,6 +130,8 @@ func resourceSwitchControllerRemoteLogUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerRemoteLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_remotelog", obj)
+
 	o, err := c.UpdateSwitchControllerRemoteLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerRemoteLog resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSflow() *schema.Resource {
 		Delete: resourceSwitchControllerSflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceSwitchControllerSflowUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_sflow", obj)
+
 	o, err := c.UpdateSwitchControllerSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSflow resource: %v", err)
@@ -101,6 +103,8 @@ func resourceSwitchControllerSflowDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_sflow", obj)
+
 	_, err = c.UpdateSwitchControllerSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerSflow resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerSflowExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSflow(i)
+		o, err := c.ReadSwitchControllerSflow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerSflow: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerSflowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSflow(i)
+		o, err := c.ReadSwitchControllerSflow(i, "")
 
 		if err == nil {
 			if o != nil {
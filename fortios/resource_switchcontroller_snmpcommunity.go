@@ -26,7 +26,7 @@ func resourceSwitchControllerSnmpCommunity() *schema.Resource {
 		Delete: resourceSwitchControllerSnmpCommunityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/switch-controller/snmp-community", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -157,6 +157,8 @@ func resourceSwitchControllerSnmpCommunityCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating SwitchControllerSnmpCommunity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpcommunity", obj)
+
 	o, err := c.CreateSwitchControllerSnmpCommunity(obj, vdomparam)
 
 	if err != nil {
@@ -190,6 +192,8 @@ func resourceSwitchControllerSnmpCommunityUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerSnmpCommunity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpcommunity", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpCommunity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpCommunity resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSnmpSysinfo() *schema.Resource {
 		Delete: resourceSwitchControllerSnmpSysinfoDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceSwitchControllerSnmpSysinfoUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpsysinfo", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpSysinfo resource: %v", err)
@@ -120,6 +122,8 @@ func resourceSwitchControllerSnmpSysinfoDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpsysinfo", obj)
+
 	_, err = c.UpdateSwitchControllerSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerSnmpSysinfo resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSnmpTrapThreshold() *schema.Resource {
 		Delete: resourceSwitchControllerSnmpTrapThresholdDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceSwitchControllerSnmpTrapThresholdUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerSnmpTrapThreshold resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmptrapthreshold", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpTrapThreshold(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpTrapThreshold resource: %v", err)
@@ -106,6 +108,8 @@ func resourceSwitchControllerSnmpTrapThresholdDelete(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerSnmpTrapThreshold resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmptrapthreshold", obj)
+
 	_, err = c.UpdateSwitchControllerSnmpTrapThreshold(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerSnmpTrapThreshold resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSnmpUser() *schema.Resource {
 		Delete: resourceSwitchControllerSnmpUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceSwitchControllerSnmpUserCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating SwitchControllerSnmpUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpuser", obj)
+
 	o, err := c.CreateSwitchControllerSnmpUser(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceSwitchControllerSnmpUserUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SwitchControllerSnmpUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_snmpuser", obj)
+
 	o, err := c.UpdateSwitchControllerSnmpUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSnmpUser resource: %v", err)
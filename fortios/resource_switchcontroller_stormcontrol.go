@@ -26,7 +26,7 @@ func resourceSwitchControllerStormControl() *schema.Resource {
 		Delete: resourceSwitchControllerStormControlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceSwitchControllerStormControlUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerStormControl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stormcontrol", obj)
+
 	o, err := c.UpdateSwitchControllerStormControl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStormControl resource: %v", err)
@@ -112,6 +114,8 @@ func resourceSwitchControllerStormControlDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerStormControl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stormcontrol", obj)
+
 	_, err = c.UpdateSwitchControllerStormControl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerStormControl resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerStormControlPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerStormControlPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,6 +95,8 @@ func resourceSwitchControllerStormControlPolicyCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating SwitchControllerStormControlPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stormcontrolpolicy", obj)
+
 	o, err := c.CreateSwitchControllerStormControlPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -128,6 +130,8 @@ func resourceSwitchControllerStormControlPolicyUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerStormControlPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stormcontrolpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerStormControlPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStormControlPolicy resource: %v", err)
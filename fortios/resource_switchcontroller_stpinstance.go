@@ -26,7 +26,7 @@ func resourceSwitchControllerStpInstance() *schema.Resource {
 		Delete: resourceSwitchControllerStpInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceSwitchControllerStpInstanceCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SwitchControllerStpInstance resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stpinstance", obj)
+
 	o, err := c.CreateSwitchControllerStpInstance(obj, vdomparam)
 
 	if err != nil {
@@ -115,6 +117,8 @@ func resourceSwitchControllerStpInstanceUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerStpInstance resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stpinstance", obj)
+
 	o, err := c.UpdateSwitchControllerStpInstance(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStpInstance resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerStpSettings() *schema.Resource {
 		Delete: resourceSwitchControllerStpSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,6 +104,8 @@ func resourceSwitchControllerStpSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerStpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stpsettings", obj)
+
 	o, err := c.UpdateSwitchControllerStpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerStpSettings resource: %v", err)
@@ -138,6 +140,8 @@ func resourceSwitchControllerStpSettingsDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerStpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_stpsettings", obj)
+
 	_, err = c.UpdateSwitchControllerStpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerStpSettings resource: %v", err)
@@ -54,7 +54,7 @@ func testAccCheckFortiOSSwitchControllerStpSettingsExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerStpSettings(i)
+		o, err := c.ReadSwitchControllerStpSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerStpSettings: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSwitchControllerStpSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerStpSettings(i)
+		o, err := c.ReadSwitchControllerStpSettings(i, "")
 
 		if err == nil {
 			if o != nil {
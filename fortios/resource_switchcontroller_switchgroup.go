@@ -26,7 +26,7 @@ func resourceSwitchControllerSwitchGroup() *schema.Resource {
 		Delete: resourceSwitchControllerSwitchGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,6 +100,8 @@ func resourceSwitchControllerSwitchGroupCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SwitchControllerSwitchGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchgroup", obj)
+
 	o, err := c.CreateSwitchControllerSwitchGroup(obj, vdomparam)
 
 	if err != nil {
@@ -133,6 +135,8 @@ func resourceSwitchControllerSwitchGroupUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerSwitchGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchgroup", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchGroup resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSwitchInterfaceTag() *schema.Resource {
 		Delete: resourceSwitchControllerSwitchInterfaceTagDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceSwitchControllerSwitchInterfaceTagCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating SwitchControllerSwitchInterfaceTag resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchinterfacetag", obj)
+
 	o, err := c.CreateSwitchControllerSwitchInterfaceTag(obj, vdomparam)
 
 	if err != nil {
@@ -95,6 +97,8 @@ func resourceSwitchControllerSwitchInterfaceTagUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerSwitchInterfaceTag resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchinterfacetag", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchInterfaceTag(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchInterfaceTag resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSSwitchControllerSwitchInterfaceTagExists(n string) resou
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchInterfaceTag(i)
+		o, err := c.ReadSwitchControllerSwitchInterfaceTag(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerSwitchInterfaceTag: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSwitchControllerSwitchInterfaceTagDestroy(s *terraform.State) e
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchInterfaceTag(i)
+		o, err := c.ReadSwitchControllerSwitchInterfaceTag(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSwitchControllerSwitchLog() *schema.Resource {
 		Delete: resourceSwitchControllerSwitchLogDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceSwitchControllerSwitchLogUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerSwitchLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchlog", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchLog resource: %v", err)
@@ -101,6 +103,8 @@ func resourceSwitchControllerSwitchLogDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerSwitchLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchlog", obj)
+
 	_, err = c.UpdateSwitchControllerSwitchLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerSwitchLog resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerSwitchLogExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchLog(i)
+		o, err := c.ReadSwitchControllerSwitchLog(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerSwitchLog: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerSwitchLogDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchLog(i)
+		o, err := c.ReadSwitchControllerSwitchLog(i, "")
 
 		if err == nil {
 			if o != nil {
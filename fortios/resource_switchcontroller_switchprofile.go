@@ -26,7 +26,7 @@ func resourceSwitchControllerSwitchProfile() *schema.Resource {
 		Delete: resourceSwitchControllerSwitchProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSwitchControllerSwitchProfileCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating SwitchControllerSwitchProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchprofile", obj)
+
 	o, err := c.CreateSwitchControllerSwitchProfile(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceSwitchControllerSwitchProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerSwitchProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_switchprofile", obj)
+
 	o, err := c.UpdateSwitchControllerSwitchProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSwitchProfile resource: %v", err)
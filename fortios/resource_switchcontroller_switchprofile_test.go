@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerSwitchProfileExists(n string) resource.T
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchProfile(i)
+		o, err := c.ReadSwitchControllerSwitchProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerSwitchProfile: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerSwitchProfileDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSwitchProfile(i)
+		o, err := c.ReadSwitchControllerSwitchProfile(i, "")
 
 		if err == nil {
 			if o != nil {
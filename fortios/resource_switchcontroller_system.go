@@ -26,7 +26,7 @@ func resourceSwitchControllerSystem() *schema.Resource {
 		Delete: resourceSwitchControllerSystemDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceSwitchControllerSystemUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSystem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_system", obj)
+
 	o, err := c.UpdateSwitchControllerSystem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSystem resource: %v", err)
@@ -145,6 +147,8 @@ func resourceSwitchControllerSystemDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SwitchControllerSystem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_system", obj)
+
 	_, err = c.UpdateSwitchControllerSystem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerSystem resource: %v", err)
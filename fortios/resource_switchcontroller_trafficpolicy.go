@@ -26,7 +26,7 @@ func resourceSwitchControllerTrafficPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerTrafficPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceSwitchControllerTrafficPolicyCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating SwitchControllerTrafficPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_trafficpolicy", obj)
+
 	o, err := c.CreateSwitchControllerTrafficPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceSwitchControllerTrafficPolicyUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating SwitchControllerTrafficPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_trafficpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerTrafficPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerTrafficPolicy resource: %v", err)
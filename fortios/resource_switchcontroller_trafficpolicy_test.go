@@ -53,7 +53,7 @@ func testAccCheckFortiOSSwitchControllerTrafficPolicyExists(n string) resource.T
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerTrafficPolicy(i)
+		o, err := c.ReadSwitchControllerTrafficPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerTrafficPolicy: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSwitchControllerTrafficPolicyDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerTrafficPolicy(i)
+		o, err := c.ReadSwitchControllerTrafficPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
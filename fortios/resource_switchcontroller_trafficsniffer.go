@@ -26,7 +26,7 @@ func resourceSwitchControllerTrafficSniffer() *schema.Resource {
 		Delete: resourceSwitchControllerTrafficSnifferDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -158,6 +158,8 @@ func resourceSwitchControllerTrafficSnifferUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SwitchControllerTrafficSniffer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_trafficsniffer", obj)
+
 	o, err := c.UpdateSwitchControllerTrafficSniffer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerTrafficSniffer resource: %v", err)
@@ -192,6 +194,8 @@ func resourceSwitchControllerTrafficSnifferDelete(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SwitchControllerTrafficSniffer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_trafficsniffer", obj)
+
 	_, err = c.UpdateSwitchControllerTrafficSniffer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerTrafficSniffer resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerVirtualPortPool() *schema.Resource {
 		Delete: resourceSwitchControllerVirtualPortPoolDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +69,8 @@ func resourceSwitchControllerVirtualPortPoolCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating SwitchControllerVirtualPortPool resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_virtualportpool", obj)
+
 	o, err := c.CreateSwitchControllerVirtualPortPool(obj, vdomparam)
 
 	if err != nil {
@@ -102,6 +104,8 @@ func resourceSwitchControllerVirtualPortPoolUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating SwitchControllerVirtualPortPool resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_virtualportpool", obj)
+
 	o, err := c.UpdateSwitchControllerVirtualPortPool(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVirtualPortPool resource: %v", err)
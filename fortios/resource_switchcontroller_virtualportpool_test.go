@@ -49,7 +49,7 @@ func testAccCheckFortiOSSwitchControllerVirtualPortPoolExists(n string) resource
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerVirtualPortPool(i)
+		o, err := c.ReadSwitchControllerVirtualPortPool(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerVirtualPortPool: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSwitchControllerVirtualPortPoolDestroy(s *terraform.State) erro
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerVirtualPortPool(i)
+		o, err := c.ReadSwitchControllerVirtualPortPool(i, "")
 
 		if err == nil {
 			if o != nil {
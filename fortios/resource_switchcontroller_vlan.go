@@ -26,7 +26,7 @@ func resourceSwitchControllerVlan() *schema.Resource {
 		Delete: resourceSwitchControllerVlanDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -167,6 +167,8 @@ func resourceSwitchControllerVlanCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating SwitchControllerVlan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_vlan", obj)
+
 	o, err := c.CreateSwitchControllerVlan(obj, vdomparam)
 
 	if err != nil {
@@ -200,6 +202,8 @@ func resourceSwitchControllerVlanUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SwitchControllerVlan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_vlan", obj)
+
 	o, err := c.UpdateSwitchControllerVlan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVlan resource: %v", err)
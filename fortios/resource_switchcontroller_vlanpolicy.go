@@ -26,7 +26,7 @@ func resourceSwitchControllerVlanPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerVlanPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,6 +124,8 @@ func resourceSwitchControllerVlanPolicyCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating SwitchControllerVlanPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_vlanpolicy", obj)
+
 	o, err := c.CreateSwitchControllerVlanPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -157,6 +159,8 @@ func resourceSwitchControllerVlanPolicyUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SwitchControllerVlanPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontroller_vlanpolicy", obj)
+
 	o, err := c.UpdateSwitchControllerVlanPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerVlanPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerAutoConfigCustom() *schema.Resource {
 		Delete: resourceSwitchControllerAutoConfigCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceSwitchControllerAutoConfigCustomCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating SwitchControllerAutoConfigCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_custom", obj)
+
 	o, err := c.CreateSwitchControllerAutoConfigCustom(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceSwitchControllerAutoConfigCustomUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_custom", obj)
+
 	o, err := c.UpdateSwitchControllerAutoConfigCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigCustom resource: %v", err)
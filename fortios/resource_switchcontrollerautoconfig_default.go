@@ -26,7 +26,7 @@ func resourceSwitchControllerAutoConfigDefault() *schema.Resource {
 		Delete: resourceSwitchControllerAutoConfigDefaultDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,6 +75,8 @@ func resourceSwitchControllerAutoConfigDefaultUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigDefault resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_default", obj)
+
 	o, err := c.UpdateSwitchControllerAutoConfigDefault(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigDefault resource: %v", err)
@@ -109,6 +111,8 @@ func resourceSwitchControllerAutoConfigDefaultDelete(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigDefault resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_default", obj)
+
 	_, err = c.UpdateSwitchControllerAutoConfigDefault(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerAutoConfigDefault resource: %v", err)
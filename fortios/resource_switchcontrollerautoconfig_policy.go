@@ -26,7 +26,7 @@ func resourceSwitchControllerAutoConfigPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerAutoConfigPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSwitchControllerAutoConfigPolicyCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating SwitchControllerAutoConfigPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_policy", obj)
+
 	o, err := c.CreateSwitchControllerAutoConfigPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -122,6 +124,8 @@ func resourceSwitchControllerAutoConfigPolicyUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerautoconfig_policy", obj)
+
 	o, err := c.UpdateSwitchControllerAutoConfigPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerAutoConfigPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerInitialConfigTemplate() *schema.Resource {
 		Delete: resourceSwitchControllerInitialConfigTemplateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSwitchControllerInitialConfigTemplateCreate(d *schema.ResourceData,
 		return fmt.Errorf("Error creating SwitchControllerInitialConfigTemplate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerinitialconfig_template", obj)
+
 	o, err := c.CreateSwitchControllerInitialConfigTemplate(obj, vdomparam)
 
 	if err != nil {
@@ -122,6 +124,8 @@ func resourceSwitchControllerInitialConfigTemplateUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigTemplate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerinitialconfig_template", obj)
+
 	o, err := c.UpdateSwitchControllerInitialConfigTemplate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigTemplate resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerInitialConfigVlans() *schema.Resource {
 		Delete: resourceSwitchControllerInitialConfigVlansDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -99,6 +99,8 @@ func resourceSwitchControllerInitialConfigVlansUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigVlans resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerinitialconfig_vlans", obj)
+
 	o, err := c.UpdateSwitchControllerInitialConfigVlans(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigVlans resource: %v", err)
@@ -133,6 +135,8 @@ func resourceSwitchControllerInitialConfigVlansDelete(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerInitialConfigVlans resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerinitialconfig_vlans", obj)
+
 	_, err = c.UpdateSwitchControllerInitialConfigVlans(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerInitialConfigVlans resource: %v", err)
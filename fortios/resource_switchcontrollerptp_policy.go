@@ -26,7 +26,7 @@ func resourceSwitchControllerPtpPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerPtpPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceSwitchControllerPtpPolicyCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating SwitchControllerPtpPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerptp_policy", obj)
+
 	o, err := c.CreateSwitchControllerPtpPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceSwitchControllerPtpPolicyUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SwitchControllerPtpPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerptp_policy", obj)
+
 	o, err := c.UpdateSwitchControllerPtpPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPtpPolicy resource: %v", err)
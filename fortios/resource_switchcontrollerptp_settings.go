@@ -26,7 +26,7 @@ func resourceSwitchControllerPtpSettings() *schema.Resource {
 		Delete: resourceSwitchControllerPtpSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,6 +62,8 @@ func resourceSwitchControllerPtpSettingsUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerPtpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerptp_settings", obj)
+
 	o, err := c.UpdateSwitchControllerPtpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerPtpSettings resource: %v", err)
@@ -96,6 +98,8 @@ func resourceSwitchControllerPtpSettingsDelete(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerPtpSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerptp_settings", obj)
+
 	_, err = c.UpdateSwitchControllerPtpSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SwitchControllerPtpSettings resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerQosDot1PMap() *schema.Resource {
 		Delete: resourceSwitchControllerQosDot1PMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceSwitchControllerQosDot1PMapCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SwitchControllerQosDot1PMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_dot1pmap", obj)
+
 	o, err := c.CreateSwitchControllerQosDot1PMap(obj, vdomparam)
 
 	if err != nil {
@@ -146,6 +148,8 @@ func resourceSwitchControllerQosDot1PMapUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SwitchControllerQosDot1PMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_dot1pmap", obj)
+
 	o, err := c.UpdateSwitchControllerQosDot1PMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosDot1PMap resource: %v", err)
@@ -56,7 +56,7 @@ func testAccCheckFortiOSSwitchControllerQosDot1PMapExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosDot1PMap(i)
+		o, err := c.ReadSwitchControllerQosDot1PMap(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerQosDot1PMap: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckSwitchControllerQosDot1PMapDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosDot1PMap(i)
+		o, err := c.ReadSwitchControllerQosDot1PMap(i, "")
 
 		if err == nil {
 			if o != nil {
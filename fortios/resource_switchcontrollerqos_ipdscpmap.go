@@ -26,7 +26,7 @@ func resourceSwitchControllerQosIpDscpMap() *schema.Resource {
 		Delete: resourceSwitchControllerQosIpDscpMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +108,8 @@ func resourceSwitchControllerQosIpDscpMapCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating SwitchControllerQosIpDscpMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_ipdscpmap", obj)
+
 	o, err := c.CreateSwitchControllerQosIpDscpMap(obj, vdomparam)
 
 	if err != nil {
@@ -141,6 +143,8 @@ func resourceSwitchControllerQosIpDscpMapUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerQosIpDscpMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_ipdscpmap", obj)
+
 	o, err := c.UpdateSwitchControllerQosIpDscpMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosIpDscpMap resource: %v", err)
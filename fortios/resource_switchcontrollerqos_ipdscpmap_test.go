@@ -52,7 +52,7 @@ func testAccCheckFortiOSSwitchControllerQosIpDscpMapExists(n string) resource.Te
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosIpDscpMap(i)
+		o, err := c.ReadSwitchControllerQosIpDscpMap(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerQosIpDscpMap: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckSwitchControllerQosIpDscpMapDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosIpDscpMap(i)
+		o, err := c.ReadSwitchControllerQosIpDscpMap(i, "")
 
 		if err == nil {
 			if o != nil {
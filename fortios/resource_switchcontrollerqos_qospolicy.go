@@ -26,7 +26,7 @@ func resourceSwitchControllerQosQosPolicy() *schema.Resource {
 		Delete: resourceSwitchControllerQosQosPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceSwitchControllerQosQosPolicyCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating SwitchControllerQosQosPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_qospolicy", obj)
+
 	o, err := c.CreateSwitchControllerQosQosPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -118,6 +120,8 @@ func resourceSwitchControllerQosQosPolicyUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SwitchControllerQosQosPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_qospolicy", obj)
+
 	o, err := c.UpdateSwitchControllerQosQosPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosQosPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerQosQueuePolicy() *schema.Resource {
 		Delete: resourceSwitchControllerQosQueuePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,6 +130,8 @@ func resourceSwitchControllerQosQueuePolicyCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error creating SwitchControllerQosQueuePolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_queuepolicy", obj)
+
 	o, err := c.CreateSwitchControllerQosQueuePolicy(obj, vdomparam)
 
 	if err != nil {
@@ -163,6 +165,8 @@ func resourceSwitchControllerQosQueuePolicyUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SwitchControllerQosQueuePolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollerqos_queuepolicy", obj)
+
 	o, err := c.UpdateSwitchControllerQosQueuePolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerQosQueuePolicy resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSwitchControllerQosQueuePolicyExists(n string) resource.
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosQueuePolicy(i)
+		o, err := c.ReadSwitchControllerQosQueuePolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerQosQueuePolicy: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSwitchControllerQosQueuePolicyDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerQosQueuePolicy(i)
+		o, err := c.ReadSwitchControllerQosQueuePolicy(i, "")
 
 		if err == nil {
 			if o != nil {
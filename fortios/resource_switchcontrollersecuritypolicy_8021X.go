@@ -26,7 +26,7 @@ func resourceSwitchControllerSecurityPolicy8021X() *schema.Resource {
 		Delete: resourceSwitchControllerSecurityPolicy8021XDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -179,6 +179,8 @@ func resourceSwitchControllerSecurityPolicy8021XCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating SwitchControllerSecurityPolicy8021X resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_8021X", obj)
+
 	o, err := c.CreateSwitchControllerSecurityPolicy8021X(obj, vdomparam)
 
 	if err != nil {
@@ -212,6 +214,8 @@ func resourceSwitchControllerSecurityPolicy8021XUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicy8021X resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_8021X", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicy8021X(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicy8021X resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSSwitchControllerSecurityPolicy8021XExists(n string) reso
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSecurityPolicy8021X(i)
+		o, err := c.ReadSwitchControllerSecurityPolicy8021X(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SwitchControllerSecurityPolicy8021X: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckSwitchControllerSecurityPolicy8021XDestroy(s *terraform.State)
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSwitchControllerSecurityPolicy8021X(i)
+		o, err := c.ReadSwitchControllerSecurityPolicy8021X(i, "")
 
 		if err == nil {
 			if o != nil {
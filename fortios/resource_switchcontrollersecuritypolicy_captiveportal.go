@@ -26,7 +26,7 @@ func resourceSwitchControllerSecurityPolicyCaptivePortal() *schema.Resource {
 		Delete: resourceSwitchControllerSecurityPolicyCaptivePortalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSwitchControllerSecurityPolicyCaptivePortalCreate(d *schema.Resourc
 		return fmt.Errorf("Error creating SwitchControllerSecurityPolicyCaptivePortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_captiveportal", obj)
+
 	o, err := c.CreateSwitchControllerSecurityPolicyCaptivePortal(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceSwitchControllerSecurityPolicyCaptivePortalUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyCaptivePortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_captiveportal", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicyCaptivePortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyCaptivePortal resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSwitchControllerSecurityPolicyLocalAccess() *schema.Resource {
 		Delete: resourceSwitchControllerSecurityPolicyLocalAccessDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSwitchControllerSecurityPolicyLocalAccessCreate(d *schema.ResourceD
 		return fmt.Errorf("Error creating SwitchControllerSecurityPolicyLocalAccess resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_localaccess", obj)
+
 	o, err := c.CreateSwitchControllerSecurityPolicyLocalAccess(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSwitchControllerSecurityPolicyLocalAccessUpdate(d *schema.ResourceD
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyLocalAccess resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_switchcontrollersecuritypolicy_localaccess", obj)
+
 	o, err := c.UpdateSwitchControllerSecurityPolicyLocalAccess(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SwitchControllerSecurityPolicyLocalAccess resource: %v", err)
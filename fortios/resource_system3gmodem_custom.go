@@ -26,7 +26,7 @@ func resourceSystem3GModemCustom() *schema.Resource {
 		Delete: resourceSystem3GModemCustomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system.3g-modem/custom", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceSystem3GModemCustomCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating System3GModemCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system3gmodem_custom", obj)
+
 	o, err := c.CreateSystem3GModemCustom(obj, vdomparam)
 
 	if err != nil {
@@ -134,6 +136,8 @@ func resourceSystem3GModemCustomUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating System3GModemCustom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system3gmodem_custom", obj)
+
 	o, err := c.UpdateSystem3GModemCustom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating System3GModemCustom resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemAccprofile() *schema.Resource {
 		Delete: resourceSystemAccprofileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -333,6 +333,8 @@ func resourceSystemAccprofileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemAccprofile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_accprofile", obj)
+
 	o, err := c.CreateSystemAccprofile(obj, vdomparam)
 
 	if err != nil {
@@ -366,6 +368,8 @@ func resourceSystemAccprofileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemAccprofile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_accprofile", obj)
+
 	o, err := c.UpdateSystemAccprofile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAccprofile resource: %v", err)
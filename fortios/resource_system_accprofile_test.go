@@ -87,7 +87,7 @@ func testAccCheckFortiOSSystemAccprofileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAccprofile(i)
+		o, err := c.ReadSystemAccprofile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAccprofile: %s", err)
@@ -110,7 +110,7 @@ func testAccCheckSystemAccprofileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAccprofile(i)
+		o, err := c.ReadSystemAccprofile(i, "")
 
 		if err == nil {
 			if o != nil {
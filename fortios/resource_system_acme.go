@@ -26,7 +26,7 @@ func resourceSystemAcme() *schema.Resource {
 		Delete: resourceSystemAcmeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -120,6 +120,8 @@ func resourceSystemAcmeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAcme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_acme", obj)
+
 	o, err := c.UpdateSystemAcme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAcme resource: %v", err)
@@ -154,6 +156,8 @@ func resourceSystemAcmeDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAcme resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_acme", obj)
+
 	_, err = c.UpdateSystemAcme(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAcme resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemAdmin() *schema.Resource {
 		Delete: resourceSystemAdminDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -585,6 +585,8 @@ func resourceSystemAdminCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_admin", obj)
+
 	o, err := c.CreateSystemAdmin(obj, vdomparam)
 
 	if err != nil {
@@ -618,6 +620,8 @@ func resourceSystemAdminUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_admin", obj)
+
 	o, err := c.UpdateSystemAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAdmin resource: %v", err)
@@ -62,7 +62,7 @@ func testAccCheckFortiOSSystemAdminExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAdmin(i)
+		o, err := c.ReadSystemAdmin(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAdmin: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckSystemAdminDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAdmin(i)
+		o, err := c.ReadSystemAdmin(i, "")
 
 		if err == nil {
 			if o != nil {
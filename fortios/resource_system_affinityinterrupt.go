@@ -26,7 +26,7 @@ func resourceSystemAffinityInterrupt() *schema.Resource {
 		Delete: resourceSystemAffinityInterruptDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/affinity-interrupt", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,6 +71,8 @@ func resourceSystemAffinityInterruptCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemAffinityInterrupt resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_affinityinterrupt", obj)
+
 	o, err := c.CreateSystemAffinityInterrupt(obj, vdomparam)
 
 	if err != nil {
@@ -104,6 +106,8 @@ func resourceSystemAffinityInterruptUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemAffinityInterrupt resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_affinityinterrupt", obj)
+
 	o, err := c.UpdateSystemAffinityInterrupt(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAffinityInterrupt resource: %v", err)
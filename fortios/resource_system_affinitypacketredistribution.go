@@ -26,7 +26,7 @@ func resourceSystemAffinityPacketRedistribution() *schema.Resource {
 		Delete: resourceSystemAffinityPacketRedistributionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/affinity-packet-redistribution", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +76,8 @@ func resourceSystemAffinityPacketRedistributionCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating SystemAffinityPacketRedistribution resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_affinitypacketredistribution", obj)
+
 	o, err := c.CreateSystemAffinityPacketRedistribution(obj, vdomparam)
 
 	if err != nil {
@@ -109,6 +111,8 @@ func resourceSystemAffinityPacketRedistributionUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating SystemAffinityPacketRedistribution resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_affinitypacketredistribution", obj)
+
 	o, err := c.UpdateSystemAffinityPacketRedistribution(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAffinityPacketRedistribution resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemAlarm() *schema.Resource {
 		Delete: resourceSystemAlarmDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -196,6 +196,8 @@ func resourceSystemAlarmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAlarm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_alarm", obj)
+
 	o, err := c.UpdateSystemAlarm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAlarm resource: %v", err)
@@ -230,6 +232,8 @@ func resourceSystemAlarmDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAlarm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_alarm", obj)
+
 	_, err = c.UpdateSystemAlarm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAlarm resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemAlias() *schema.Resource {
 		Delete: resourceSystemAliasDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceSystemAliasCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemAlias resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_alias", obj)
+
 	o, err := c.CreateSystemAlias(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceSystemAliasUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemAlias resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_alias", obj)
+
 	o, err := c.UpdateSystemAlias(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAlias resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSSystemAliasExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAlias(i)
+		o, err := c.ReadSystemAlias(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAlias: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSystemAliasDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAlias(i)
+		o, err := c.ReadSystemAlias(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemApiUser() *schema.Resource {
 		Delete: resourceSystemApiUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -149,6 +149,8 @@ func resourceSystemApiUserCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemApiUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_apiuser", obj)
+
 	o, err := c.CreateSystemApiUser(obj, vdomparam)
 
 	if err != nil {
@@ -182,6 +184,8 @@ func resourceSystemApiUserUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemApiUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_apiuser", obj)
+
 	o, err := c.UpdateSystemApiUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemApiUser resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSSystemApiUserExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemApiUser(i)
+		o, err := c.ReadSystemApiUser(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemApiUser: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckSystemApiUserDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemApiUser(i)
+		o, err := c.ReadSystemApiUser(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemArpTable() *schema.Resource {
 		Delete: resourceSystemArpTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/arp-table", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSystemArpTableCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemArpTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_arptable", obj)
+
 	o, err := c.CreateSystemArpTable(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceSystemArpTableUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemArpTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_arptable", obj)
+
 	o, err := c.UpdateSystemArpTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemArpTable resource: %v", err)
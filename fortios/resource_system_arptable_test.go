@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemArpTableExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemArpTable(i)
+		o, err := c.ReadSystemArpTable(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemArpTable: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemArpTableDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemArpTable(i)
+		o, err := c.ReadSystemArpTable(i, "")
 
 		if err == nil {
 			if o != nil {
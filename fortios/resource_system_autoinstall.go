@@ -26,7 +26,7 @@ func resourceSystemAutoInstall() *schema.Resource {
 		Delete: resourceSystemAutoInstallDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemAutoInstallUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemAutoInstall resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_autoinstall", obj)
+
 	o, err := c.UpdateSystemAutoInstall(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoInstall resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemAutoInstallDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemAutoInstall resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_autoinstall", obj)
+
 	_, err = c.UpdateSystemAutoInstall(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAutoInstall resource: %v", err)
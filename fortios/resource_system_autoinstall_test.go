@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemAutoInstallExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoInstall(i)
+		o, err := c.ReadSystemAutoInstall(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutoInstall: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemAutoInstallDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoInstall(i)
+		o, err := c.ReadSystemAutoInstall(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemAutomationAction() *schema.Resource {
 		Delete: resourceSystemAutomationActionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -376,6 +376,8 @@ func resourceSystemAutomationActionCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemAutomationAction resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationaction", obj)
+
 	o, err := c.CreateSystemAutomationAction(obj, vdomparam)
 
 	if err != nil {
@@ -409,6 +411,8 @@ func resourceSystemAutomationActionUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemAutomationAction resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationaction", obj)
+
 	o, err := c.UpdateSystemAutomationAction(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationAction resource: %v", err)
@@ -56,7 +56,7 @@ func testAccCheckFortiOSSystemAutomationActionExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationAction(i)
+		o, err := c.ReadSystemAutomationAction(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutomationAction: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckSystemAutomationActionDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationAction(i)
+		o, err := c.ReadSystemAutomationAction(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemAutomationDestination() *schema.Resource {
 		Delete: resourceSystemAutomationDestinationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceSystemAutomationDestinationCreate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error creating SystemAutomationDestination resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationdestination", obj)
+
 	o, err := c.CreateSystemAutomationDestination(obj, vdomparam)
 
 	if err != nil {
@@ -126,6 +128,8 @@ func resourceSystemAutomationDestinationUpdate(d *schema.ResourceData, m interfa
 		return fmt.Errorf("Error updating SystemAutomationDestination resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationdestination", obj)
+
 	o, err := c.UpdateSystemAutomationDestination(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationDestination resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemAutomationDestinationExists(n string) resource.Tes
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationDestination(i)
+		o, err := c.ReadSystemAutomationDestination(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutomationDestination: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemAutomationDestinationDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationDestination(i)
+		o, err := c.ReadSystemAutomationDestination(i, "")
 
 		if err == nil {
 			if o != nil {
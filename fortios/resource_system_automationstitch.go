@@ -26,7 +26,7 @@ func resourceSystemAutomationStitch() *schema.Resource {
 		Delete: resourceSystemAutomationStitchDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -140,6 +140,8 @@ func resourceSystemAutomationStitchCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemAutomationStitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationstitch", obj)
+
 	o, err := c.CreateSystemAutomationStitch(obj, vdomparam)
 
 	if err != nil {
@@ -173,6 +175,8 @@ func resourceSystemAutomationStitchUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemAutomationStitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationstitch", obj)
+
 	o, err := c.UpdateSystemAutomationStitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationStitch resource: %v", err)
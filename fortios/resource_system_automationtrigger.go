@@ -26,7 +26,7 @@ func resourceSystemAutomationTrigger() *schema.Resource {
 		Delete: resourceSystemAutomationTriggerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -200,6 +200,8 @@ func resourceSystemAutomationTriggerCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemAutomationTrigger resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationtrigger", obj)
+
 	o, err := c.CreateSystemAutomationTrigger(obj, vdomparam)
 
 	if err != nil {
@@ -233,6 +235,8 @@ func resourceSystemAutomationTriggerUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemAutomationTrigger resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_automationtrigger", obj)
+
 	o, err := c.UpdateSystemAutomationTrigger(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutomationTrigger resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSSystemAutomationTriggerExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationTrigger(i)
+		o, err := c.ReadSystemAutomationTrigger(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutomationTrigger: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckSystemAutomationTriggerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutomationTrigger(i)
+		o, err := c.ReadSystemAutomationTrigger(i, "")
 
 		if err == nil {
 			if o != nil {
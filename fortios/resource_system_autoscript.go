@@ -26,7 +26,7 @@ func resourceSystemAutoScript() *schema.Resource {
 		Delete: resourceSystemAutoScriptDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceSystemAutoScriptCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemAutoScript resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_autoscript", obj)
+
 	o, err := c.CreateSystemAutoScript(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceSystemAutoScriptUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemAutoScript resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_autoscript", obj)
+
 	o, err := c.UpdateSystemAutoScript(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoScript resource: %v", err)
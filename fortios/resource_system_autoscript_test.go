@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemAutoScriptExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoScript(i)
+		o, err := c.ReadSystemAutoScript(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutoScript: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemAutoScriptDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoScript(i)
+		o, err := c.ReadSystemAutoScript(i, "")
 
 		if err == nil {
 			if o != nil {
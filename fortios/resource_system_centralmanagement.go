@@ -26,7 +26,7 @@ func resourceSystemCentralManagement() *schema.Resource {
 		Delete: resourceSystemCentralManagementDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -204,6 +204,8 @@ func resourceSystemCentralManagementUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemCentralManagement resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_centralmanagement", obj)
+
 	o, err := c.UpdateSystemCentralManagement(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCentralManagement resource: %v", err)
@@ -238,6 +240,8 @@ func resourceSystemCentralManagementDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemCentralManagement resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_centralmanagement", obj)
+
 	_, err = c.UpdateSystemCentralManagement(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemCentralManagement resource: %v", err)
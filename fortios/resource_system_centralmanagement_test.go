@@ -60,7 +60,7 @@ func testAccCheckFortiOSSystemCentralManagementExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemCentralManagement(i)
+		o, err := c.ReadSystemCentralManagement(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemCentralManagement: %s", err)
@@ -83,7 +83,7 @@ func testAccCheckSystemCentralManagementDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemCentralManagement(i)
+		o, err := c.ReadSystemCentralManagement(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemClusterSync() *schema.Resource {
 		Delete: resourceSystemClusterSyncDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/cluster-sync", "sync-id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -214,6 +214,8 @@ func resourceSystemClusterSyncCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SystemClusterSync resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_clustersync", obj)
+
 	o, err := c.CreateSystemClusterSync(obj, vdomparam)
 
 	if err != nil {
@@ -247,6 +249,8 @@ func resourceSystemClusterSyncUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemClusterSync resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_clustersync", obj)
+
 	o, err := c.UpdateSystemClusterSync(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemClusterSync resource: %v", err)
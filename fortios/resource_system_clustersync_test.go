@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemClusterSyncExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemClusterSync(i)
+		o, err := c.ReadSystemClusterSync(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemClusterSync: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemClusterSyncDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemClusterSync(i)
+		o, err := c.ReadSystemClusterSync(i, "")
 
 		if err == nil {
 			if o != nil {
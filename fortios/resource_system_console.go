@@ -26,7 +26,7 @@ func resourceSystemConsole() *schema.Resource {
 		Delete: resourceSystemConsoleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceSystemConsoleUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemConsole resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_console", obj)
+
 	o, err := c.UpdateSystemConsole(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemConsole resource: %v", err)
@@ -116,6 +118,8 @@ func resourceSystemConsoleDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemConsole resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_console", obj)
+
 	_, err = c.UpdateSystemConsole(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemConsole resource: %v", err)
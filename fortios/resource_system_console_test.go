@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemConsoleExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemConsole(i)
+		o, err := c.ReadSystemConsole(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemConsole: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemConsoleDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemConsole(i)
+		o, err := c.ReadSystemConsole(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemCsf() *schema.Resource {
 		Delete: resourceSystemCsfDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -288,6 +288,8 @@ func resourceSystemCsfUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemCsf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_csf", obj)
+
 	o, err := c.UpdateSystemCsf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCsf resource: %v", err)
@@ -322,6 +324,8 @@ func resourceSystemCsfDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemCsf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_csf", obj)
+
 	_, err = c.UpdateSystemCsf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemCsf resource: %v", err)
@@ -54,7 +54,7 @@ func testAccCheckFortiOSSystemCsfExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemCsf(i)
+		o, err := c.ReadSystemCsf(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemCsf: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSystemCsfDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemCsf(i)
+		o, err := c.ReadSystemCsf(i, "")
 
 		if err == nil {
 			if o != nil {
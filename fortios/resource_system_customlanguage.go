@@ -26,7 +26,7 @@ func resourceSystemCustomLanguage() *schema.Resource {
 		Delete: resourceSystemCustomLanguageDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceSystemCustomLanguageCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating SystemCustomLanguage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_customlanguage", obj)
+
 	o, err := c.CreateSystemCustomLanguage(obj, vdomparam)
 
 	if err != nil {
@@ -105,6 +107,8 @@ func resourceSystemCustomLanguageUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemCustomLanguage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_customlanguage", obj)
+
 	o, err := c.UpdateSystemCustomLanguage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemCustomLanguage resource: %v", err)
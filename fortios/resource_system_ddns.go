@@ -26,7 +26,7 @@ func resourceSystemDdns() *schema.Resource {
 		Delete: resourceSystemDdnsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/ddns", "ddnsid"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -194,6 +194,8 @@ func resourceSystemDdnsCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemDdns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ddns", obj)
+
 	o, err := c.CreateSystemDdns(obj, vdomparam)
 
 	if err != nil {
@@ -227,6 +229,8 @@ func resourceSystemDdnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDdns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ddns", obj)
+
 	o, err := c.UpdateSystemDdns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDdns resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSSystemDdnsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDdns(i)
+		o, err := c.ReadSystemDdns(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDdns: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckSystemDdnsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDdns(i)
+		o, err := c.ReadSystemDdns(i, "")
 
 		if err == nil {
 			if o != nil {
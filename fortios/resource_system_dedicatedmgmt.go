@@ -26,7 +26,7 @@ func resourceSystemDedicatedMgmt() *schema.Resource {
 		Delete: resourceSystemDedicatedMgmtDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceSystemDedicatedMgmtUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemDedicatedMgmt resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dedicatedmgmt", obj)
+
 	o, err := c.UpdateSystemDedicatedMgmt(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDedicatedMgmt resource: %v", err)
@@ -127,6 +129,8 @@ func resourceSystemDedicatedMgmtDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemDedicatedMgmt resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dedicatedmgmt", obj)
+
 	_, err = c.UpdateSystemDedicatedMgmt(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemDedicatedMgmt resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemDns() *schema.Resource {
 		Delete: resourceSystemDnsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +189,8 @@ func resourceSystemDnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dns", obj)
+
 	o, err := c.UpdateSystemDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDns resource: %v", err)
@@ -223,6 +225,8 @@ func resourceSystemDnsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dns", obj)
+
 	_, err = c.UpdateSystemDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemDns resource: %v", err)
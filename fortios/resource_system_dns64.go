@@ -26,7 +26,7 @@ func resourceSystemDns64() *schema.Resource {
 		Delete: resourceSystemDns64Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceSystemDns64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dns64", obj)
+
 	o, err := c.UpdateSystemDns64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDns64 resource: %v", err)
@@ -106,6 +108,8 @@ func resourceSystemDns64Delete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemDns64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dns64", obj)
+
 	_, err = c.UpdateSystemDns64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemDns64 resource: %v", err)
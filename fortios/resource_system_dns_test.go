@@ -57,7 +57,7 @@ func testAccCheckFortiOSSystemDnsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDns(i)
+		o, err := c.ReadSystemDns(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDns: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckSystemDnsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDns(i)
+		o, err := c.ReadSystemDns(i, "")
 
 		if err == nil {
 			if o != nil {
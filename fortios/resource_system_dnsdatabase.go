@@ -26,7 +26,7 @@ func resourceSystemDnsDatabase() *schema.Resource {
 		Delete: resourceSystemDnsDatabaseDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -192,6 +192,8 @@ func resourceSystemDnsDatabaseCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SystemDnsDatabase resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dnsdatabase", obj)
+
 	o, err := c.CreateSystemDnsDatabase(obj, vdomparam)
 
 	if err != nil {
@@ -225,6 +227,8 @@ func resourceSystemDnsDatabaseUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemDnsDatabase resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dnsdatabase", obj)
+
 	o, err := c.UpdateSystemDnsDatabase(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDnsDatabase resource: %v", err)
@@ -62,7 +62,7 @@ func testAccCheckFortiOSSystemDnsDatabaseExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDnsDatabase(i)
+		o, err := c.ReadSystemDnsDatabase(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDnsDatabase: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckSystemDnsDatabaseDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDnsDatabase(i)
+		o, err := c.ReadSystemDnsDatabase(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemDnsServer() *schema.Resource {
 		Delete: resourceSystemDnsServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemDnsServerCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemDnsServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dnsserver", obj)
+
 	o, err := c.CreateSystemDnsServer(obj, vdomparam)
 
 	if err != nil {
@@ -112,6 +114,8 @@ func resourceSystemDnsServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemDnsServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dnsserver", obj)
+
 	o, err := c.UpdateSystemDnsServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDnsServer resource: %v", err)
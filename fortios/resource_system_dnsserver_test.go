@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemDnsServerExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDnsServer(i)
+		o, err := c.ReadSystemDnsServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDnsServer: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemDnsServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDnsServer(i)
+		o, err := c.ReadSystemDnsServer(i, "")
 
 		if err == nil {
 			if o != nil {
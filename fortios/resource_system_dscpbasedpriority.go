@@ -26,7 +26,7 @@ func resourceSystemDscpBasedPriority() *schema.Resource {
 		Delete: resourceSystemDscpBasedPriorityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/dscp-based-priority", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemDscpBasedPriorityCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemDscpBasedPriority resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dscpbasedpriority", obj)
+
 	o, err := c.CreateSystemDscpBasedPriority(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemDscpBasedPriorityUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemDscpBasedPriority resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_dscpbasedpriority", obj)
+
 	o, err := c.UpdateSystemDscpBasedPriority(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDscpBasedPriority resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemDscpBasedPriorityExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDscpBasedPriority(i)
+		o, err := c.ReadSystemDscpBasedPriority(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDscpBasedPriority: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemDscpBasedPriorityDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDscpBasedPriority(i)
+		o, err := c.ReadSystemDscpBasedPriority(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemEmailServer() *schema.Resource {
 		Delete: resourceSystemEmailServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,6 +133,8 @@ func resourceSystemEmailServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemEmailServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_emailserver", obj)
+
 	o, err := c.UpdateSystemEmailServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemEmailServer resource: %v", err)
@@ -167,6 +169,8 @@ func resourceSystemEmailServerDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemEmailServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_emailserver", obj)
+
 	_, err = c.UpdateSystemEmailServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemEmailServer resource: %v", err)
@@ -56,7 +56,7 @@ func testAccCheckFortiOSSystemEmailServerExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemEmailServer(i)
+		o, err := c.ReadSystemEmailServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemEmailServer: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckSystemEmailServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemEmailServer(i)
+		o, err := c.ReadSystemEmailServer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemExternalResource() *schema.Resource {
 		Delete: resourceSystemExternalResourceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -132,6 +132,8 @@ func resourceSystemExternalResourceCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemExternalResource resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_externalresource", obj)
+
 	o, err := c.CreateSystemExternalResource(obj, vdomparam)
 
 	if err != nil {
@@ -165,6 +167,8 @@ func resourceSystemExternalResourceUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemExternalResource resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_externalresource", obj)
+
 	o, err := c.UpdateSystemExternalResource(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemExternalResource resource: %v", err)
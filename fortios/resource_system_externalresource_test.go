@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemExternalResourceExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemExternalResource(i)
+		o, err := c.ReadSystemExternalResource(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemExternalResource: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemExternalResourceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemExternalResource(i)
+		o, err := c.ReadSystemExternalResource(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemFederatedUpgrade() *schema.Resource {
 		Delete: resourceSystemFederatedUpgradeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -134,6 +134,8 @@ func resourceSystemFederatedUpgradeUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemFederatedUpgrade resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_federatedupgrade", obj)
+
 	o, err := c.UpdateSystemFederatedUpgrade(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFederatedUpgrade resource: %v", err)
@@ -168,6 +170,8 @@ func resourceSystemFederatedUpgradeDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemFederatedUpgrade resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_federatedupgrade", obj)
+
 	_, err = c.UpdateSystemFederatedUpgrade(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFederatedUpgrade resource: %v", err)
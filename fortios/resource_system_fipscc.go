@@ -26,7 +26,7 @@ func resourceSystemFipsCc() *schema.Resource {
 		Delete: resourceSystemFipsCcDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceSystemFipsCcUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFipsCc resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fipscc", obj)
+
 	o, err := c.UpdateSystemFipsCc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFipsCc resource: %v", err)
@@ -112,6 +114,8 @@ func resourceSystemFipsCcDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFipsCc resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fipscc", obj)
+
 	_, err = c.UpdateSystemFipsCc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFipsCc resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemFipsCcExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFipsCc(i)
+		o, err := c.ReadSystemFipsCc(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFipsCc: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemFipsCcDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFipsCc(i)
+		o, err := c.ReadSystemFipsCc(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemFm() *schema.Resource {
 		Delete: resourceSystemFmDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceSystemFmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fm", obj)
+
 	o, err := c.UpdateSystemFm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFm resource: %v", err)
@@ -128,6 +130,8 @@ func resourceSystemFmDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fm", obj)
+
 	_, err = c.UpdateSystemFm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFm resource: %v", err)
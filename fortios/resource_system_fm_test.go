@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemFmExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFm(i)
+		o, err := c.ReadSystemFm(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFm: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemFmDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFm(i)
+		o, err := c.ReadSystemFm(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemFortiai() *schema.Resource {
 		Delete: resourceSystemFortiaiDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemFortiaiUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFortiai resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortiai", obj)
+
 	o, err := c.UpdateSystemFortiai(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortiai resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemFortiaiDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFortiai resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortiai", obj)
+
 	_, err = c.UpdateSystemFortiai(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFortiai resource: %v", err)
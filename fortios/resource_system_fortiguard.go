@@ -26,7 +26,7 @@ func resourceSystemFortiguard() *schema.Resource {
 		Delete: resourceSystemFortiguardDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -332,6 +332,8 @@ func resourceSystemFortiguardUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemFortiguard resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortiguard", obj)
+
 	o, err := c.UpdateSystemFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortiguard resource: %v", err)
@@ -366,6 +368,8 @@ func resourceSystemFortiguardDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemFortiguard resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortiguard", obj)
+
 	_, err = c.UpdateSystemFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFortiguard resource: %v", err)
@@ -77,7 +77,7 @@ func testAccCheckFortiOSSystemFortiguardExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortiguard(i)
+		o, err := c.ReadSystemFortiguard(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFortiguard: %s", err)
@@ -100,7 +100,7 @@ func testAccCheckSystemFortiguardDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortiguard(i)
+		o, err := c.ReadSystemFortiguard(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemFortimanager() *schema.Resource {
 		Delete: resourceSystemFortimanagerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceSystemFortimanagerUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortimanager resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortimanager", obj)
+
 	o, err := c.UpdateSystemFortimanager(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortimanager resource: %v", err)
@@ -127,6 +129,8 @@ func resourceSystemFortimanagerDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortimanager resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortimanager", obj)
+
 	_, err = c.UpdateSystemFortimanager(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFortimanager resource: %v", err)
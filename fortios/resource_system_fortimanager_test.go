@@ -54,7 +54,7 @@ func testAccCheckFortiOSSystemFortimanagerExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortimanager(i)
+		o, err := c.ReadSystemFortimanager(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFortimanager: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSystemFortimanagerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortimanager(i)
+		o, err := c.ReadSystemFortimanager(i, "")
 
 		if err == nil {
 			if o != nil {
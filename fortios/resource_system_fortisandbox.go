@@ -26,7 +26,7 @@ func resourceSystemFortisandbox() *schema.Resource {
 		Delete: resourceSystemFortisandboxDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceSystemFortisandboxUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortisandbox resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortisandbox", obj)
+
 	o, err := c.UpdateSystemFortisandbox(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFortisandbox resource: %v", err)
@@ -140,6 +142,8 @@ func resourceSystemFortisandboxDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemFortisandbox resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fortisandbox", obj)
+
 	_, err = c.UpdateSystemFortisandbox(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFortisandbox resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemFortisandboxExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortisandbox(i)
+		o, err := c.ReadSystemFortisandbox(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFortisandbox: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemFortisandboxDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFortisandbox(i)
+		o, err := c.ReadSystemFortisandbox(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemFssoPolling() *schema.Resource {
 		Delete: resourceSystemFssoPollingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemFssoPollingUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemFssoPolling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fssopolling", obj)
+
 	o, err := c.UpdateSystemFssoPolling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFssoPolling resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemFssoPollingDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemFssoPolling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_fssopolling", obj)
+
 	_, err = c.UpdateSystemFssoPolling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFssoPolling resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemFssoPollingExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFssoPolling(i)
+		o, err := c.ReadSystemFssoPolling(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFssoPolling: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemFssoPollingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFssoPolling(i)
+		o, err := c.ReadSystemFssoPolling(i, "")
 
 		if err == nil {
 			if o != nil {
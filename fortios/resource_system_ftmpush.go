@@ -26,7 +26,7 @@ func resourceSystemFtmPush() *schema.Resource {
 		Delete: resourceSystemFtmPushDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceSystemFtmPushUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFtmPush resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ftmpush", obj)
+
 	o, err := c.UpdateSystemFtmPush(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemFtmPush resource: %v", err)
@@ -119,6 +121,8 @@ func resourceSystemFtmPushDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemFtmPush resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ftmpush", obj)
+
 	_, err = c.UpdateSystemFtmPush(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemFtmPush resource: %v", err)
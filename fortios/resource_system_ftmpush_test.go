@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemFtmPushExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFtmPush(i)
+		o, err := c.ReadSystemFtmPush(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemFtmPush: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemFtmPushDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemFtmPush(i)
+		o, err := c.ReadSystemFtmPush(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemGeneve() *schema.Resource {
 		Delete: resourceSystemGeneveDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceSystemGeneveCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemGeneve resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geneve", obj)
+
 	o, err := c.CreateSystemGeneve(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceSystemGeneveUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemGeneve resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geneve", obj)
+
 	o, err := c.UpdateSystemGeneve(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeneve resource: %v", err)
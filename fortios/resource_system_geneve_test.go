@@ -54,7 +54,7 @@ func testAccCheckFortiOSSystemGeneveExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGeneve(i)
+		o, err := c.ReadSystemGeneve(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemGeneve: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSystemGeneveDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGeneve(i)
+		o, err := c.ReadSystemGeneve(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemGeoipCountry() *schema.Resource {
 		Delete: resourceSystemGeoipCountryDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +69,8 @@ func resourceSystemGeoipCountryCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating SystemGeoipCountry resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geoipcountry", obj)
+
 	o, err := c.CreateSystemGeoipCountry(obj, vdomparam)
 
 	if err != nil {
@@ -102,6 +104,8 @@ func resourceSystemGeoipCountryUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemGeoipCountry resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geoipcountry", obj)
+
 	o, err := c.UpdateSystemGeoipCountry(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeoipCountry resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemGeoipOverride() *schema.Resource {
 		Delete: resourceSystemGeoipOverrideDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,6 +127,8 @@ func resourceSystemGeoipOverrideCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemGeoipOverride resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geoipoverride", obj)
+
 	o, err := c.CreateSystemGeoipOverride(obj, vdomparam)
 
 	if err != nil {
@@ -160,6 +162,8 @@ func resourceSystemGeoipOverrideUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemGeoipOverride resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_geoipoverride", obj)
+
 	o, err := c.UpdateSystemGeoipOverride(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGeoipOverride resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemGlobal() *schema.Resource {
 		Delete: resourceSystemGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1331,6 +1331,8 @@ func resourceSystemGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_global", obj)
+
 	o, err := c.UpdateSystemGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGlobal resource: %v", err)
@@ -1365,6 +1367,8 @@ func resourceSystemGlobalDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_global", obj)
+
 	_, err = c.UpdateSystemGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemGlobal resource: %v", err)
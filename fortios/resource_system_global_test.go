@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemGlobalExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGlobal(i)
+		o, err := c.ReadSystemGlobal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemGlobal: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemGlobalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGlobal(i)
+		o, err := c.ReadSystemGlobal(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemGreTunnel() *schema.Resource {
 		Delete: resourceSystemGreTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -149,6 +149,8 @@ func resourceSystemGreTunnelCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemGreTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_gretunnel", obj)
+
 	o, err := c.CreateSystemGreTunnel(obj, vdomparam)
 
 	if err != nil {
@@ -182,6 +184,8 @@ func resourceSystemGreTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemGreTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_gretunnel", obj)
+
 	o, err := c.UpdateSystemGreTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemGreTunnel resource: %v", err)
@@ -63,7 +63,7 @@ func testAccCheckFortiOSSystemGreTunnelExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGreTunnel(i)
+		o, err := c.ReadSystemGreTunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemGreTunnel: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckSystemGreTunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemGreTunnel(i)
+		o, err := c.ReadSystemGreTunnel(i, "")
 
 		if err == nil {
 			if o != nil {
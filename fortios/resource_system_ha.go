@@ -26,7 +26,7 @@ func resourceSystemHa() *schema.Resource {
 		Delete: resourceSystemHaDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -583,6 +583,8 @@ func resourceSystemHaUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemHa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ha", obj)
+
 	o, err := c.UpdateSystemHa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemHa resource: %v", err)
@@ -617,6 +619,8 @@ func resourceSystemHaDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemHa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ha", obj)
+
 	_, err = c.UpdateSystemHa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemHa resource: %v", err)
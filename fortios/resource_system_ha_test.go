@@ -80,7 +80,7 @@ func testAccCheckFortiOSSystemHaExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemHa(i)
+		o, err := c.ReadSystemHa(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemHa: %s", err)
@@ -103,7 +103,7 @@ func testAccCheckSystemHaDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemHa(i)
+		o, err := c.ReadSystemHa(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemHaMonitor() *schema.Resource {
 		Delete: resourceSystemHaMonitorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSystemHaMonitorUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemHaMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_hamonitor", obj)
+
 	o, err := c.UpdateSystemHaMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemHaMonitor resource: %v", err)
@@ -108,6 +110,8 @@ func resourceSystemHaMonitorDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemHaMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_hamonitor", obj)
+
 	_, err = c.UpdateSystemHaMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemHaMonitor resource: %v", err)
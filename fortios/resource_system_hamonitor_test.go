@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemHaMonitorExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemHaMonitor(i)
+		o, err := c.ReadSystemHaMonitor(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemHaMonitor: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemHaMonitorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemHaMonitor(i)
+		o, err := c.ReadSystemHaMonitor(i, "")
 
 		if err == nil {
 			if o != nil {
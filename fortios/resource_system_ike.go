@@ -26,7 +26,7 @@ func resourceSystemIke() *schema.Resource {
 		Delete: resourceSystemIkeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -520,6 +520,8 @@ func resourceSystemIkeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIke resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ike", obj)
+
 	o, err := c.UpdateSystemIke(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIke resource: %v", err)
@@ -554,6 +556,8 @@ func resourceSystemIkeDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIke resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ike", obj)
+
 	_, err = c.UpdateSystemIke(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemIke resource: %v", err)
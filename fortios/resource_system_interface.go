@@ -26,7 +26,7 @@ func resourceSystemInterface() *schema.Resource {
 		Delete: resourceSystemInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1845,6 +1845,8 @@ func resourceSystemInterfaceCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_interface", obj)
+
 	var o map[string]interface{}
 	v, _ := d.GetOk("type")
 	ag, _ := d.GetOk("autogenerated")
@@ -1885,6 +1887,8 @@ func resourceSystemInterfaceUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_interface", obj)
+
 	o, err := c.UpdateSystemInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemInterface resource: %v", err)
@@ -60,7 +60,7 @@ func testAccCheckFortiOSSystemInterfaceExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemInterface(i)
+		o, err := c.ReadSystemInterface(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemInterface: %s", err)
@@ -83,7 +83,7 @@ func testAccCheckSystemInterfaceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemInterface(i)
+		o, err := c.ReadSystemInterface(i, "")
 
 		if err == nil {
 			if o != nil {
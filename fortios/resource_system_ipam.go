@@ -26,7 +26,7 @@ func resourceSystemIpam() *schema.Resource {
 		Delete: resourceSystemIpamDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceSystemIpamUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIpam resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipam", obj)
+
 	o, err := c.UpdateSystemIpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpam resource: %v", err)
@@ -106,6 +108,8 @@ func resourceSystemIpamDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIpam resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipam", obj)
+
 	_, err = c.UpdateSystemIpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemIpam resource: %v", err)
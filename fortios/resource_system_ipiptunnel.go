@@ -26,7 +26,7 @@ func resourceSystemIpipTunnel() *schema.Resource {
 		Delete: resourceSystemIpipTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceSystemIpipTunnelCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemIpipTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipiptunnel", obj)
+
 	o, err := c.CreateSystemIpipTunnel(obj, vdomparam)
 
 	if err != nil {
@@ -119,6 +121,8 @@ func resourceSystemIpipTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemIpipTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipiptunnel", obj)
+
 	o, err := c.UpdateSystemIpipTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpipTunnel resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemIpipTunnelExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpipTunnel(i)
+		o, err := c.ReadSystemIpipTunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemIpipTunnel: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemIpipTunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpipTunnel(i)
+		o, err := c.ReadSystemIpipTunnel(i, "")
 
 		if err == nil {
 			if o != nil {
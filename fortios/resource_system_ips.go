@@ -26,7 +26,7 @@ func resourceSystemIps() *schema.Resource {
 		Delete: resourceSystemIpsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceSystemIpsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIps resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ips", obj)
+
 	o, err := c.UpdateSystemIps(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIps resource: %v", err)
@@ -101,6 +103,8 @@ func resourceSystemIpsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemIps resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ips", obj)
+
 	_, err = c.UpdateSystemIps(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemIps resource: %v", err)
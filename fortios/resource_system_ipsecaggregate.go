@@ -26,7 +26,7 @@ func resourceSystemIpsecAggregate() *schema.Resource {
 		Delete: resourceSystemIpsecAggregateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceSystemIpsecAggregateCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating SystemIpsecAggregate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsecaggregate", obj)
+
 	o, err := c.CreateSystemIpsecAggregate(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceSystemIpsecAggregateUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemIpsecAggregate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsecaggregate", obj)
+
 	o, err := c.UpdateSystemIpsecAggregate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsecAggregate resource: %v", err)
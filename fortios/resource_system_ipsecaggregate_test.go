@@ -54,7 +54,7 @@ func testAccCheckFortiOSSystemIpsecAggregateExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpsecAggregate(i)
+		o, err := c.ReadSystemIpsecAggregate(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemIpsecAggregate: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSystemIpsecAggregateDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpsecAggregate(i)
+		o, err := c.ReadSystemIpsecAggregate(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemIpsUrlfilterDns() *schema.Resource {
 		Delete: resourceSystemIpsUrlfilterDnsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceSystemIpsUrlfilterDnsCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemIpsUrlfilterDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsurlfilterdns", obj)
+
 	o, err := c.CreateSystemIpsUrlfilterDns(obj, vdomparam)
 
 	if err != nil {
@@ -105,6 +107,8 @@ func resourceSystemIpsUrlfilterDnsUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsurlfilterdns", obj)
+
 	o, err := c.UpdateSystemIpsUrlfilterDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns resource: %v", err)
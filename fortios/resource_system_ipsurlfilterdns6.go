@@ -26,7 +26,7 @@ func resourceSystemIpsUrlfilterDns6() *schema.Resource {
 		Delete: resourceSystemIpsUrlfilterDns6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,6 +67,8 @@ func resourceSystemIpsUrlfilterDns6Create(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemIpsUrlfilterDns6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsurlfilterdns6", obj)
+
 	o, err := c.CreateSystemIpsUrlfilterDns6(obj, vdomparam)
 
 	if err != nil {
@@ -100,6 +102,8 @@ func resourceSystemIpsUrlfilterDns6Update(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipsurlfilterdns6", obj)
+
 	o, err := c.UpdateSystemIpsUrlfilterDns6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpsUrlfilterDns6 resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemIpv6NeighborCache() *schema.Resource {
 		Delete: resourceSystemIpv6NeighborCacheDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/ipv6-neighbor-cache", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSystemIpv6NeighborCacheCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemIpv6NeighborCache resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipv6neighborcache", obj)
+
 	o, err := c.CreateSystemIpv6NeighborCache(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceSystemIpv6NeighborCacheUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemIpv6NeighborCache resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipv6neighborcache", obj)
+
 	o, err := c.UpdateSystemIpv6NeighborCache(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpv6NeighborCache resource: %v", err)
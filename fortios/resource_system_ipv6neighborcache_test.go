@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemIpv6NeighborCacheExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpv6NeighborCache(i)
+		o, err := c.ReadSystemIpv6NeighborCache(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemIpv6NeighborCache: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemIpv6NeighborCacheDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpv6NeighborCache(i)
+		o, err := c.ReadSystemIpv6NeighborCache(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemIpv6Tunnel() *schema.Resource {
 		Delete: resourceSystemIpv6TunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,6 +88,8 @@ func resourceSystemIpv6TunnelCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemIpv6Tunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipv6tunnel", obj)
+
 	o, err := c.CreateSystemIpv6Tunnel(obj, vdomparam)
 
 	if err != nil {
@@ -121,6 +123,8 @@ func resourceSystemIpv6TunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemIpv6Tunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ipv6tunnel", obj)
+
 	o, err := c.UpdateSystemIpv6Tunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemIpv6Tunnel resource: %v", err)
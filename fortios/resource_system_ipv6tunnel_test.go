@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemIpv6TunnelExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpv6Tunnel(i)
+		o, err := c.ReadSystemIpv6Tunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemIpv6Tunnel: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemIpv6TunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemIpv6Tunnel(i)
+		o, err := c.ReadSystemIpv6Tunnel(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemLinkMonitor() *schema.Resource {
 		Delete: resourceSystemLinkMonitorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -292,6 +292,8 @@ func resourceSystemLinkMonitorCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SystemLinkMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_linkmonitor", obj)
+
 	o, err := c.CreateSystemLinkMonitor(obj, vdomparam)
 
 	if err != nil {
@@ -325,6 +327,8 @@ func resourceSystemLinkMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemLinkMonitor resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_linkmonitor", obj)
+
 	o, err := c.UpdateSystemLinkMonitor(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLinkMonitor resource: %v", err)
@@ -68,7 +68,7 @@ func testAccCheckFortiOSSystemLinkMonitorExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemLinkMonitor(i)
+		o, err := c.ReadSystemLinkMonitor(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemLinkMonitor: %s", err)
@@ -91,7 +91,7 @@ func testAccCheckSystemLinkMonitorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemLinkMonitor(i)
+		o, err := c.ReadSystemLinkMonitor(i, "")
 
 		if err == nil {
 			if o != nil {
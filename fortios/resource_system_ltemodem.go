@@ -26,7 +26,7 @@ func resourceSystemLteModem() *schema.Resource {
 		Delete: resourceSystemLteModemDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceSystemLteModemUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemLteModem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ltemodem", obj)
+
 	o, err := c.UpdateSystemLteModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLteModem resource: %v", err)
@@ -147,6 +149,8 @@ func resourceSystemLteModemDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemLteModem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ltemodem", obj)
+
 	_, err = c.UpdateSystemLteModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemLteModem resource: %v", err)
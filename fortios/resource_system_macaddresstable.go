@@ -26,7 +26,7 @@ func resourceSystemMacAddressTable() *schema.Resource {
 		Delete: resourceSystemMacAddressTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,6 +71,8 @@ func resourceSystemMacAddressTableCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemMacAddressTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_macaddresstable", obj)
+
 	o, err := c.CreateSystemMacAddressTable(obj, vdomparam)
 
 	if err != nil {
@@ -104,6 +106,8 @@ func resourceSystemMacAddressTableUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemMacAddressTable resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_macaddresstable", obj)
+
 	o, err := c.UpdateSystemMacAddressTable(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemMacAddressTable resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemManagementTunnel() *schema.Resource {
 		Delete: resourceSystemManagementTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,6 +92,8 @@ func resourceSystemManagementTunnelUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemManagementTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_managementtunnel", obj)
+
 	o, err := c.UpdateSystemManagementTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemManagementTunnel resource: %v", err)
@@ -126,6 +128,8 @@ func resourceSystemManagementTunnelDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemManagementTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_managementtunnel", obj)
+
 	_, err = c.UpdateSystemManagementTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemManagementTunnel resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemManagementTunnelExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemManagementTunnel(i)
+		o, err := c.ReadSystemManagementTunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemManagementTunnel: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemManagementTunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemManagementTunnel(i)
+		o, err := c.ReadSystemManagementTunnel(i, "")
 
 		if err == nil {
 			if o != nil {
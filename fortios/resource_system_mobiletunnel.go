@@ -26,7 +26,7 @@ func resourceSystemMobileTunnel() *schema.Resource {
 		Delete: resourceSystemMobileTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -153,6 +153,8 @@ func resourceSystemMobileTunnelCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating SystemMobileTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_mobiletunnel", obj)
+
 	o, err := c.CreateSystemMobileTunnel(obj, vdomparam)
 
 	if err != nil {
@@ -186,6 +188,8 @@ func resourceSystemMobileTunnelUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemMobileTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_mobiletunnel", obj)
+
 	o, err := c.UpdateSystemMobileTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemMobileTunnel resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSSystemMobileTunnelExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemMobileTunnel(i)
+		o, err := c.ReadSystemMobileTunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemMobileTunnel: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckSystemMobileTunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemMobileTunnel(i)
+		o, err := c.ReadSystemMobileTunnel(i, "")
 
 		if err == nil {
 			if o != nil {
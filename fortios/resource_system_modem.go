@@ -26,7 +26,7 @@ func resourceSystemModem() *schema.Resource {
 		Delete: resourceSystemModemDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -308,6 +308,8 @@ func resourceSystemModemUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemModem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_modem", obj)
+
 	o, err := c.UpdateSystemModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemModem resource: %v", err)
@@ -342,6 +344,8 @@ func resourceSystemModemDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemModem resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_modem", obj)
+
 	_, err = c.UpdateSystemModem(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemModem resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemNat64() *schema.Resource {
 		Delete: resourceSystemNat64Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -110,6 +110,8 @@ func resourceSystemNat64Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNat64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_nat64", obj)
+
 	o, err := c.UpdateSystemNat64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNat64 resource: %v", err)
@@ -144,6 +146,8 @@ func resourceSystemNat64Delete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNat64 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_nat64", obj)
+
 	_, err = c.UpdateSystemNat64(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNat64 resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemNat64Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNat64(i)
+		o, err := c.ReadSystemNat64(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemNat64: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemNat64Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNat64(i)
+		o, err := c.ReadSystemNat64(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemNdProxy() *schema.Resource {
 		Delete: resourceSystemNdProxyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,6 +81,8 @@ func resourceSystemNdProxyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNdProxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ndproxy", obj)
+
 	o, err := c.UpdateSystemNdProxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNdProxy resource: %v", err)
@@ -115,6 +117,8 @@ func resourceSystemNdProxyDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNdProxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ndproxy", obj)
+
 	_, err = c.UpdateSystemNdProxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNdProxy resource: %v", err)
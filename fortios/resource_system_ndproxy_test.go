@@ -48,7 +48,7 @@ func testAccCheckFortiOSSystemNdProxyExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNdProxy(i)
+		o, err := c.ReadSystemNdProxy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemNdProxy: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSystemNdProxyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNdProxy(i)
+		o, err := c.ReadSystemNdProxy(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemNetflow() *schema.Resource {
 		Delete: resourceSystemNetflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +108,8 @@ func resourceSystemNetflowUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNetflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_netflow", obj)
+
 	o, err := c.UpdateSystemNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNetflow resource: %v", err)
@@ -142,6 +144,8 @@ func resourceSystemNetflowDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNetflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_netflow", obj)
+
 	_, err = c.UpdateSystemNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNetflow resource: %v", err)
@@ -54,7 +54,7 @@ func testAccCheckFortiOSSystemNetflowExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNetflow(i)
+		o, err := c.ReadSystemNetflow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemNetflow: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckSystemNetflowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNetflow(i)
+		o, err := c.ReadSystemNetflow(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemNetworkVisibility() *schema.Resource {
 		Delete: resourceSystemNetworkVisibilityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSystemNetworkVisibilityUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemNetworkVisibility resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_networkvisibility", obj)
+
 	o, err := c.UpdateSystemNetworkVisibility(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNetworkVisibility resource: %v", err)
@@ -123,6 +125,8 @@ func resourceSystemNetworkVisibilityDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemNetworkVisibility resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_networkvisibility", obj)
+
 	_, err = c.UpdateSystemNetworkVisibility(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNetworkVisibility resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemNetworkVisibilityExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNetworkVisibility(i)
+		o, err := c.ReadSystemNetworkVisibility(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemNetworkVisibility: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemNetworkVisibilityDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNetworkVisibility(i)
+		o, err := c.ReadSystemNetworkVisibility(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemNpu() *schema.Resource {
 		Delete: resourceSystemNpuDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +172,8 @@ func resourceSystemNpuUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNpu resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_npu", obj)
+
 	o, err := c.UpdateSystemNpu(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNpu resource: %v", err)
@@ -206,6 +208,8 @@ func resourceSystemNpuDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNpu resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_npu", obj)
+
 	_, err = c.UpdateSystemNpu(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNpu resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemNtp() *schema.Resource {
 		Delete: resourceSystemNtpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -179,6 +179,8 @@ func resourceSystemNtpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ntp", obj)
+
 	o, err := c.UpdateSystemNtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemNtp resource: %v", err)
@@ -213,6 +215,8 @@ func resourceSystemNtpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemNtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ntp", obj)
+
 	_, err = c.UpdateSystemNtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemNtp resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemNtpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNtp(i)
+		o, err := c.ReadSystemNtp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemNtp: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemNtpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemNtp(i)
+		o, err := c.ReadSystemNtp(i, "")
 
 		if err == nil {
 			if o != nil {
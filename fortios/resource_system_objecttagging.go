@@ -26,7 +26,7 @@ func resourceSystemObjectTagging() *schema.Resource {
 		Delete: resourceSystemObjectTaggingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +108,8 @@ func resourceSystemObjectTaggingCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemObjectTagging resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_objecttagging", obj)
+
 	o, err := c.CreateSystemObjectTagging(obj, vdomparam)
 
 	if err != nil {
@@ -141,6 +143,8 @@ func resourceSystemObjectTaggingUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemObjectTagging resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_objecttagging", obj)
+
 	o, err := c.UpdateSystemObjectTagging(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemObjectTagging resource: %v", err)
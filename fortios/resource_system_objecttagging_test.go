@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemObjectTaggingExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemObjectTagging(i)
+		o, err := c.ReadSystemObjectTagging(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemObjectTagging: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemObjectTaggingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemObjectTagging(i)
+		o, err := c.ReadSystemObjectTagging(i, "")
 
 		if err == nil {
 			if o != nil {
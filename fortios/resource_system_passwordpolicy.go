@@ -26,7 +26,7 @@ func resourceSystemPasswordPolicy() *schema.Resource {
 		Delete: resourceSystemPasswordPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,6 +124,8 @@ func resourceSystemPasswordPolicyUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPasswordPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_passwordpolicy", obj)
+
 	o, err := c.UpdateSystemPasswordPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPasswordPolicy resource: %v", err)
@@ -158,6 +160,8 @@ func resourceSystemPasswordPolicyDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPasswordPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_passwordpolicy", obj)
+
 	_, err = c.UpdateSystemPasswordPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemPasswordPolicy resource: %v", err)
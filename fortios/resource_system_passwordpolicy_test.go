@@ -58,7 +58,7 @@ func testAccCheckFortiOSSystemPasswordPolicyExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPasswordPolicy(i)
+		o, err := c.ReadSystemPasswordPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemPasswordPolicy: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckSystemPasswordPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPasswordPolicy(i)
+		o, err := c.ReadSystemPasswordPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
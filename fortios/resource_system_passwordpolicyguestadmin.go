@@ -26,7 +26,7 @@ func resourceSystemPasswordPolicyGuestAdmin() *schema.Resource {
 		Delete: resourceSystemPasswordPolicyGuestAdminDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,6 +124,8 @@ func resourceSystemPasswordPolicyGuestAdminUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SystemPasswordPolicyGuestAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_passwordpolicyguestadmin", obj)
+
 	o, err := c.UpdateSystemPasswordPolicyGuestAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPasswordPolicyGuestAdmin resource: %v", err)
@@ -158,6 +160,8 @@ func resourceSystemPasswordPolicyGuestAdminDelete(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating SystemPasswordPolicyGuestAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_passwordpolicyguestadmin", obj)
+
 	_, err = c.UpdateSystemPasswordPolicyGuestAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemPasswordPolicyGuestAdmin resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSSystemPasswordPolicyGuestAdminExists(n string) resource.
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPasswordPolicyGuestAdmin(i)
+		o, err := c.ReadSystemPasswordPolicyGuestAdmin(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemPasswordPolicyGuestAdmin: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckSystemPasswordPolicyGuestAdminDestroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPasswordPolicyGuestAdmin(i)
+		o, err := c.ReadSystemPasswordPolicyGuestAdmin(i, "")
 
 		if err == nil {
 			if o != nil {
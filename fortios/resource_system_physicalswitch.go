@@ -26,7 +26,7 @@ func resourceSystemPhysicalSwitch() *schema.Resource {
 		Delete: resourceSystemPhysicalSwitchDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemPhysicalSwitchCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating SystemPhysicalSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_physicalswitch", obj)
+
 	o, err := c.CreateSystemPhysicalSwitch(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemPhysicalSwitchUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPhysicalSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_physicalswitch", obj)
+
 	o, err := c.UpdateSystemPhysicalSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPhysicalSwitch resource: %v", err)
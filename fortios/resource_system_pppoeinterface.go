@@ -26,7 +26,7 @@ func resourceSystemPppoeInterface() *schema.Resource {
 		Delete: resourceSystemPppoeInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,6 +142,8 @@ func resourceSystemPppoeInterfaceCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating SystemPppoeInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_pppoeinterface", obj)
+
 	o, err := c.CreateSystemPppoeInterface(obj, vdomparam)
 
 	if err != nil {
@@ -175,6 +177,8 @@ func resourceSystemPppoeInterfaceUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemPppoeInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_pppoeinterface", obj)
+
 	o, err := c.UpdateSystemPppoeInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPppoeInterface resource: %v", err)
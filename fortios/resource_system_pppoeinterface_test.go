@@ -59,7 +59,7 @@ func testAccCheckFortiOSSystemPppoeInterfaceExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPppoeInterface(i)
+		o, err := c.ReadSystemPppoeInterface(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemPppoeInterface: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckSystemPppoeInterfaceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPppoeInterface(i)
+		o, err := c.ReadSystemPppoeInterface(i, "")
 
 		if err == nil {
 			if o != nil {
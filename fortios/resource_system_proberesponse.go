@@ -26,7 +26,7 @@ func resourceSystemProbeResponse() *schema.Resource {
 		Delete: resourceSystemProbeResponseDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceSystemProbeResponseUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemProbeResponse resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_proberesponse", obj)
+
 	o, err := c.UpdateSystemProbeResponse(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemProbeResponse resource: %v", err)
@@ -130,6 +132,8 @@ func resourceSystemProbeResponseDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemProbeResponse resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_proberesponse", obj)
+
 	_, err = c.UpdateSystemProbeResponse(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemProbeResponse resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemProbeResponseExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemProbeResponse(i)
+		o, err := c.ReadSystemProbeResponse(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemProbeResponse: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemProbeResponseDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemProbeResponse(i)
+		o, err := c.ReadSystemProbeResponse(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemProxyArp() *schema.Resource {
 		Delete: resourceSystemProxyArpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/proxy-arp", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,6 +75,8 @@ func resourceSystemProxyArpCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemProxyArp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_proxyarp", obj)
+
 	o, err := c.CreateSystemProxyArp(obj, vdomparam)
 
 	if err != nil {
@@ -108,6 +110,8 @@ func resourceSystemProxyArpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemProxyArp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_proxyarp", obj)
+
 	o, err := c.UpdateSystemProxyArp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemProxyArp resource: %v", err)
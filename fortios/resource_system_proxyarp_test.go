@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemProxyArpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemProxyArp(i)
+		o, err := c.ReadSystemProxyArp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemProxyArp: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemProxyArpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemProxyArp(i)
+		o, err := c.ReadSystemProxyArp(i, "")
 
 		if err == nil {
 			if o != nil {
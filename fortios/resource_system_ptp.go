@@ -26,7 +26,7 @@ func resourceSystemPtp() *schema.Resource {
 		Delete: resourceSystemPtpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -117,6 +117,8 @@ func resourceSystemPtpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemPtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ptp", obj)
+
 	o, err := c.UpdateSystemPtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemPtp resource: %v", err)
@@ -151,6 +153,8 @@ func resourceSystemPtpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemPtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ptp", obj)
+
 	_, err = c.UpdateSystemPtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemPtp resource: %v", err)
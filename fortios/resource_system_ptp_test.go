@@ -52,7 +52,7 @@ func testAccCheckFortiOSSystemPtpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPtp(i)
+		o, err := c.ReadSystemPtp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemPtp: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckSystemPtpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemPtp(i)
+		o, err := c.ReadSystemPtp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgGroup() *schema.Resource {
 		Delete: resourceSystemReplacemsgGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -628,6 +628,8 @@ func resourceSystemReplacemsgGroupCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemReplacemsgGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_replacemsggroup", obj)
+
 	o, err := c.CreateSystemReplacemsgGroup(obj, vdomparam)
 
 	if err != nil {
@@ -661,6 +663,8 @@ func resourceSystemReplacemsgGroupUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_replacemsggroup", obj)
+
 	o, err := c.UpdateSystemReplacemsgGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgGroup resource: %v", err)
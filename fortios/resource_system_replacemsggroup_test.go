@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemReplacemsgGroupExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgGroup(i)
+		o, err := c.ReadSystemReplacemsgGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemReplacemsgGroup: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemReplacemsgGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgGroup(i)
+		o, err := c.ReadSystemReplacemsgGroup(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgImage() *schema.Resource {
 		Delete: resourceSystemReplacemsgImageDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemReplacemsgImageCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemReplacemsgImage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_replacemsgimage", obj)
+
 	o, err := c.CreateSystemReplacemsgImage(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemReplacemsgImageUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgImage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_replacemsgimage", obj)
+
 	o, err := c.UpdateSystemReplacemsgImage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgImage resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemReplacemsgImageExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgImage(i)
+		o, err := c.ReadSystemReplacemsgImage(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemReplacemsgImage: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemReplacemsgImageDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgImage(i)
+		o, err := c.ReadSystemReplacemsgImage(i, "")
 
 		if err == nil {
 			if o != nil {
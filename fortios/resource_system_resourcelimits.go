@@ -26,7 +26,7 @@ func resourceSystemResourceLimits() *schema.Resource {
 		Delete: resourceSystemResourceLimitsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -147,6 +147,8 @@ func resourceSystemResourceLimitsUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemResourceLimits resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_resourcelimits", obj)
+
 	o, err := c.UpdateSystemResourceLimits(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemResourceLimits resource: %v", err)
@@ -181,6 +183,8 @@ func resourceSystemResourceLimitsDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemResourceLimits resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_resourcelimits", obj)
+
 	_, err = c.UpdateSystemResourceLimits(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemResourceLimits resource: %v", err)
@@ -65,7 +65,7 @@ func testAccCheckFortiOSSystemResourceLimitsExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemResourceLimits(i)
+		o, err := c.ReadSystemResourceLimits(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemResourceLimits: %s", err)
@@ -88,7 +88,7 @@ func testAccCheckSystemResourceLimitsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemResourceLimits(i)
+		o, err := c.ReadSystemResourceLimits(i, "")
 
 		if err == nil {
 			if o != nil {
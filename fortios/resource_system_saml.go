@@ -26,7 +26,7 @@ func resourceSystemSaml() *schema.Resource {
 		Delete: resourceSystemSamlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -250,6 +250,8 @@ func resourceSystemSamlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSaml resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_saml", obj)
+
 	o, err := c.UpdateSystemSaml(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSaml resource: %v", err)
@@ -284,6 +286,8 @@ func resourceSystemSamlDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSaml resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_saml", obj)
+
 	_, err = c.UpdateSystemSaml(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSaml resource: %v", err)
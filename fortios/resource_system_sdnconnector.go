@@ -26,7 +26,7 @@ func resourceSystemSdnConnector() *schema.Resource {
 		Delete: resourceSystemSdnConnectorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -517,6 +517,8 @@ func resourceSystemSdnConnectorCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating SystemSdnConnector resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sdnconnector", obj)
+
 	o, err := c.CreateSystemSdnConnector(obj, vdomparam)
 
 	if err != nil {
@@ -550,6 +552,8 @@ func resourceSystemSdnConnectorUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemSdnConnector resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sdnconnector", obj)
+
 	o, err := c.UpdateSystemSdnConnector(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSdnConnector resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSSystemSdnConnectorExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSdnConnector(i)
+		o, err := c.ReadSystemSdnConnector(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSdnConnector: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckSystemSdnConnectorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSdnConnector(i)
+		o, err := c.ReadSystemSdnConnector(i, "")
 
 		if err == nil {
 			if o != nil {
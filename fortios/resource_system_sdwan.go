@@ -26,7 +26,7 @@ func resourceSystemSdwan() *schema.Resource {
 		Delete: resourceSystemSdwanDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1120,6 +1120,8 @@ func resourceSystemSdwanUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSdwan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sdwan", obj)
+
 	o, err := c.UpdateSystemSdwan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSdwan resource: %v", err)
@@ -1154,6 +1156,8 @@ func resourceSystemSdwanDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSdwan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sdwan", obj)
+
 	_, err = c.UpdateSystemSdwan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSdwan resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemSessionHelper() *schema.Resource {
 		Delete: resourceSystemSessionHelperDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/session-helper", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +76,8 @@ func resourceSystemSessionHelperCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemSessionHelper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sessionhelper", obj)
+
 	o, err := c.CreateSystemSessionHelper(obj, vdomparam)
 
 	if err != nil {
@@ -109,6 +111,8 @@ func resourceSystemSessionHelperUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemSessionHelper resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sessionhelper", obj)
+
 	o, err := c.UpdateSystemSessionHelper(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSessionHelper resource: %v", err)
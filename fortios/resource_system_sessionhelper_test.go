@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemSessionHelperExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSessionHelper(i)
+		o, err := c.ReadSystemSessionHelper(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSessionHelper: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemSessionHelperDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSessionHelper(i)
+		o, err := c.ReadSystemSessionHelper(i, "")
 
 		if err == nil {
 			if o != nil {
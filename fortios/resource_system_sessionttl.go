@@ -26,7 +26,7 @@ func resourceSystemSessionTtl() *schema.Resource {
 		Delete: resourceSystemSessionTtlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,6 +104,8 @@ func resourceSystemSessionTtlUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSessionTtl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sessionttl", obj)
+
 	o, err := c.UpdateSystemSessionTtl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSessionTtl resource: %v", err)
@@ -138,6 +140,8 @@ func resourceSystemSessionTtlDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSessionTtl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sessionttl", obj)
+
 	_, err = c.UpdateSystemSessionTtl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSessionTtl resource: %v", err)
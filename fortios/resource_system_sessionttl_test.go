@@ -48,7 +48,7 @@ func testAccCheckFortiOSSystemSessionTtlExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSessionTtl(i)
+		o, err := c.ReadSystemSessionTtl(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSessionTtl: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckSystemSessionTtlDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSessionTtl(i)
+		o, err := c.ReadSystemSessionTtl(i, "")
 
 		if err == nil {
 			if o != nil {
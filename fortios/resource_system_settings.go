@@ -26,7 +26,7 @@ func resourceSystemSettings() *schema.Resource {
 		Delete: resourceSystemSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -741,6 +741,8 @@ func resourceSystemSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_settings", obj)
+
 	o, err := c.UpdateSystemSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSettings resource: %v", err)
@@ -775,6 +777,8 @@ func resourceSystemSettingsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_settings", obj)
+
 	_, err = c.UpdateSystemSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSettings resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSSystemSettingsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSettings(i)
+		o, err := c.ReadSystemSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSettings: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckSystemSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSettings(i)
+		o, err := c.ReadSystemSettings(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemSflow() *schema.Resource {
 		Delete: resourceSystemSflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,6 +83,8 @@ func resourceSystemSflowUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sflow", obj)
+
 	o, err := c.UpdateSystemSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSflow resource: %v", err)
@@ -117,6 +119,8 @@ func resourceSystemSflowDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sflow", obj)
+
 	_, err = c.UpdateSystemSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSflow resource: %v", err)
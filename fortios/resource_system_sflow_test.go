@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemSflowExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSflow(i)
+		o, err := c.ReadSystemSflow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSflow: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemSflowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSflow(i)
+		o, err := c.ReadSystemSflow(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemSitTunnel() *schema.Resource {
 		Delete: resourceSystemSitTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceSystemSitTunnelCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemSitTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sittunnel", obj)
+
 	o, err := c.CreateSystemSitTunnel(obj, vdomparam)
 
 	if err != nil {
@@ -126,6 +128,8 @@ func resourceSystemSitTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSitTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_sittunnel", obj)
+
 	o, err := c.UpdateSystemSitTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSitTunnel resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSSystemSitTunnelExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSitTunnel(i)
+		o, err := c.ReadSystemSitTunnel(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSitTunnel: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckSystemSitTunnelDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSitTunnel(i)
+		o, err := c.ReadSystemSitTunnel(i, "")
 
 		if err == nil {
 			if o != nil {
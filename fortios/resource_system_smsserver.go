@@ -26,7 +26,7 @@ func resourceSystemSmsServer() *schema.Resource {
 		Delete: resourceSystemSmsServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceSystemSmsServerCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemSmsServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_smsserver", obj)
+
 	o, err := c.CreateSystemSmsServer(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceSystemSmsServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemSmsServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_smsserver", obj)
+
 	o, err := c.UpdateSystemSmsServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSmsServer resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSystemSmsServerExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSmsServer(i)
+		o, err := c.ReadSystemSmsServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSmsServer: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSystemSmsServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSmsServer(i)
+		o, err := c.ReadSystemSmsServer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemSpeedTestSchedule() *schema.Resource {
 		Delete: resourceSystemSpeedTestScheduleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -137,6 +137,8 @@ func resourceSystemSpeedTestScheduleCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemSpeedTestSchedule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_speedtestschedule", obj)
+
 	o, err := c.CreateSystemSpeedTestSchedule(obj, vdomparam)
 
 	if err != nil {
@@ -170,6 +172,8 @@ func resourceSystemSpeedTestScheduleUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemSpeedTestSchedule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_speedtestschedule", obj)
+
 	o, err := c.UpdateSystemSpeedTestSchedule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSpeedTestSchedule resource: %v", err)
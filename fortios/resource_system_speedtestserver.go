@@ -26,7 +26,7 @@ func resourceSystemSpeedTestServer() *schema.Resource {
 		Delete: resourceSystemSpeedTestServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -109,6 +109,8 @@ func resourceSystemSpeedTestServerCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemSpeedTestServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_speedtestserver", obj)
+
 	o, err := c.CreateSystemSpeedTestServer(obj, vdomparam)
 
 	if err != nil {
@@ -142,6 +144,8 @@ func resourceSystemSpeedTestServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemSpeedTestServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_speedtestserver", obj)
+
 	o, err := c.UpdateSystemSpeedTestServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSpeedTestServer resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemSsoAdmin() *schema.Resource {
 		Delete: resourceSystemSsoAdminDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceSystemSsoAdminCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemSsoAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ssoadmin", obj)
+
 	o, err := c.CreateSystemSsoAdmin(obj, vdomparam)
 
 	if err != nil {
@@ -126,6 +128,8 @@ func resourceSystemSsoAdminUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSsoAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ssoadmin", obj)
+
 	o, err := c.UpdateSystemSsoAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSsoAdmin resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemSsoAdminExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSsoAdmin(i)
+		o, err := c.ReadSystemSsoAdmin(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSsoAdmin: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemSsoAdminDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSsoAdmin(i)
+		o, err := c.ReadSystemSsoAdmin(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemSsoForticloudAdmin() *schema.Resource {
 		Delete: resourceSystemSsoForticloudAdminDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceSystemSsoForticloudAdminCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating SystemSsoForticloudAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ssoforticloudadmin", obj)
+
 	o, err := c.CreateSystemSsoForticloudAdmin(obj, vdomparam)
 
 	if err != nil {
@@ -115,6 +117,8 @@ func resourceSystemSsoForticloudAdminUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemSsoForticloudAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_ssoforticloudadmin", obj)
+
 	o, err := c.UpdateSystemSsoForticloudAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSsoForticloudAdmin resource: %v", err)
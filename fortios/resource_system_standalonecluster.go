@@ -26,7 +26,7 @@ func resourceSystemStandaloneCluster() *schema.Resource {
 		Delete: resourceSystemStandaloneClusterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSystemStandaloneClusterUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemStandaloneCluster resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_standalonecluster", obj)
+
 	o, err := c.UpdateSystemStandaloneCluster(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStandaloneCluster resource: %v", err)
@@ -123,6 +125,8 @@ func resourceSystemStandaloneClusterDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemStandaloneCluster resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_standalonecluster", obj)
+
 	_, err = c.UpdateSystemStandaloneCluster(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemStandaloneCluster resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemStorage() *schema.Resource {
 		Delete: resourceSystemStorageDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceSystemStorageCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemStorage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_storage", obj)
+
 	o, err := c.CreateSystemStorage(obj, vdomparam)
 
 	if err != nil {
@@ -139,6 +141,8 @@ func resourceSystemStorageUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemStorage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_storage", obj)
+
 	o, err := c.UpdateSystemStorage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStorage resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemStp() *schema.Resource {
 		Delete: resourceSystemStpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceSystemStpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemStp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_stp", obj)
+
 	o, err := c.UpdateSystemStp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemStp resource: %v", err)
@@ -120,6 +122,8 @@ func resourceSystemStpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemStp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_stp", obj)
+
 	_, err = c.UpdateSystemStp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemStp resource: %v", err)
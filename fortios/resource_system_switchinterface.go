@@ -26,7 +26,7 @@ func resourceSystemSwitchInterface() *schema.Resource {
 		Delete: resourceSystemSwitchInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,6 +133,8 @@ func resourceSystemSwitchInterfaceCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemSwitchInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_switchinterface", obj)
+
 	o, err := c.CreateSystemSwitchInterface(obj, vdomparam)
 
 	if err != nil {
@@ -166,6 +168,8 @@ func resourceSystemSwitchInterfaceUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemSwitchInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_switchinterface", obj)
+
 	o, err := c.UpdateSystemSwitchInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSwitchInterface resource: %v", err)
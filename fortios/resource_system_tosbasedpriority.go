@@ -26,7 +26,7 @@ func resourceSystemTosBasedPriority() *schema.Resource {
 		Delete: resourceSystemTosBasedPriorityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/tos-based-priority", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemTosBasedPriorityCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemTosBasedPriority resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_tosbasedpriority", obj)
+
 	o, err := c.CreateSystemTosBasedPriority(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemTosBasedPriorityUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemTosBasedPriority resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_tosbasedpriority", obj)
+
 	o, err := c.UpdateSystemTosBasedPriority(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemTosBasedPriority resource: %v", err)
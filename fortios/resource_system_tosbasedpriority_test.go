@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemTosBasedPriorityExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemTosBasedPriority(i)
+		o, err := c.ReadSystemTosBasedPriority(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemTosBasedPriority: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemTosBasedPriorityDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemTosBasedPriority(i)
+		o, err := c.ReadSystemTosBasedPriority(i, "")
 
 		if err == nil {
 			if o != nil {
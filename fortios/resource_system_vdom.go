@@ -26,7 +26,7 @@ func resourceSystemVdom() *schema.Resource {
 		Delete: resourceSystemVdomDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,6 +84,8 @@ func resourceSystemVdomCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemVdom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdom", obj)
+
 	o, err := c.CreateSystemVdom(obj, vdomparam)
 
 	if err != nil {
@@ -117,6 +119,8 @@ func resourceSystemVdomUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdom resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdom", obj)
+
 	o, err := c.UpdateSystemVdom(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdom resource: %v", err)
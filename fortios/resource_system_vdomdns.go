@@ -26,7 +26,7 @@ func resourceSystemVdomDns() *schema.Resource {
 		Delete: resourceSystemVdomDnsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -148,6 +148,8 @@ func resourceSystemVdomDnsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdomDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomdns", obj)
+
 	o, err := c.UpdateSystemVdomDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomDns resource: %v", err)
@@ -182,6 +184,8 @@ func resourceSystemVdomDnsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdomDns resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomdns", obj)
+
 	_, err = c.UpdateSystemVdomDns(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemVdomDns resource: %v", err)
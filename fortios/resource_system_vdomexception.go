@@ -26,7 +26,7 @@ func resourceSystemVdomException() *schema.Resource {
 		Delete: resourceSystemVdomExceptionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system/vdom-exception", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceSystemVdomExceptionCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemVdomException resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomexception", obj)
+
 	o, err := c.CreateSystemVdomException(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceSystemVdomExceptionUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemVdomException resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomexception", obj)
+
 	o, err := c.UpdateSystemVdomException(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomException resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemVdomExceptionExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomException(i)
+		o, err := c.ReadSystemVdomException(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemVdomException: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemVdomExceptionDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomException(i)
+		o, err := c.ReadSystemVdomException(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemVdomLink() *schema.Resource {
 		Delete: resourceSystemVdomLinkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemVdomLinkCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemVdomLink resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomlink", obj)
+
 	o, err := c.CreateSystemVdomLink(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemVdomLinkUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVdomLink resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomlink", obj)
+
 	o, err := c.UpdateSystemVdomLink(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomLink resource: %v", err)
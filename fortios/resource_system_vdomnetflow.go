@@ -26,7 +26,7 @@ func resourceSystemVdomNetflow() *schema.Resource {
 		Delete: resourceSystemVdomNetflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSystemVdomNetflowUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemVdomNetflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomnetflow", obj)
+
 	o, err := c.UpdateSystemVdomNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomNetflow resource: %v", err)
@@ -123,6 +125,8 @@ func resourceSystemVdomNetflowDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemVdomNetflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomnetflow", obj)
+
 	_, err = c.UpdateSystemVdomNetflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemVdomNetflow resource: %v", err)
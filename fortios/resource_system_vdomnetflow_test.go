@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemVdomNetflowExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomNetflow(i)
+		o, err := c.ReadSystemVdomNetflow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemVdomNetflow: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemVdomNetflowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomNetflow(i)
+		o, err := c.ReadSystemVdomNetflow(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemVdomProperty() *schema.Resource {
 		Delete: resourceSystemVdomPropertyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -164,6 +164,8 @@ func resourceSystemVdomPropertyCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating SystemVdomProperty resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomproperty", obj)
+
 	o, err := c.CreateSystemVdomProperty(obj, vdomparam)
 
 	if err != nil {
@@ -197,6 +199,8 @@ func resourceSystemVdomPropertyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemVdomProperty resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomproperty", obj)
+
 	o, err := c.UpdateSystemVdomProperty(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomProperty resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemVdomRadiusServer() *schema.Resource {
 		Delete: resourceSystemVdomRadiusServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceSystemVdomRadiusServerCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemVdomRadiusServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomradiusserver", obj)
+
 	o, err := c.CreateSystemVdomRadiusServer(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceSystemVdomRadiusServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemVdomRadiusServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomradiusserver", obj)
+
 	o, err := c.UpdateSystemVdomRadiusServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomRadiusServer resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemVdomSflow() *schema.Resource {
 		Delete: resourceSystemVdomSflowDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceSystemVdomSflowUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVdomSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomsflow", obj)
+
 	o, err := c.UpdateSystemVdomSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVdomSflow resource: %v", err)
@@ -123,6 +125,8 @@ func resourceSystemVdomSflowDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVdomSflow resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vdomsflow", obj)
+
 	_, err = c.UpdateSystemVdomSflow(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemVdomSflow resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemVdomSflowExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomSflow(i)
+		o, err := c.ReadSystemVdomSflow(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemVdomSflow: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemVdomSflowDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVdomSflow(i)
+		o, err := c.ReadSystemVdomSflow(i, "")
 
 		if err == nil {
 			if o != nil {
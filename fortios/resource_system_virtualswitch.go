@@ -26,7 +26,7 @@ func resourceSystemVirtualSwitch() *schema.Resource {
 		Delete: resourceSystemVirtualSwitchDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,6 +121,8 @@ func resourceSystemVirtualSwitchCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemVirtualSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualswitch", obj)
+
 	o, err := c.CreateSystemVirtualSwitch(obj, vdomparam)
 
 	if err != nil {
@@ -154,6 +156,8 @@ func resourceSystemVirtualSwitchUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemVirtualSwitch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualswitch", obj)
+
 	o, err := c.UpdateSystemVirtualSwitch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualSwitch resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemVirtualWanLink() *schema.Resource {
 		Delete: resourceSystemVirtualWanLinkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -917,6 +917,8 @@ func resourceSystemVirtualWanLinkUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemVirtualWanLink resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualwanlink", obj)
+
 	o, err := c.UpdateSystemVirtualWanLink(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualWanLink resource: %v", err)
@@ -951,6 +953,8 @@ func resourceSystemVirtualWanLinkDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemVirtualWanLink resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualwanlink", obj)
+
 	_, err = c.UpdateSystemVirtualWanLink(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemVirtualWanLink resource: %v", err)
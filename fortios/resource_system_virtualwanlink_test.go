@@ -50,7 +50,7 @@ func testAccCheckFortiOSSystemVirtualWanLinkExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVirtualWanLink(i)
+		o, err := c.ReadSystemVirtualWanLink(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemVirtualWanLink: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckSystemVirtualWanLinkDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVirtualWanLink(i)
+		o, err := c.ReadSystemVirtualWanLink(i, "")
 
 		if err == nil {
 			if o != nil {
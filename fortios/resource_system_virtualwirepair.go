@@ -26,7 +26,7 @@ func resourceSystemVirtualWirePair() *schema.Resource {
 		Delete: resourceSystemVirtualWirePairDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,6 +92,8 @@ func resourceSystemVirtualWirePairCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating SystemVirtualWirePair resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualwirepair", obj)
+
 	o, err := c.CreateSystemVirtualWirePair(obj, vdomparam)
 
 	if err != nil {
@@ -125,6 +127,8 @@ func resourceSystemVirtualWirePairUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemVirtualWirePair resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_virtualwirepair", obj)
+
 	o, err := c.UpdateSystemVirtualWirePair(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVirtualWirePair resource: %v", err)
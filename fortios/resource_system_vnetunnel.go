@@ -26,7 +26,7 @@ func resourceSystemVneTunnel() *schema.Resource {
 		Delete: resourceSystemVneTunnelDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceSystemVneTunnelUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVneTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vnetunnel", obj)
+
 	o, err := c.UpdateSystemVneTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVneTunnel resource: %v", err)
@@ -140,6 +142,8 @@ func resourceSystemVneTunnelDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemVneTunnel resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vnetunnel", obj)
+
 	_, err = c.UpdateSystemVneTunnel(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemVneTunnel resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemVxlan() *schema.Resource {
 		Delete: resourceSystemVxlanDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -122,6 +122,8 @@ func resourceSystemVxlanCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemVxlan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vxlan", obj)
+
 	o, err := c.CreateSystemVxlan(obj, vdomparam)
 
 	if err != nil {
@@ -155,6 +157,8 @@ func resourceSystemVxlanUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemVxlan resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_vxlan", obj)
+
 	o, err := c.UpdateSystemVxlan(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemVxlan resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemVxlanExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVxlan(i)
+		o, err := c.ReadSystemVxlan(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemVxlan: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemVxlanDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemVxlan(i)
+		o, err := c.ReadSystemVxlan(i, "")
 
 		if err == nil {
 			if o != nil {
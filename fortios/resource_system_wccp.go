@@ -26,7 +26,7 @@ func resourceSystemWccp() *schema.Resource {
 		Delete: resourceSystemWccpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,6 +177,8 @@ func resourceSystemWccpCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemWccp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_wccp", obj)
+
 	o, err := c.CreateSystemWccp(obj, vdomparam)
 
 	if err != nil {
@@ -210,6 +212,8 @@ func resourceSystemWccpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemWccp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_wccp", obj)
+
 	o, err := c.UpdateSystemWccp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemWccp resource: %v", err)
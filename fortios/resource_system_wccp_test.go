@@ -66,7 +66,7 @@ func testAccCheckFortiOSSystemWccpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemWccp(i)
+		o, err := c.ReadSystemWccp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemWccp: %s", err)
@@ -89,7 +89,7 @@ func testAccCheckSystemWccpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemWccp(i)
+		o, err := c.ReadSystemWccp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemZone() *schema.Resource {
 		Delete: resourceSystemZoneDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,6 +127,8 @@ func resourceSystemZoneCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemZone resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_zone", obj)
+
 	o, err := c.CreateSystemZone(obj, vdomparam)
 
 	if err != nil {
@@ -160,6 +162,8 @@ func resourceSystemZoneUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemZone resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_system_zone", obj)
+
 	o, err := c.UpdateSystemZone(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemZone resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSystemZoneExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemZone(i)
+		o, err := c.ReadSystemZone(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemZone: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSystemZoneDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemZone(i)
+		o, err := c.ReadSystemZone(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemAutoupdatePushUpdate() *schema.Resource {
 		Delete: resourceSystemAutoupdatePushUpdateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSystemAutoupdatePushUpdateUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SystemAutoupdatePushUpdate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_pushupdate", obj)
+
 	o, err := c.UpdateSystemAutoupdatePushUpdate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdatePushUpdate resource: %v", err)
@@ -108,6 +110,8 @@ func resourceSystemAutoupdatePushUpdateDelete(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SystemAutoupdatePushUpdate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_pushupdate", obj)
+
 	_, err = c.UpdateSystemAutoupdatePushUpdate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAutoupdatePushUpdate resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemAutoupdatePushUpdateExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdatePushUpdate(i)
+		o, err := c.ReadSystemAutoupdatePushUpdate(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutoupdatePushUpdate: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemAutoupdatePushUpdateDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdatePushUpdate(i)
+		o, err := c.ReadSystemAutoupdatePushUpdate(i, "")
 
 		if err == nil {
 			if o != nil {
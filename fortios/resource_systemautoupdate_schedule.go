@@ -26,7 +26,7 @@ func resourceSystemAutoupdateSchedule() *schema.Resource {
 		Delete: resourceSystemAutoupdateScheduleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceSystemAutoupdateScheduleUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemAutoupdateSchedule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_schedule", obj)
+
 	o, err := c.UpdateSystemAutoupdateSchedule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdateSchedule resource: %v", err)
@@ -108,6 +110,8 @@ func resourceSystemAutoupdateScheduleDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemAutoupdateSchedule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_schedule", obj)
+
 	_, err = c.UpdateSystemAutoupdateSchedule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAutoupdateSchedule resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemAutoupdateScheduleExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdateSchedule(i)
+		o, err := c.ReadSystemAutoupdateSchedule(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutoupdateSchedule: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemAutoupdateScheduleDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdateSchedule(i)
+		o, err := c.ReadSystemAutoupdateSchedule(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemAutoupdateTunneling() *schema.Resource {
 		Delete: resourceSystemAutoupdateTunnelingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceSystemAutoupdateTunnelingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemAutoupdateTunneling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_tunneling", obj)
+
 	o, err := c.UpdateSystemAutoupdateTunneling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemAutoupdateTunneling resource: %v", err)
@@ -120,6 +122,8 @@ func resourceSystemAutoupdateTunnelingDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemAutoupdateTunneling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemautoupdate_tunneling", obj)
+
 	_, err = c.UpdateSystemAutoupdateTunneling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemAutoupdateTunneling resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSystemAutoupdateTunnelingExists(n string) resource.TestC
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdateTunneling(i)
+		o, err := c.ReadSystemAutoupdateTunneling(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemAutoupdateTunneling: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSystemAutoupdateTunnelingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemAutoupdateTunneling(i)
+		o, err := c.ReadSystemAutoupdateTunneling(i, "")
 
 		if err == nil {
 			if o != nil {
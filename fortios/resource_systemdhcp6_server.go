@@ -26,7 +26,7 @@ func resourceSystemDhcp6Server() *schema.Resource {
 		Delete: resourceSystemDhcp6ServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system.dhcp6/server", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -215,6 +215,8 @@ func resourceSystemDhcp6ServerCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating SystemDhcp6Server resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemdhcp6_server", obj)
+
 	o, err := c.CreateSystemDhcp6Server(obj, vdomparam)
 
 	if err != nil {
@@ -248,6 +250,8 @@ func resourceSystemDhcp6ServerUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemDhcp6Server resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemdhcp6_server", obj)
+
 	o, err := c.UpdateSystemDhcp6Server(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDhcp6Server resource: %v", err)
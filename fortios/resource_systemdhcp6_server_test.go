@@ -53,7 +53,7 @@ func testAccCheckFortiOSSystemDhcp6ServerExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDhcp6Server(i)
+		o, err := c.ReadSystemDhcp6Server(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDhcp6Server: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckSystemDhcp6ServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDhcp6Server(i)
+		o, err := c.ReadSystemDhcp6Server(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemDhcpServer() *schema.Resource {
 		Delete: resourceSystemDhcpServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system.dhcp/server", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -458,6 +458,8 @@ func resourceSystemDhcpServerCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating SystemDhcpServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemdhcp_server", obj)
+
 	o, err := c.CreateSystemDhcpServer(obj, vdomparam)
 
 	if err != nil {
@@ -491,6 +493,8 @@ func resourceSystemDhcpServerUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating SystemDhcpServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemdhcp_server", obj)
+
 	o, err := c.UpdateSystemDhcpServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemDhcpServer resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSSystemDhcpServerExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDhcpServer(i)
+		o, err := c.ReadSystemDhcpServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemDhcpServer: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckSystemDhcpServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemDhcpServer(i)
+		o, err := c.ReadSystemDhcpServer(i, "")
 
 		if err == nil {
 			if o != nil {
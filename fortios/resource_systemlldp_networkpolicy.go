@@ -26,7 +26,7 @@ func resourceSystemLldpNetworkPolicy() *schema.Resource {
 		Delete: resourceSystemLldpNetworkPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -363,6 +363,8 @@ func resourceSystemLldpNetworkPolicyCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating SystemLldpNetworkPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemlldp_networkpolicy", obj)
+
 	o, err := c.CreateSystemLldpNetworkPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -396,6 +398,8 @@ func resourceSystemLldpNetworkPolicyUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemLldpNetworkPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemlldp_networkpolicy", obj)
+
 	o, err := c.UpdateSystemLldpNetworkPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemLldpNetworkPolicy resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSSystemLldpNetworkPolicyExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemLldpNetworkPolicy(i)
+		o, err := c.ReadSystemLldpNetworkPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemLldpNetworkPolicy: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckSystemLldpNetworkPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemLldpNetworkPolicy(i)
+		o, err := c.ReadSystemLldpNetworkPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
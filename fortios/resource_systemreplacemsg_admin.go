@@ -26,7 +26,7 @@ func resourceSystemReplacemsgAdmin() *schema.Resource {
 		Delete: resourceSystemReplacemsgAdminDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAdminUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_admin", obj)
+
 	o, err := c.UpdateSystemReplacemsgAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAdmin resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgAdminDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgAdmin resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_admin", obj)
+
 	_, err = c.UpdateSystemReplacemsgAdmin(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgAdmin resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemReplacemsgAdminExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgAdmin(i)
+		o, err := c.ReadSystemReplacemsgAdmin(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemReplacemsgAdmin: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemReplacemsgAdminDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemReplacemsgAdmin(i)
+		o, err := c.ReadSystemReplacemsgAdmin(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgAlertmail() *schema.Resource {
 		Delete: resourceSystemReplacemsgAlertmailDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAlertmailUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemReplacemsgAlertmail resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_alertmail", obj)
+
 	o, err := c.UpdateSystemReplacemsgAlertmail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAlertmail resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgAlertmailDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating SystemReplacemsgAlertmail resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_alertmail", obj)
+
 	_, err = c.UpdateSystemReplacemsgAlertmail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgAlertmail resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgAuth() *schema.Resource {
 		Delete: resourceSystemReplacemsgAuthDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgAuthUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgAuth resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_auth", obj)
+
 	o, err := c.UpdateSystemReplacemsgAuth(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAuth resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgAuthDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgAuth resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_auth", obj)
+
 	_, err = c.UpdateSystemReplacemsgAuth(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgAuth resource: %v", err)
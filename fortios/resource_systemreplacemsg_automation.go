@@ -26,7 +26,7 @@ func resourceSystemReplacemsgAutomation() *schema.Resource {
 		Delete: resourceSystemReplacemsgAutomationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceSystemReplacemsgAutomationCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating SystemReplacemsgAutomation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_automation", obj)
+
 	o, err := c.CreateSystemReplacemsgAutomation(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceSystemReplacemsgAutomationUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating SystemReplacemsgAutomation resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_automation", obj)
+
 	o, err := c.UpdateSystemReplacemsgAutomation(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgAutomation resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgDeviceDetectionPortal() *schema.Resource {
 		Delete: resourceSystemReplacemsgDeviceDetectionPortalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgDeviceDetectionPortalUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating SystemReplacemsgDeviceDetectionPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_devicedetectionportal", obj)
+
 	o, err := c.UpdateSystemReplacemsgDeviceDetectionPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgDeviceDetectionPortal resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgDeviceDetectionPortalDelete(d *schema.ResourceData,
 		return fmt.Errorf("Error updating SystemReplacemsgDeviceDetectionPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_devicedetectionportal", obj)
+
 	_, err = c.UpdateSystemReplacemsgDeviceDetectionPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgDeviceDetectionPortal resource: %v", err)
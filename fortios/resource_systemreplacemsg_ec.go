@@ -26,7 +26,7 @@ func resourceSystemReplacemsgEc() *schema.Resource {
 		Delete: resourceSystemReplacemsgEcDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgEcUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemReplacemsgEc resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_ec", obj)
+
 	o, err := c.UpdateSystemReplacemsgEc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgEc resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgEcDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating SystemReplacemsgEc resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_ec", obj)
+
 	_, err = c.UpdateSystemReplacemsgEc(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgEc resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgFortiguardWf() *schema.Resource {
 		Delete: resourceSystemReplacemsgFortiguardWfDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgFortiguardWfUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgFortiguardWf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_fortiguardwf", obj)
+
 	o, err := c.UpdateSystemReplacemsgFortiguardWf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgFortiguardWf resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgFortiguardWfDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgFortiguardWf resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_fortiguardwf", obj)
+
 	_, err = c.UpdateSystemReplacemsgFortiguardWf(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgFortiguardWf resource: %v", err)
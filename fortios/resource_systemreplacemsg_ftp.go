@@ -26,7 +26,7 @@ func resourceSystemReplacemsgFtp() *schema.Resource {
 		Delete: resourceSystemReplacemsgFtpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgFtpUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgFtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_ftp", obj)
+
 	o, err := c.UpdateSystemReplacemsgFtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgFtp resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgFtpDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgFtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_ftp", obj)
+
 	_, err = c.UpdateSystemReplacemsgFtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgFtp resource: %v", err)
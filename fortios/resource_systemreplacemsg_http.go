@@ -26,7 +26,7 @@ func resourceSystemReplacemsgHttp() *schema.Resource {
 		Delete: resourceSystemReplacemsgHttpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgHttpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgHttp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_http", obj)
+
 	o, err := c.UpdateSystemReplacemsgHttp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgHttp resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgHttpDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgHttp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_http", obj)
+
 	_, err = c.UpdateSystemReplacemsgHttp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgHttp resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgIcap() *schema.Resource {
 		Delete: resourceSystemReplacemsgIcapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgIcapUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgIcap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_icap", obj)
+
 	o, err := c.UpdateSystemReplacemsgIcap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgIcap resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgIcapDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgIcap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_icap", obj)
+
 	_, err = c.UpdateSystemReplacemsgIcap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgIcap resource: %v", err)
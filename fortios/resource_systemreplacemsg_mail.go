@@ -26,7 +26,7 @@ func resourceSystemReplacemsgMail() *schema.Resource {
 		Delete: resourceSystemReplacemsgMailDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgMailUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgMail resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_mail", obj)
+
 	o, err := c.UpdateSystemReplacemsgMail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgMail resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgMailDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgMail resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_mail", obj)
+
 	_, err = c.UpdateSystemReplacemsgMail(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgMail resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgNacQuar() *schema.Resource {
 		Delete: resourceSystemReplacemsgNacQuarDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgNacQuarUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemReplacemsgNacQuar resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_nacquar", obj)
+
 	o, err := c.UpdateSystemReplacemsgNacQuar(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgNacQuar resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgNacQuarDelete(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating SystemReplacemsgNacQuar resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_nacquar", obj)
+
 	_, err = c.UpdateSystemReplacemsgNacQuar(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgNacQuar resource: %v", err)
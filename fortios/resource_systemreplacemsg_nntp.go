@@ -26,7 +26,7 @@ func resourceSystemReplacemsgNntp() *schema.Resource {
 		Delete: resourceSystemReplacemsgNntpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgNntpUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgNntp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_nntp", obj)
+
 	o, err := c.UpdateSystemReplacemsgNntp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgNntp resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgNntpDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgNntp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_nntp", obj)
+
 	_, err = c.UpdateSystemReplacemsgNntp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgNntp resource: %v", err)
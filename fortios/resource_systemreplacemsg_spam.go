@@ -26,7 +26,7 @@ func resourceSystemReplacemsgSpam() *schema.Resource {
 		Delete: resourceSystemReplacemsgSpamDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgSpamUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgSpam resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_spam", obj)
+
 	o, err := c.UpdateSystemReplacemsgSpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgSpam resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgSpamDelete(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating SystemReplacemsgSpam resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_spam", obj)
+
 	_, err = c.UpdateSystemReplacemsgSpam(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgSpam resource: %v", err)
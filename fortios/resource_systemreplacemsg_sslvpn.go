@@ -26,7 +26,7 @@ func resourceSystemReplacemsgSslvpn() *schema.Resource {
 		Delete: resourceSystemReplacemsgSslvpnDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgSslvpnUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgSslvpn resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_sslvpn", obj)
+
 	o, err := c.UpdateSystemReplacemsgSslvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgSslvpn resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgSslvpnDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating SystemReplacemsgSslvpn resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_sslvpn", obj)
+
 	_, err = c.UpdateSystemReplacemsgSslvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgSslvpn resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemReplacemsgTrafficQuota() *schema.Resource {
 		Delete: resourceSystemReplacemsgTrafficQuotaDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgTrafficQuotaUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgTrafficQuota resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_trafficquota", obj)
+
 	o, err := c.UpdateSystemReplacemsgTrafficQuota(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgTrafficQuota resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgTrafficQuotaDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating SystemReplacemsgTrafficQuota resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_trafficquota", obj)
+
 	_, err = c.UpdateSystemReplacemsgTrafficQuota(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgTrafficQuota resource: %v", err)
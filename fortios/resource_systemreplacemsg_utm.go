@@ -26,7 +26,7 @@ func resourceSystemReplacemsgUtm() *schema.Resource {
 		Delete: resourceSystemReplacemsgUtmDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgUtmUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgUtm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_utm", obj)
+
 	o, err := c.UpdateSystemReplacemsgUtm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgUtm resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgUtmDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemReplacemsgUtm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_utm", obj)
+
 	_, err = c.UpdateSystemReplacemsgUtm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgUtm resource: %v", err)
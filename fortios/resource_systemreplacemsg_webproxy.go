@@ -26,7 +26,7 @@ func resourceSystemReplacemsgWebproxy() *schema.Resource {
 		Delete: resourceSystemReplacemsgWebproxyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceSystemReplacemsgWebproxyUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemReplacemsgWebproxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_webproxy", obj)
+
 	o, err := c.UpdateSystemReplacemsgWebproxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemReplacemsgWebproxy resource: %v", err)
@@ -113,6 +115,8 @@ func resourceSystemReplacemsgWebproxyDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating SystemReplacemsgWebproxy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemreplacemsg_webproxy", obj)
+
 	_, err = c.UpdateSystemReplacemsgWebproxy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemReplacemsgWebproxy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceSystemSnmpCommunity() *schema.Resource {
 		Delete: resourceSystemSnmpCommunityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/system.snmp/community", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -203,6 +203,8 @@ func resourceSystemSnmpCommunityCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating SystemSnmpCommunity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_community", obj)
+
 	o, err := c.CreateSystemSnmpCommunity(obj, vdomparam)
 
 	if err != nil {
@@ -236,6 +238,8 @@ func resourceSystemSnmpCommunityUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating SystemSnmpCommunity resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_community", obj)
+
 	o, err := c.UpdateSystemSnmpCommunity(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpCommunity resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSSystemSnmpCommunityExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpCommunity(i)
+		o, err := c.ReadSystemSnmpCommunity(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSnmpCommunity: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckSystemSnmpCommunityDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpCommunity(i)
+		o, err := c.ReadSystemSnmpCommunity(i, "")
 
 		if err == nil {
 			if o != nil {
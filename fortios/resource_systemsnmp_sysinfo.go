@@ -26,7 +26,7 @@ func resourceSystemSnmpSysinfo() *schema.Resource {
 		Delete: resourceSystemSnmpSysinfoDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceSystemSnmpSysinfoUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_sysinfo", obj)
+
 	o, err := c.UpdateSystemSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpSysinfo resource: %v", err)
@@ -140,6 +142,8 @@ func resourceSystemSnmpSysinfoDelete(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating SystemSnmpSysinfo resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_sysinfo", obj)
+
 	_, err = c.UpdateSystemSnmpSysinfo(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing SystemSnmpSysinfo resource: %v", err)
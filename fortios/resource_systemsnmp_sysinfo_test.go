@@ -51,7 +51,7 @@ func testAccCheckFortiOSSystemSnmpSysinfoExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpSysinfo(i)
+		o, err := c.ReadSystemSnmpSysinfo(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSnmpSysinfo: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckSystemSnmpSysinfoDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpSysinfo(i)
+		o, err := c.ReadSystemSnmpSysinfo(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceSystemSnmpUser() *schema.Resource {
 		Delete: resourceSystemSnmpUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -152,6 +152,8 @@ func resourceSystemSnmpUserCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating SystemSnmpUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_user", obj)
+
 	o, err := c.CreateSystemSnmpUser(obj, vdomparam)
 
 	if err != nil {
@@ -185,6 +187,8 @@ func resourceSystemSnmpUserUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating SystemSnmpUser resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_systemsnmp_user", obj)
+
 	o, err := c.UpdateSystemSnmpUser(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating SystemSnmpUser resource: %v", err)
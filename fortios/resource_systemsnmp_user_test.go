@@ -61,7 +61,7 @@ func testAccCheckFortiOSSystemSnmpUserExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpUser(i)
+		o, err := c.ReadSystemSnmpUser(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading SystemSnmpUser: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckSystemSnmpUserDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadSystemSnmpUser(i)
+		o, err := c.ReadSystemSnmpUser(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserAdgrp() *schema.Resource {
 		Delete: resourceUserAdgrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceUserAdgrpCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserAdgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_adgrp", obj)
+
 	o, err := c.CreateUserAdgrp(obj, vdomparam)
 
 	if err != nil {
@@ -112,6 +114,8 @@ func resourceUserAdgrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserAdgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_adgrp", obj)
+
 	o, err := c.UpdateUserAdgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserAdgrp resource: %v", err)
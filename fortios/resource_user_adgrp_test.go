@@ -51,7 +51,7 @@ func testAccCheckFortiOSUserAdgrpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserAdgrp(i)
+		o, err := c.ReadUserAdgrp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserAdgrp: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckUserAdgrpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserAdgrp(i)
+		o, err := c.ReadUserAdgrp(i, "")
 
 		if err == nil {
 			if o != nil {
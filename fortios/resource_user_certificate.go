@@ -26,7 +26,7 @@ func resourceUserCertificate() *schema.Resource {
 		Delete: resourceUserCertificateDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,6 +90,8 @@ func resourceUserCertificateCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating UserCertificate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_certificate", obj)
+
 	o, err := c.CreateUserCertificate(obj, vdomparam)
 
 	if err != nil {
@@ -123,6 +125,8 @@ func resourceUserCertificateUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserCertificate resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_certificate", obj)
+
 	o, err := c.UpdateUserCertificate(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserCertificate resource: %v", err)
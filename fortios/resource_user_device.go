@@ -26,7 +26,7 @@ func resourceUserDevice() *schema.Resource {
 		Delete: resourceUserDeviceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -139,6 +139,8 @@ func resourceUserDeviceCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserDevice resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_device", obj)
+
 	o, err := c.CreateUserDevice(obj, vdomparam)
 
 	if err != nil {
@@ -172,6 +174,8 @@ func resourceUserDeviceUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserDevice resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_device", obj)
+
 	o, err := c.UpdateUserDevice(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDevice resource: %v", err)
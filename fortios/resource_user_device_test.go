@@ -51,7 +51,7 @@ func testAccCheckFortiOSUserDeviceExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDevice(i)
+		o, err := c.ReadUserDevice(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserDevice: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckUserDeviceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDevice(i)
+		o, err := c.ReadUserDevice(i, "")
 
 		if err == nil {
 			if o != nil {
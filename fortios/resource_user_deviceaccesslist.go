@@ -26,7 +26,7 @@ func resourceUserDeviceAccessList() *schema.Resource {
 		Delete: resourceUserDeviceAccessListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceUserDeviceAccessListCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating UserDeviceAccessList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_deviceaccesslist", obj)
+
 	o, err := c.CreateUserDeviceAccessList(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceUserDeviceAccessListUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating UserDeviceAccessList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_deviceaccesslist", obj)
+
 	o, err := c.UpdateUserDeviceAccessList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceAccessList resource: %v", err)
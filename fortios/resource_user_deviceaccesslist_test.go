@@ -49,7 +49,7 @@ func testAccCheckFortiOSUserDeviceAccessListExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDeviceAccessList(i)
+		o, err := c.ReadUserDeviceAccessList(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserDeviceAccessList: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckUserDeviceAccessListDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDeviceAccessList(i)
+		o, err := c.ReadUserDeviceAccessList(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserDeviceCategory() *schema.Resource {
 		Delete: resourceUserDeviceCategoryDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceUserDeviceCategoryCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating UserDeviceCategory resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_devicecategory", obj)
+
 	o, err := c.CreateUserDeviceCategory(obj, vdomparam)
 
 	if err != nil {
@@ -105,6 +107,8 @@ func resourceUserDeviceCategoryUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating UserDeviceCategory resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_devicecategory", obj)
+
 	o, err := c.UpdateUserDeviceCategory(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceCategory resource: %v", err)
@@ -26,7 +26,7 @@ func resourceUserDeviceGroup() *schema.Resource {
 		Delete: resourceUserDeviceGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -120,6 +120,8 @@ func resourceUserDeviceGroupCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating UserDeviceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_devicegroup", obj)
+
 	o, err := c.CreateUserDeviceGroup(obj, vdomparam)
 
 	if err != nil {
@@ -153,6 +155,8 @@ func resourceUserDeviceGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserDeviceGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_devicegroup", obj)
+
 	o, err := c.UpdateUserDeviceGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDeviceGroup resource: %v", err)
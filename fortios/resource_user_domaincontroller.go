@@ -26,7 +26,7 @@ func resourceUserDomainController() *schema.Resource {
 		Delete: resourceUserDomainControllerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -212,6 +212,8 @@ func resourceUserDomainControllerCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating UserDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_domaincontroller", obj)
+
 	o, err := c.CreateUserDomainController(obj, vdomparam)
 
 	if err != nil {
@@ -245,6 +247,8 @@ func resourceUserDomainControllerUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating UserDomainController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_domaincontroller", obj)
+
 	o, err := c.UpdateUserDomainController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserDomainController resource: %v", err)
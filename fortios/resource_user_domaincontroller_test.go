@@ -54,7 +54,7 @@ func testAccCheckFortiOSUserDomainControllerExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDomainController(i)
+		o, err := c.ReadUserDomainController(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserDomainController: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckUserDomainControllerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserDomainController(i)
+		o, err := c.ReadUserDomainController(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserExchange() *schema.Resource {
 		Delete: resourceUserExchangeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,6 +141,8 @@ func resourceUserExchangeCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserExchange resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_exchange", obj)
+
 	o, err := c.CreateUserExchange(obj, vdomparam)
 
 	if err != nil {
@@ -174,6 +176,8 @@ func resourceUserExchangeUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserExchange resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_exchange", obj)
+
 	o, err := c.UpdateUserExchange(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserExchange resource: %v", err)
@@ -26,7 +26,7 @@ func resourceUserFortitoken() *schema.Resource {
 		Delete: resourceUserFortitokenDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +108,8 @@ func resourceUserFortitokenCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserFortitoken resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fortitoken", obj)
+
 	o, err := c.CreateUserFortitoken(obj, vdomparam)
 
 	if err != nil {
@@ -141,6 +143,8 @@ func resourceUserFortitokenUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserFortitoken resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fortitoken", obj)
+
 	o, err := c.UpdateUserFortitoken(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFortitoken resource: %v", err)
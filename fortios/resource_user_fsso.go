@@ -26,7 +26,7 @@ func resourceUserFsso() *schema.Resource {
 		Delete: resourceUserFssoDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -234,6 +234,8 @@ func resourceUserFssoCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserFsso resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fsso", obj)
+
 	o, err := c.CreateUserFsso(obj, vdomparam)
 
 	if err != nil {
@@ -267,6 +269,8 @@ func resourceUserFssoUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserFsso resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fsso", obj)
+
 	o, err := c.UpdateUserFsso(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFsso resource: %v", err)
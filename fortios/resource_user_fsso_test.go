@@ -56,7 +56,7 @@ func testAccCheckFortiOSUserFssoExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserFsso(i)
+		o, err := c.ReadUserFsso(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserFsso: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckUserFssoDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserFsso(i)
+		o, err := c.ReadUserFsso(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserFssoPolling() *schema.Resource {
 		Delete: resourceUserFssoPollingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/user/fsso-polling", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,6 +141,8 @@ func resourceUserFssoPollingCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating UserFssoPolling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fssopolling", obj)
+
 	o, err := c.CreateUserFssoPolling(obj, vdomparam)
 
 	if err != nil {
@@ -174,6 +176,8 @@ func resourceUserFssoPollingUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating UserFssoPolling resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_fssopolling", obj)
+
 	o, err := c.UpdateUserFssoPolling(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserFssoPolling resource: %v", err)
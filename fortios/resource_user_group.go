@@ -26,7 +26,7 @@ func resourceUserGroup() *schema.Resource {
 		Delete: resourceUserGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -279,6 +279,8 @@ func resourceUserGroupCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_group", obj)
+
 	o, err := c.CreateUserGroup(obj, vdomparam)
 
 	if err != nil {
@@ -312,6 +314,8 @@ func resourceUserGroupUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_group", obj)
+
 	o, err := c.UpdateUserGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserGroup resource: %v", err)
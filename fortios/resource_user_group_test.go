@@ -57,7 +57,7 @@ func testAccCheckFortiOSUserGroupExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserGroup(i)
+		o, err := c.ReadUserGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserGroup: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckUserGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserGroup(i)
+		o, err := c.ReadUserGroup(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserKrbKeytab() *schema.Resource {
 		Delete: resourceUserKrbKeytabDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,6 +84,8 @@ func resourceUserKrbKeytabCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserKrbKeytab resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_krbkeytab", obj)
+
 	o, err := c.CreateUserKrbKeytab(obj, vdomparam)
 
 	if err != nil {
@@ -117,6 +119,8 @@ func resourceUserKrbKeytabUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserKrbKeytab resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_krbkeytab", obj)
+
 	o, err := c.UpdateUserKrbKeytab(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserKrbKeytab resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSUserKrbKeytabExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserKrbKeytab(i)
+		o, err := c.ReadUserKrbKeytab(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserKrbKeytab: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckUserKrbKeytabDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserKrbKeytab(i)
+		o, err := c.ReadUserKrbKeytab(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserLdap() *schema.Resource {
 		Delete: resourceUserLdapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -249,6 +249,8 @@ func resourceUserLdapCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserLdap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_ldap", obj)
+
 	o, err := c.CreateUserLdap(obj, vdomparam)
 
 	if err != nil {
@@ -282,6 +284,8 @@ func resourceUserLdapUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserLdap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_ldap", obj)
+
 	o, err := c.UpdateUserLdap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserLdap resource: %v", err)
@@ -64,7 +64,7 @@ func testAccCheckFortiOSUserLdapExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserLdap(i)
+		o, err := c.ReadUserLdap(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserLdap: %s", err)
@@ -87,7 +87,7 @@ func testAccCheckUserLdapDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserLdap(i)
+		o, err := c.ReadUserLdap(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserLocal() *schema.Resource {
 		Delete: resourceUserLocalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -204,6 +204,8 @@ func resourceUserLocalCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_local", obj)
+
 	o, err := c.CreateUserLocal(obj, vdomparam)
 
 	if err != nil {
@@ -237,6 +239,8 @@ func resourceUserLocalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_local", obj)
+
 	o, err := c.UpdateUserLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserLocal resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSUserLocalExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserLocal(i)
+		o, err := c.ReadUserLocal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserLocal: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckUserLocalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserLocal(i)
+		o, err := c.ReadUserLocal(i, "")
 
 		if err == nil {
 			if o != nil {
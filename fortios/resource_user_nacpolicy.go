@@ -26,7 +26,7 @@ func resourceUserNacPolicy() *schema.Resource {
 		Delete: resourceUserNacPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -218,6 +218,8 @@ func resourceUserNacPolicyCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserNacPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_nacpolicy", obj)
+
 	o, err := c.CreateUserNacPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -251,6 +253,8 @@ func resourceUserNacPolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserNacPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_nacpolicy", obj)
+
 	o, err := c.UpdateUserNacPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserNacPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceUserPasswordPolicy() *schema.Resource {
 		Delete: resourceUserPasswordPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +80,8 @@ func resourceUserPasswordPolicyCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating UserPasswordPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_passwordpolicy", obj)
+
 	o, err := c.CreateUserPasswordPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -113,6 +115,8 @@ func resourceUserPasswordPolicyUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating UserPasswordPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_passwordpolicy", obj)
+
 	o, err := c.UpdateUserPasswordPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPasswordPolicy resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSUserPasswordPolicyExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPasswordPolicy(i)
+		o, err := c.ReadUserPasswordPolicy(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserPasswordPolicy: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckUserPasswordPolicyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPasswordPolicy(i)
+		o, err := c.ReadUserPasswordPolicy(i, "")
 
 		if err == nil {
 			if o != nil {
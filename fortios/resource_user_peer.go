@@ -26,7 +26,7 @@ func resourceUserPeer() *schema.Resource {
 		Delete: resourceUserPeerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceUserPeerCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserPeer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_peer", obj)
+
 	o, err := c.CreateUserPeer(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceUserPeerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPeer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_peer", obj)
+
 	o, err := c.UpdateUserPeer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPeer resource: %v", err)
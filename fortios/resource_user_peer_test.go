@@ -53,7 +53,7 @@ func testAccCheckFortiOSUserPeerExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPeer(i)
+		o, err := c.ReadUserPeer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserPeer: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckUserPeerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPeer(i)
+		o, err := c.ReadUserPeer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserPeergrp() *schema.Resource {
 		Delete: resourceUserPeergrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +82,8 @@ func resourceUserPeergrpCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserPeergrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_peergrp", obj)
+
 	o, err := c.CreateUserPeergrp(obj, vdomparam)
 
 	if err != nil {
@@ -115,6 +117,8 @@ func resourceUserPeergrpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPeergrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_peergrp", obj)
+
 	o, err := c.UpdateUserPeergrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPeergrp resource: %v", err)
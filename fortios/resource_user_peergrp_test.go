@@ -51,7 +51,7 @@ func testAccCheckFortiOSUserPeergrpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPeergrp(i)
+		o, err := c.ReadUserPeergrp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserPeergrp: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckUserPeergrpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPeergrp(i)
+		o, err := c.ReadUserPeergrp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserPop3() *schema.Resource {
 		Delete: resourceUserPop3Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,6 +84,8 @@ func resourceUserPop3Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserPop3 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_pop3", obj)
+
 	o, err := c.CreateUserPop3(obj, vdomparam)
 
 	if err != nil {
@@ -117,6 +119,8 @@ func resourceUserPop3Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserPop3 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_pop3", obj)
+
 	o, err := c.UpdateUserPop3(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserPop3 resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSUserPop3Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPop3(i)
+		o, err := c.ReadUserPop3(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserPop3: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckUserPop3Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserPop3(i)
+		o, err := c.ReadUserPop3(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserQuarantine() *schema.Resource {
 		Delete: resourceUserQuarantineDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -134,6 +134,8 @@ func resourceUserQuarantineUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_quarantine", obj)
+
 	o, err := c.UpdateUserQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserQuarantine resource: %v", err)
@@ -168,6 +170,8 @@ func resourceUserQuarantineDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserQuarantine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_quarantine", obj)
+
 	_, err = c.UpdateUserQuarantine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing UserQuarantine resource: %v", err)
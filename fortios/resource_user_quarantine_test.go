@@ -48,7 +48,7 @@ func testAccCheckFortiOSUserQuarantineExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserQuarantine(i)
+		o, err := c.ReadUserQuarantine(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserQuarantine: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckUserQuarantineDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserQuarantine(i)
+		o, err := c.ReadUserQuarantine(i, "")
 
 		if err == nil {
 			if o != nil {
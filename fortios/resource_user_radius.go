@@ -26,7 +26,7 @@ func resourceUserRadius() *schema.Resource {
 		Delete: resourceUserRadiusDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -351,6 +351,8 @@ func resourceUserRadiusCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserRadius resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_radius", obj)
+
 	o, err := c.CreateUserRadius(obj, vdomparam)
 
 	if err != nil {
@@ -384,6 +386,8 @@ func resourceUserRadiusUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserRadius resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_radius", obj)
+
 	o, err := c.UpdateUserRadius(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserRadius resource: %v", err)
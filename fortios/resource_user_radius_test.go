@@ -74,7 +74,7 @@ func testAccCheckFortiOSUserRadiusExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserRadius(i)
+		o, err := c.ReadUserRadius(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserRadius: %s", err)
@@ -97,7 +97,7 @@ func testAccCheckUserRadiusDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserRadius(i)
+		o, err := c.ReadUserRadius(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserSaml() *schema.Resource {
 		Delete: resourceUserSamlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -149,6 +149,8 @@ func resourceUserSamlCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserSaml resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_saml", obj)
+
 	o, err := c.CreateUserSaml(obj, vdomparam)
 
 	if err != nil {
@@ -182,6 +184,8 @@ func resourceUserSamlUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserSaml resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_saml", obj)
+
 	o, err := c.UpdateUserSaml(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSaml resource: %v", err)
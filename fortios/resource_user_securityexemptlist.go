@@ -26,7 +26,7 @@ func resourceUserSecurityExemptList() *schema.Resource {
 		Delete: resourceUserSecurityExemptListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -143,6 +143,8 @@ func resourceUserSecurityExemptListCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating UserSecurityExemptList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_securityexemptlist", obj)
+
 	o, err := c.CreateUserSecurityExemptList(obj, vdomparam)
 
 	if err != nil {
@@ -176,6 +178,8 @@ func resourceUserSecurityExemptListUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating UserSecurityExemptList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_securityexemptlist", obj)
+
 	o, err := c.UpdateUserSecurityExemptList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSecurityExemptList resource: %v", err)
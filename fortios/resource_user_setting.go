@@ -26,7 +26,7 @@ func resourceUserSetting() *schema.Resource {
 		Delete: resourceUserSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -193,6 +193,8 @@ func resourceUserSettingUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_setting", obj)
+
 	o, err := c.UpdateUserSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserSetting resource: %v", err)
@@ -227,6 +229,8 @@ func resourceUserSettingDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_setting", obj)
+
 	_, err = c.UpdateUserSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing UserSetting resource: %v", err)
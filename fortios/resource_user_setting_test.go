@@ -62,7 +62,7 @@ func testAccCheckFortiOSUserSettingExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserSetting(i)
+		o, err := c.ReadUserSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserSetting: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckUserSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserSetting(i)
+		o, err := c.ReadUserSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceUserTacacs() *schema.Resource {
 		Delete: resourceUserTacacsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -132,6 +132,8 @@ func resourceUserTacacsCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating UserTacacs resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_tacacs", obj)
+
 	o, err := c.CreateUserTacacs(obj, vdomparam)
 
 	if err != nil {
@@ -165,6 +167,8 @@ func resourceUserTacacsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating UserTacacs resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_user_tacacs", obj)
+
 	o, err := c.UpdateUserTacacs(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating UserTacacs resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSUserTacacsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserTacacs(i)
+		o, err := c.ReadUserTacacs(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading UserTacacs: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckUserTacacsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadUserTacacs(i)
+		o, err := c.ReadUserTacacs(i, "")
 
 		if err == nil {
 			if o != nil {
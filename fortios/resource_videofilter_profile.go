@@ -26,7 +26,7 @@ func resourceVideofilterProfile() *schema.Resource {
 		Delete: resourceVideofilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceVideofilterProfileCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating VideofilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_profile", obj)
+
 	o, err := c.CreateVideofilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceVideofilterProfileUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating VideofilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_profile", obj)
+
 	o, err := c.UpdateVideofilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVideofilterYoutubeChannelFilter() *schema.Resource {
 		Delete: resourceVideofilterYoutubeChannelFilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/videofilter/youtube-channel-filter", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -117,6 +117,8 @@ func resourceVideofilterYoutubeChannelFilterCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating VideofilterYoutubeChannelFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_youtubechannelfilter", obj)
+
 	o, err := c.CreateVideofilterYoutubeChannelFilter(obj, vdomparam)
 
 	if err != nil {
@@ -150,6 +152,8 @@ func resourceVideofilterYoutubeChannelFilterUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating VideofilterYoutubeChannelFilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_youtubechannelfilter", obj)
+
 	o, err := c.UpdateVideofilterYoutubeChannelFilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterYoutubeChannelFilter resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVideofilterYoutubeKey() *schema.Resource {
 		Delete: resourceVideofilterYoutubeKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/videofilter/youtube-key", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceVideofilterYoutubeKeyCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating VideofilterYoutubeKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_youtubekey", obj)
+
 	o, err := c.CreateVideofilterYoutubeKey(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceVideofilterYoutubeKeyUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VideofilterYoutubeKey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_videofilter_youtubekey", obj)
+
 	o, err := c.UpdateVideofilterYoutubeKey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VideofilterYoutubeKey resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVoipProfile() *schema.Resource {
 		Delete: resourceVoipProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -723,6 +723,8 @@ func resourceVoipProfileCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VoipProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_voip_profile", obj)
+
 	o, err := c.CreateVoipProfile(obj, vdomparam)
 
 	if err != nil {
@@ -756,6 +758,8 @@ func resourceVoipProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VoipProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_voip_profile", obj)
+
 	o, err := c.UpdateVoipProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VoipProfile resource: %v", err)
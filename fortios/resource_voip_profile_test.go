@@ -101,7 +101,7 @@ func testAccCheckFortiOSVoipProfileExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVoipProfile(i)
+		o, err := c.ReadVoipProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VoipProfile: %s", err)
@@ -124,7 +124,7 @@ func testAccCheckVoipProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVoipProfile(i)
+		o, err := c.ReadVoipProfile(i, "")
 
 		if err == nil {
 			if o != nil {
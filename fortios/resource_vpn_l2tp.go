@@ -26,7 +26,7 @@ func resourceVpnL2Tp() *schema.Resource {
 		Delete: resourceVpnL2TpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -105,6 +105,8 @@ func resourceVpnL2TpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnL2Tp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_l2tp", obj)
+
 	o, err := c.UpdateVpnL2Tp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnL2Tp resource: %v", err)
@@ -139,6 +141,8 @@ func resourceVpnL2TpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnL2Tp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_l2tp", obj)
+
 	_, err = c.UpdateVpnL2Tp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing VpnL2Tp resource: %v", err)
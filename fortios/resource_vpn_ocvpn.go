@@ -26,7 +26,7 @@ func resourceVpnOcvpn() *schema.Resource {
 		Delete: resourceVpnOcvpnDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -266,6 +266,8 @@ func resourceVpnOcvpnUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnOcvpn resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_ocvpn", obj)
+
 	o, err := c.UpdateVpnOcvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnOcvpn resource: %v", err)
@@ -300,6 +302,8 @@ func resourceVpnOcvpnDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnOcvpn resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_ocvpn", obj)
+
 	_, err = c.UpdateVpnOcvpn(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing VpnOcvpn resource: %v", err)
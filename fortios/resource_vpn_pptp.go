@@ -26,7 +26,7 @@ func resourceVpnPptp() *schema.Resource {
 		Delete: resourceVpnPptpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceVpnPptpUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnPptp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_pptp", obj)
+
 	o, err := c.UpdateVpnPptp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnPptp resource: %v", err)
@@ -121,6 +123,8 @@ func resourceVpnPptpDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnPptp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpn_pptp", obj)
+
 	_, err = c.UpdateVpnPptp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing VpnPptp resource: %v", err)
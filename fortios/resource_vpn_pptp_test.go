@@ -53,7 +53,7 @@ func testAccCheckFortiOSVpnPptpExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnPptp(i)
+		o, err := c.ReadVpnPptp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnPptp: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckVpnPptpDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnPptp(i)
+		o, err := c.ReadVpnPptp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnCertificateCa() *schema.Resource {
 		Delete: resourceVpnCertificateCaDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +118,8 @@ func resourceVpnCertificateCaCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating VpnCertificateCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_ca", obj)
+
 	o, err := c.CreateVpnCertificateCa(obj, vdomparam)
 
 	if err != nil {
@@ -151,6 +153,8 @@ func resourceVpnCertificateCaUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating VpnCertificateCa resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_ca", obj)
+
 	o, err := c.UpdateVpnCertificateCa(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateCa resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVpnCertificateCrl() *schema.Resource {
 		Delete: resourceVpnCertificateCrlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -134,6 +134,8 @@ func resourceVpnCertificateCrlCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating VpnCertificateCrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_crl", obj)
+
 	o, err := c.CreateVpnCertificateCrl(obj, vdomparam)
 
 	if err != nil {
@@ -167,6 +169,8 @@ func resourceVpnCertificateCrlUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating VpnCertificateCrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_crl", obj)
+
 	o, err := c.UpdateVpnCertificateCrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateCrl resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVpnCertificateLocal() *schema.Resource {
 		Delete: resourceVpnCertificateLocalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -217,6 +217,8 @@ func resourceVpnCertificateLocalCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating VpnCertificateLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_local", obj)
+
 	o, err := c.CreateVpnCertificateLocal(obj, vdomparam)
 
 	if err != nil {
@@ -250,6 +252,8 @@ func resourceVpnCertificateLocalUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating VpnCertificateLocal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_local", obj)
+
 	o, err := c.UpdateVpnCertificateLocal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateLocal resource: %v", err)
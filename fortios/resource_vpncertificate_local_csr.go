@@ -0,0 +1,217 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceVpnCertificateLocalCsr() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnCertificateLocalCsrCreate,
+		Read:   resourceVpnCertificateLocalCsrRead,
+		Delete: resourceVpnCertificateLocalCsrDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 35),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"keytype": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "rsa",
+			},
+			"keysize": &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{1024, 1536, 2048, 4096}),
+				ForceNew:     true,
+				Optional:     true,
+				Default:      2048,
+			},
+			"curvename": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"secp256r1", "secp384r1", "secp521r1"}, false),
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "secp256r1",
+			},
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"orgunits": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ForceNew: true,
+				Optional: true,
+			},
+			"city": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"countrycode": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+				ForceNew:     true,
+				Optional:     true,
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"sub_alt_name": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"password": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+				ForceNew:     true,
+				Optional:     true,
+				Sensitive:    true,
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"vdom", "global"}, false),
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "vdom",
+			},
+			"csr": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnCertificateLocalCsrCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	name := d.Get("name").(string)
+
+	body := map[string]interface{}{
+		"certname": name,
+		"subject":  d.Get("subject").(string),
+		"keytype":  d.Get("keytype").(string),
+		"scope":    d.Get("scope").(string),
+	}
+	if body["keytype"] == "ec" {
+		body["curvename"] = d.Get("curvename").(string)
+	} else {
+		body["keysize"] = d.Get("keysize").(int)
+	}
+	for _, k := range []string{"org", "city", "state", "countrycode", "email", "sub_alt_name", "password"} {
+		if v, ok := d.GetOk(k); ok {
+			body[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("orgunits"); ok {
+		body["orgunits"] = v.([]interface{})
+	}
+
+	_, err := fortiGenericRequest(c, "POST", "/api/v2/monitor/vpn-certificate/csr/generate", "", body, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error generating the CSR of local certificate %s: %v", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceVpnCertificateLocalCsrRead(d, m)
+}
+
+func resourceVpnCertificateLocalCsrDelete(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	if err := certificateLocalDelete(c, d.Id(), vdomparam); err != nil {
+		return fmt.Errorf("Error deleting local certificate %s: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVpnCertificateLocalCsrRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	o, err := certificateLocalRead(c, d.Id(), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading local certificate %s: %v", d.Id(), err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the CSR is kept once the signed certificate is imported, whether FortiOS keeps it or not
+	if csr := cmdbObjectString(o["csr"]); csr != "" {
+		d.Set("csr", csr)
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceVpnCertificateLocalImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnCertificateLocalImportCreate,
+		Read:   resourceVpnCertificateLocalImportRead,
+		Update: resourceVpnCertificateLocalImportUpdate,
+		Delete: resourceVpnCertificateLocalImportDelete,
+
+		CustomizeDiff: resourceVpnCertificateLocalImportDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 35),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"certificate": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateCertificatePEM,
+				Optional:     true,
+				ExactlyOneOf: []string{"certificate", "pkcs12"},
+				RequiredWith: []string{"private_key"},
+			},
+			"private_key": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateCertificateKeyPEM,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"certificate"},
+			},
+			"pkcs12": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateCertificateBase64,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"certificate", "pkcs12"},
+			},
+			"password": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+				Optional:     true,
+				Sensitive:    true,
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"vdom", "global"}, false),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "vdom",
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// vpnCertificateLocalImportFingerprint returns the fingerprint of the certificate of the
+// PKCS#12 content pfx if set, of the PEM certificate otherwise. It is "" for PKCS#12
+// content the provider cannot decrypt, imported again whenever the content changes.
+func vpnCertificateLocalImportFingerprint(certificate, pfx, password string) (string, error) {
+	if pfx != "" {
+		return certificatePKCS12Fingerprint(pfx, password)
+	}
+	return certificateFingerprint(certificate)
+}
+
+func resourceVpnCertificateLocalImportDiff(d *schema.ResourceDiff, m interface{}) error {
+	for _, k := range []string{"certificate", "pkcs12", "password"} {
+		if !d.NewValueKnown(k) {
+			return certificateFingerprintDiff(d, "", false)
+		}
+	}
+
+	fp, err := vpnCertificateLocalImportFingerprint(d.Get("certificate").(string), d.Get("pkcs12").(string), d.Get("password").(string))
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %v", err)
+	}
+	if fp == "" {
+		if d.HasChange("pkcs12") || d.HasChange("password") {
+			return certificateFingerprintDiff(d, "", false)
+		}
+		return nil
+	}
+	return certificateFingerprintDiff(d, fp, true)
+}
+
+// vpnCertificateLocalImportKeys are the arguments with the content imported.
+var vpnCertificateLocalImportKeys = []string{"certificate", "private_key", "pkcs12", "password"}
+
+// vpnCertificateLocalImport imports the PEM certificate and key or the PKCS#12 content of
+// values, by argument name.
+func vpnCertificateLocalImport(c *forticlient.FortiSDKClient, name, scope string, values map[string]string, vdomparam string) error {
+	body := map[string]interface{}{
+		"certname": name,
+		"scope":    scope,
+	}
+	if v := values["password"]; v != "" {
+		body["password"] = v
+	}
+
+	if v := values["pkcs12"]; v != "" {
+		body["type"] = "pkcs12"
+		body["file_content"] = v
+	} else {
+		body["type"] = "regular"
+		body["file_content"] = certificateBase64(values["certificate"])
+		body["key_file_content"] = certificateBase64(values["private_key"])
+	}
+
+	return certificateLocalImport(c, body, vdomparam)
+}
+
+// vpnCertificateLocalImportValues returns the content configured, or the content in the
+// state before the change when old is set.
+func vpnCertificateLocalImportValues(d *schema.ResourceData, old bool) map[string]string {
+	res := make(map[string]string, len(vpnCertificateLocalImportKeys))
+	for _, k := range vpnCertificateLocalImportKeys {
+		o, n := d.GetChange(k)
+		if old {
+			res[k] = o.(string)
+		} else {
+			res[k] = n.(string)
+		}
+	}
+	return res
+}
+
+func resourceVpnCertificateLocalImportCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	name := d.Get("name").(string)
+
+	if err := vpnCertificateLocalImport(c, name, d.Get("scope").(string), vpnCertificateLocalImportValues(d, false), vdomparam); err != nil {
+		return fmt.Errorf("Error importing local certificate %s: %v", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceVpnCertificateLocalImportRead(d, m)
+}
+
+func resourceVpnCertificateLocalImportUpdate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	fp, err := vpnCertificateLocalImportFingerprint(d.Get("certificate").(string), d.Get("pkcs12").(string), d.Get("password").(string))
+	if err != nil {
+		return fmt.Errorf("Error updating local certificate %s: %v", d.Id(), err)
+	}
+
+	// the same certificate in another encoding or with another password is not imported again
+	if o, _ := d.GetChange("fingerprint"); fp != "" && o.(string) == fp {
+		return resourceVpnCertificateLocalImportRead(d, m)
+	}
+	if fp == "" && !d.HasChange("pkcs12") && !d.HasChange("password") {
+		return resourceVpnCertificateLocalImportRead(d, m)
+	}
+
+	log.Printf("[DEBUG] Importing local certificate %s again, fingerprint %s", d.Id(), fp)
+
+	name := d.Id()
+	scope := d.Get("scope").(string)
+
+	// the state keeps the certificate on the FortiGate when it is not replaced, so that the
+	// next apply imports the new one again
+	fail := func(err error) error {
+		for k, v := range vpnCertificateLocalImportValues(d, true) {
+			d.Set(k, v)
+		}
+		o, _ := d.GetChange("fingerprint")
+		d.Set("fingerprint", o)
+		return err
+	}
+
+	// FortiOS may refuse to import over an existing certificate, which is then deleted first
+	err = vpnCertificateLocalImport(c, name, scope, vpnCertificateLocalImportValues(d, false), vdomparam)
+	if err == nil {
+		return resourceVpnCertificateLocalImportRead(d, m)
+	}
+	log.Printf("[DEBUG] Cannot import local certificate %s over the existing one: %v", name, err)
+
+	if err := certificateLocalDelete(c, name, vdomparam); err != nil {
+		refs, uerr := objectUsage(c, certificateLocalPath, name, vdomparam)
+		if uerr == nil && len(refs) > 0 {
+			return fail(fmt.Errorf("Error importing local certificate %s again, it is left unchanged as it cannot be deleted while in use by:\n%s", name, objectUsageList(refs)))
+		}
+		return fail(fmt.Errorf("Error deleting local certificate %s to import it again: %v", name, err))
+	}
+
+	if err := vpnCertificateLocalImport(c, name, scope, vpnCertificateLocalImportValues(d, false), vdomparam); err != nil {
+		// the previous certificate is imported back not to lose it
+		if rerr := vpnCertificateLocalImport(c, name, scope, vpnCertificateLocalImportValues(d, true), vdomparam); rerr != nil {
+			d.SetId("")
+			return fmt.Errorf("Error importing local certificate %s: %v, and the previous certificate could not be imported back: %v", name, err, rerr)
+		}
+		return fail(fmt.Errorf("Error importing local certificate %s, the previous certificate was imported back: %v", name, err))
+	}
+
+	return resourceVpnCertificateLocalImportRead(d, m)
+}
+
+func resourceVpnCertificateLocalImportDelete(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	if err := certificateLocalDelete(c, d.Id(), vdomparam); err != nil {
+		return fmt.Errorf("Error deleting local certificate %s: %v", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVpnCertificateLocalImportRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	o, err := certificateLocalRead(c, d.Id(), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading local certificate %s: %v", d.Id(), err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// a certificate replaced on the FortiGate has another fingerprint and is imported again
+	d.Set("name", d.Id())
+	d.Set("fingerprint", certificateLocalFingerprint(o))
+
+	return nil
+}
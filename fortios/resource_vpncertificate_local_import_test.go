@@ -0,0 +1,149 @@
+package fortios
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestVpnCertificateLocalImportUpdate(t *testing.T) {
+	pemBlock := func(typ, content string) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: []byte(content)}))
+	}
+	oldCert, newCert := pemBlock("CERTIFICATE", "old"), pemBlock("CERTIFICATE", "new")
+	oldFP, _ := certificateFingerprint(oldCert)
+	newFP, _ := certificateFingerprint(newCert)
+
+	cases := []struct {
+		name string
+		// FortiOS imports over an existing certificate, the certificate is in use, the
+		// new certificate is invalid and the old one cannot be imported back
+		over, inUse, invalid, lost bool
+
+		requests    []string
+		fingerprint string
+		err         string
+	}{
+		{
+			name:        "imported over",
+			over:        true,
+			requests:    []string{"import new"},
+			fingerprint: newFP,
+		},
+		{
+			name:        "deleted and imported",
+			requests:    []string{"import new", "delete", "import new"},
+			fingerprint: newFP,
+		},
+		{
+			name:        "in use",
+			inUse:       true,
+			requests:    []string{"import new", "delete"},
+			fingerprint: oldFP,
+			err:         "in use by:\n  vpn.ssl/settings (servercert)",
+		},
+		{
+			name:        "imported back",
+			invalid:     true,
+			requests:    []string{"import new", "delete", "import new", "import old"},
+			fingerprint: oldFP,
+			err:         "the previous certificate was imported back",
+		},
+		{
+			name:     "lost",
+			invalid:  true,
+			lost:     true,
+			requests: []string{"import new", "delete", "import new", "import old"},
+			err:      "could not be imported back",
+		},
+	}
+
+	for _, c := range cases {
+		current := oldCert
+		var requests []string
+		m := testFortiClient(t, func(method, path string, query url.Values, body map[string]interface{}) (int, interface{}) {
+			switch {
+			case method == "POST" && path == "/api/v2/monitor/vpn-certificate/local/import":
+				content, _ := base64.StdEncoding.DecodeString(cmdbObjectString(body["file_content"]))
+				cert := "old"
+				if string(content) == newCert {
+					cert = "new"
+				}
+				requests = append(requests, "import "+cert)
+				if current != "" && !c.over || cert == "new" && c.invalid || cert == "old" && c.lost {
+					return http.StatusInternalServerError, nil
+				}
+				current = string(content)
+				return http.StatusOK, nil
+			case method == "DELETE" && path == "/api/v2/cmdb/vpn.certificate/local/web":
+				requests = append(requests, "delete")
+				if c.inUse {
+					return http.StatusFailedDependency, nil
+				}
+				current = ""
+				return http.StatusOK, nil
+			case method == "GET" && path == "/api/v2/monitor/system/object/usage":
+				return http.StatusOK, map[string]interface{}{
+					"currently_using": []interface{}{
+						map[string]interface{}{"path": "vpn.ssl", "name": "settings", "attribute": "servercert"},
+					},
+				}
+			case method == "GET" && path == "/api/v2/cmdb/vpn.certificate/local/web" && current != "":
+				return http.StatusOK, []interface{}{map[string]interface{}{"name": "web", "certificate": current}}
+			}
+			return http.StatusNotFound, nil
+		})
+
+		r := resourceVpnCertificateLocalImport()
+		state := &terraform.InstanceState{
+			ID: "web",
+			Attributes: map[string]string{
+				"id":          "web",
+				"name":        "web",
+				"certificate": oldCert,
+				"private_key": pemBlock("PRIVATE KEY", "old"),
+				"scope":       "vdom",
+				"fingerprint": oldFP,
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":        "web",
+			"certificate": newCert,
+			"private_key": pemBlock("PRIVATE KEY", "new"),
+		})
+
+		diff, err := r.Diff(state, config, m)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+		s, err := r.Apply(state, diff, m)
+
+		if c.err == "" && err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		} else if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%s: got error %v, want %q", c.name, err, c.err)
+		}
+		if strings.Join(requests, ", ") != strings.Join(c.requests, ", ") {
+			t.Errorf("%s: got requests %q, want %q", c.name, requests, c.requests)
+		}
+
+		if c.lost {
+			if s != nil && s.ID != "" {
+				t.Errorf("%s: certificate lost kept in the state", c.name)
+			}
+			continue
+		}
+		if s == nil || s.Attributes["fingerprint"] != c.fingerprint {
+			t.Errorf("%s: got state %v, want fingerprint %s", c.name, s, c.fingerprint)
+			continue
+		}
+		if c.fingerprint == oldFP && s.Attributes["certificate"] != oldCert {
+			t.Errorf("%s: certificate not imported kept in the state", c.name)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceVpnCertificateLocalSigned() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnCertificateLocalSignedCreate,
+		Read:   resourceVpnCertificateLocalSignedRead,
+		Update: resourceVpnCertificateLocalSignedUpdate,
+		Delete: resourceVpnCertificateLocalSignedDelete,
+
+		CustomizeDiff: resourceVpnCertificateLocalSignedDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 35),
+				ForceNew:     true,
+				Required:     true,
+			},
+			"certificate": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateCertificatePEM,
+				Required:     true,
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"vdom", "global"}, false),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "vdom",
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnCertificateLocalSignedDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("certificate") {
+		return certificateFingerprintDiff(d, "", false)
+	}
+
+	fp, err := certificateFingerprint(d.Get("certificate").(string))
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %v", err)
+	}
+	return certificateFingerprintDiff(d, fp, true)
+}
+
+// vpnCertificateLocalSignedImport imports the signed certificate of the CSR of the local
+// certificate, FortiOS matching them by their key, and checks it is the certificate of name.
+func vpnCertificateLocalSignedImport(c *forticlient.FortiSDKClient, d *schema.ResourceData, fp, vdomparam string) error {
+	name := d.Get("name").(string)
+
+	body := map[string]interface{}{
+		"type":         "local",
+		"file_content": certificateBase64(d.Get("certificate").(string)),
+		"scope":        d.Get("scope").(string),
+	}
+	if err := certificateLocalImport(c, body, vdomparam); err != nil {
+		return err
+	}
+
+	o, err := certificateLocalRead(c, name, vdomparam)
+	if err != nil {
+		return err
+	}
+	if o == nil {
+		return fmt.Errorf("local certificate %s not found", name)
+	}
+	if certificateLocalFingerprint(o) != fp {
+		return fmt.Errorf("the certificate is not the certificate of %s, it does not match the key of its CSR", name)
+	}
+
+	return nil
+}
+
+func resourceVpnCertificateLocalSignedCreate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	name := d.Get("name").(string)
+
+	fp, err := certificateFingerprint(d.Get("certificate").(string))
+	if err != nil {
+		return fmt.Errorf("Error importing the signed certificate of %s: %v", name, err)
+	}
+
+	if err := vpnCertificateLocalSignedImport(c, d, fp, vdomparam); err != nil {
+		return fmt.Errorf("Error importing the signed certificate of %s: %v", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceVpnCertificateLocalSignedRead(d, m)
+}
+
+func resourceVpnCertificateLocalSignedUpdate(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	fp, err := certificateFingerprint(d.Get("certificate").(string))
+	if err != nil {
+		return fmt.Errorf("Error importing the signed certificate of %s: %v", d.Id(), err)
+	}
+
+	// the same certificate in another encoding is not imported again
+	if o, _ := d.GetChange("fingerprint"); o.(string) == fp {
+		return resourceVpnCertificateLocalSignedRead(d, m)
+	}
+
+	if err := vpnCertificateLocalSignedImport(c, d, fp, vdomparam); err != nil {
+		return fmt.Errorf("Error importing the signed certificate of %s: %v", d.Id(), err)
+	}
+
+	return resourceVpnCertificateLocalSignedRead(d, m)
+}
+
+func resourceVpnCertificateLocalSignedDelete(d *schema.ResourceData, m interface{}) error {
+	// the certificate belongs to the local certificate of the CSR, deleted with it
+	log.Printf("[DEBUG] Removing the signed certificate of %s from state only", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVpnCertificateLocalSignedRead(d *schema.ResourceData, m interface{}) error {
+	c := m.(*FortiClient).Client
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	o, err := certificateLocalRead(c, d.Id(), vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading local certificate %s: %v", d.Id(), err)
+	}
+
+	if o == nil {
+		log.Printf("[WARN] resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	d.Set("fingerprint", certificateLocalFingerprint(o))
+
+	return nil
+}
@@ -26,7 +26,7 @@ func resourceVpnCertificateOcspServer() *schema.Resource {
 		Delete: resourceVpnCertificateOcspServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,6 +97,8 @@ func resourceVpnCertificateOcspServerCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating VpnCertificateOcspServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_ocspserver", obj)
+
 	o, err := c.CreateVpnCertificateOcspServer(obj, vdomparam)
 
 	if err != nil {
@@ -130,6 +132,8 @@ func resourceVpnCertificateOcspServerUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating VpnCertificateOcspServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_ocspserver", obj)
+
 	o, err := c.UpdateVpnCertificateOcspServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateOcspServer resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSVpnCertificateOcspServerExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnCertificateOcspServer(i)
+		o, err := c.ReadVpnCertificateOcspServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnCertificateOcspServer: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckVpnCertificateOcspServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnCertificateOcspServer(i)
+		o, err := c.ReadVpnCertificateOcspServer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnCertificateRemote() *schema.Resource {
 		Delete: resourceVpnCertificateRemoteDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,6 +77,8 @@ func resourceVpnCertificateRemoteCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating VpnCertificateRemote resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_remote", obj)
+
 	o, err := c.CreateVpnCertificateRemote(obj, vdomparam)
 
 	if err != nil {
@@ -110,6 +112,8 @@ func resourceVpnCertificateRemoteUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating VpnCertificateRemote resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_remote", obj)
+
 	o, err := c.UpdateVpnCertificateRemote(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateRemote resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVpnCertificateSetting() *schema.Resource {
 		Delete: resourceVpnCertificateSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -222,6 +222,8 @@ func resourceVpnCertificateSettingUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VpnCertificateSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_setting", obj)
+
 	o, err := c.UpdateVpnCertificateSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnCertificateSetting resource: %v", err)
@@ -256,6 +258,8 @@ func resourceVpnCertificateSettingDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VpnCertificateSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpncertificate_setting", obj)
+
 	_, err = c.UpdateVpnCertificateSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing VpnCertificateSetting resource: %v", err)
@@ -63,7 +63,7 @@ func testAccCheckFortiOSVpnCertificateSettingExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnCertificateSetting(i)
+		o, err := c.ReadVpnCertificateSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnCertificateSetting: %s", err)
@@ -86,7 +86,7 @@ func testAccCheckVpnCertificateSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnCertificateSetting(i)
+		o, err := c.ReadVpnCertificateSetting(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnIpsecConcentrator() *schema.Resource {
 		Delete: resourceVpnIpsecConcentratorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceVpnIpsecConcentratorCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error creating VpnIpsecConcentrator resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_concentrator", obj)
+
 	o, err := c.CreateVpnIpsecConcentrator(obj, vdomparam)
 
 	if err != nil {
@@ -126,6 +128,8 @@ func resourceVpnIpsecConcentratorUpdate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error updating VpnIpsecConcentrator resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_concentrator", obj)
+
 	o, err := c.UpdateVpnIpsecConcentrator(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecConcentrator resource: %v", err)
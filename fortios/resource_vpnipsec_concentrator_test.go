@@ -49,7 +49,7 @@ func testAccCheckFortiOSVpnIpsecConcentratorExists(n string) resource.TestCheckF
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecConcentrator(i)
+		o, err := c.ReadVpnIpsecConcentrator(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecConcentrator: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckVpnIpsecConcentratorDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecConcentrator(i)
+		o, err := c.ReadVpnIpsecConcentrator(i, "")
 
 		if err == nil {
 			if o != nil {
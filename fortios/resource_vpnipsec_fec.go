@@ -26,7 +26,7 @@ func resourceVpnIpsecFec() *schema.Resource {
 		Delete: resourceVpnIpsecFecDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -120,6 +120,8 @@ func resourceVpnIpsecFecCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VpnIpsecFec resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_fec", obj)
+
 	o, err := c.CreateVpnIpsecFec(obj, vdomparam)
 
 	if err != nil {
@@ -153,6 +155,8 @@ func resourceVpnIpsecFecUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecFec resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_fec", obj)
+
 	o, err := c.UpdateVpnIpsecFec(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecFec resource: %v", err)
@@ -26,7 +26,7 @@ func resourceVpnIpsecForticlient() *schema.Resource {
 		Delete: resourceVpnIpsecForticlientDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceVpnIpsecForticlientCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error creating VpnIpsecForticlient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_forticlient", obj)
+
 	o, err := c.CreateVpnIpsecForticlient(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceVpnIpsecForticlientUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating VpnIpsecForticlient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_forticlient", obj)
+
 	o, err := c.UpdateVpnIpsecForticlient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecForticlient resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSVpnIpsecForticlientExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecForticlient(i)
+		o, err := c.ReadVpnIpsecForticlient(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecForticlient: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckVpnIpsecForticlientDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecForticlient(i)
+		o, err := c.ReadVpnIpsecForticlient(i, "")
 
 		if err == nil {
 			if o != nil {
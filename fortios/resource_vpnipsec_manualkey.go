@@ -26,7 +26,7 @@ func resourceVpnIpsecManualkey() *schema.Resource {
 		Delete: resourceVpnIpsecManualkeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,6 +112,8 @@ func resourceVpnIpsecManualkeyCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating VpnIpsecManualkey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_manualkey", obj)
+
 	o, err := c.CreateVpnIpsecManualkey(obj, vdomparam)
 
 	if err != nil {
@@ -145,6 +147,8 @@ func resourceVpnIpsecManualkeyUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating VpnIpsecManualkey resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_manualkey", obj)
+
 	o, err := c.UpdateVpnIpsecManualkey(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecManualkey resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSVpnIpsecManualkeyExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecManualkey(i)
+		o, err := c.ReadVpnIpsecManualkey(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecManualkey: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckVpnIpsecManualkeyDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecManualkey(i)
+		o, err := c.ReadVpnIpsecManualkey(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnIpsecManualkeyInterface() *schema.Resource {
 		Delete: resourceVpnIpsecManualkeyInterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceVpnIpsecManualkeyInterfaceCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating VpnIpsecManualkeyInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_manualkeyinterface", obj)
+
 	o, err := c.CreateVpnIpsecManualkeyInterface(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceVpnIpsecManualkeyInterfaceUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnIpsecManualkeyInterface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_manualkeyinterface", obj)
+
 	o, err := c.UpdateVpnIpsecManualkeyInterface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecManualkeyInterface resource: %v", err)
@@ -61,7 +61,7 @@ func testAccCheckFortiOSVpnIpsecManualkeyInterfaceExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecManualkeyInterface(i)
+		o, err := c.ReadVpnIpsecManualkeyInterface(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecManualkeyInterface: %s", err)
@@ -84,7 +84,7 @@ func testAccCheckVpnIpsecManualkeyInterfaceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecManualkeyInterface(i)
+		o, err := c.ReadVpnIpsecManualkeyInterface(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnIpsecPhase1() *schema.Resource {
 		Delete: resourceVpnIpsecPhase1Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -741,6 +741,8 @@ func resourceVpnIpsecPhase1Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VpnIpsecPhase1 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase1", obj)
+
 	o, err := c.CreateVpnIpsecPhase1(obj, vdomparam)
 
 	if err != nil {
@@ -774,6 +776,8 @@ func resourceVpnIpsecPhase1Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecPhase1 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase1", obj)
+
 	o, err := c.UpdateVpnIpsecPhase1(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase1 resource: %v", err)
@@ -119,7 +119,7 @@ func testAccCheckFortiOSVpnIpsecPhase1Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase1(i)
+		o, err := c.ReadVpnIpsecPhase1(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecPhase1: %s", err)
@@ -142,7 +142,7 @@ func testAccCheckVpnIpsecPhase1Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase1(i)
+		o, err := c.ReadVpnIpsecPhase1(i, "")
 
 		if err == nil {
 			if o != nil {
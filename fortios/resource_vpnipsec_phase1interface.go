@@ -26,7 +26,7 @@ func resourceVpnIpsecPhase1Interface() *schema.Resource {
 		Delete: resourceVpnIpsecPhase1InterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -900,6 +900,8 @@ func resourceVpnIpsecPhase1InterfaceCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating VpnIpsecPhase1Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase1interface", obj)
+
 	o, err := c.CreateVpnIpsecPhase1Interface(obj, vdomparam)
 
 	if err != nil {
@@ -933,6 +935,8 @@ func resourceVpnIpsecPhase1InterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating VpnIpsecPhase1Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase1interface", obj)
+
 	o, err := c.UpdateVpnIpsecPhase1Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase1Interface resource: %v", err)
@@ -144,7 +144,7 @@ func testAccCheckFortiOSVpnIpsecPhase1InterfaceExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase1Interface(i)
+		o, err := c.ReadVpnIpsecPhase1Interface(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecPhase1Interface: %s", err)
@@ -167,7 +167,7 @@ func testAccCheckVpnIpsecPhase1InterfaceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase1Interface(i)
+		o, err := c.ReadVpnIpsecPhase1Interface(i, "")
 
 		if err == nil {
 			if o != nil {
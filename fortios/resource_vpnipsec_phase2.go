@@ -26,7 +26,7 @@ func resourceVpnIpsecPhase2() *schema.Resource {
 		Delete: resourceVpnIpsecPhase2Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -289,6 +289,8 @@ func resourceVpnIpsecPhase2Create(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VpnIpsecPhase2 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase2", obj)
+
 	o, err := c.CreateVpnIpsecPhase2(obj, vdomparam)
 
 	if err != nil {
@@ -322,6 +324,8 @@ func resourceVpnIpsecPhase2Update(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnIpsecPhase2 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase2", obj)
+
 	o, err := c.UpdateVpnIpsecPhase2(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase2 resource: %v", err)
@@ -85,7 +85,7 @@ func testAccCheckFortiOSVpnIpsecPhase2Exists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase2(i)
+		o, err := c.ReadVpnIpsecPhase2(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecPhase2: %s", err)
@@ -108,7 +108,7 @@ func testAccCheckVpnIpsecPhase2Destroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase2(i)
+		o, err := c.ReadVpnIpsecPhase2(i, "")
 
 		if err == nil {
 			if o != nil {
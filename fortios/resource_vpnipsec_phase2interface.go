@@ -26,7 +26,7 @@ func resourceVpnIpsecPhase2Interface() *schema.Resource {
 		Delete: resourceVpnIpsecPhase2InterfaceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -289,6 +289,8 @@ func resourceVpnIpsecPhase2InterfaceCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error creating VpnIpsecPhase2Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase2interface", obj)
+
 	o, err := c.CreateVpnIpsecPhase2Interface(obj, vdomparam)
 
 	if err != nil {
@@ -322,6 +324,8 @@ func resourceVpnIpsecPhase2InterfaceUpdate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error updating VpnIpsecPhase2Interface resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnipsec_phase2interface", obj)
+
 	o, err := c.UpdateVpnIpsecPhase2Interface(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnIpsecPhase2Interface resource: %v", err)
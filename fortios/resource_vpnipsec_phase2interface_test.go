@@ -77,7 +77,7 @@ func testAccCheckFortiOSVpnIpsecPhase2InterfaceExists(n string) resource.TestChe
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase2Interface(i)
+		o, err := c.ReadVpnIpsecPhase2Interface(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnIpsecPhase2Interface: %s", err)
@@ -100,7 +100,7 @@ func testAccCheckVpnIpsecPhase2InterfaceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnIpsecPhase2Interface(i)
+		o, err := c.ReadVpnIpsecPhase2Interface(i, "")
 
 		if err == nil {
 			if o != nil {
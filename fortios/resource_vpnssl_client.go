@@ -26,7 +26,7 @@ func resourceVpnSslClient() *schema.Resource {
 		Delete: resourceVpnSslClientDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +136,8 @@ func resourceVpnSslClientCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VpnSslClient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnssl_client", obj)
+
 	o, err := c.CreateVpnSslClient(obj, vdomparam)
 
 	if err != nil {
@@ -169,6 +171,8 @@ func resourceVpnSslClientUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslClient resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnssl_client", obj)
+
 	o, err := c.UpdateVpnSslClient(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslClient resource: %v", err)
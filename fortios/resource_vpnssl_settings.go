@@ -26,7 +26,7 @@ func resourceVpnSslSettings() *schema.Resource {
 		Delete: resourceVpnSslSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -590,6 +590,8 @@ func resourceVpnSslSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnssl_settings", obj)
+
 	o, err := c.UpdateVpnSslSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslSettings resource: %v", err)
@@ -624,6 +626,8 @@ func resourceVpnSslSettingsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnssl_settings", obj)
+
 	_, err = c.UpdateVpnSslSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing VpnSslSettings resource: %v", err)
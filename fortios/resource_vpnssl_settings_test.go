@@ -52,7 +52,7 @@ func testAccCheckFortiOSVpnSslSettingsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslSettings(i)
+		o, err := c.ReadVpnSslSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslSettings: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckVpnSslSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslSettings(i)
+		o, err := c.ReadVpnSslSettings(i, "")
 
 		if err == nil {
 			if o != nil {
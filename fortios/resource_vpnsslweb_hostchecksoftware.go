@@ -26,7 +26,7 @@ func resourceVpnSslWebHostCheckSoftware() *schema.Resource {
 		Delete: resourceVpnSslWebHostCheckSoftwareDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -139,6 +139,8 @@ func resourceVpnSslWebHostCheckSoftwareCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating VpnSslWebHostCheckSoftware resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_hostchecksoftware", obj)
+
 	o, err := c.CreateVpnSslWebHostCheckSoftware(obj, vdomparam)
 
 	if err != nil {
@@ -172,6 +174,8 @@ func resourceVpnSslWebHostCheckSoftwareUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnSslWebHostCheckSoftware resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_hostchecksoftware", obj)
+
 	o, err := c.UpdateVpnSslWebHostCheckSoftware(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebHostCheckSoftware resource: %v", err)
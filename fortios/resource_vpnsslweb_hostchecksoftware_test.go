@@ -50,7 +50,7 @@ func testAccCheckFortiOSVpnSslWebHostCheckSoftwareExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebHostCheckSoftware(i)
+		o, err := c.ReadVpnSslWebHostCheckSoftware(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslWebHostCheckSoftware: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckVpnSslWebHostCheckSoftwareDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebHostCheckSoftware(i)
+		o, err := c.ReadVpnSslWebHostCheckSoftware(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceVpnSslWebPortal() *schema.Resource {
 		Delete: resourceVpnSslWebPortalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -731,6 +731,8 @@ func resourceVpnSslWebPortalCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating VpnSslWebPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_portal", obj)
+
 	o, err := c.CreateVpnSslWebPortal(obj, vdomparam)
 
 	if err != nil {
@@ -764,6 +766,8 @@ func resourceVpnSslWebPortalUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating VpnSslWebPortal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_portal", obj)
+
 	o, err := c.UpdateVpnSslWebPortal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebPortal resource: %v", err)
@@ -93,7 +93,7 @@ func testAccCheckFortiOSVpnSslWebPortalExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebPortal(i)
+		o, err := c.ReadVpnSslWebPortal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslWebPortal: %s", err)
@@ -116,7 +116,7 @@ func testAccCheckVpnSslWebPortalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebPortal(i)
+		o, err := c.ReadVpnSslWebPortal(i, "")
 
 		if err == nil {
 			if o != nil {
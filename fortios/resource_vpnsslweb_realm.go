@@ -26,7 +26,7 @@ func resourceVpnSslWebRealm() *schema.Resource {
 		Delete: resourceVpnSslWebRealmDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -107,6 +107,8 @@ func resourceVpnSslWebRealmCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating VpnSslWebRealm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_realm", obj)
+
 	o, err := c.CreateVpnSslWebRealm(obj, vdomparam)
 
 	if err != nil {
@@ -140,6 +142,8 @@ func resourceVpnSslWebRealmUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating VpnSslWebRealm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_realm", obj)
+
 	o, err := c.UpdateVpnSslWebRealm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebRealm resource: %v", err)
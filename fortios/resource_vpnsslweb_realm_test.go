@@ -51,7 +51,7 @@ func testAccCheckFortiOSVpnSslWebRealmExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebRealm(i)
+		o, err := c.ReadVpnSslWebRealm(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslWebRealm: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckVpnSslWebRealmDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebRealm(i)
+		o, err := c.ReadVpnSslWebRealm(i, "")
 
 		if err == nil {
 			if o != nil {
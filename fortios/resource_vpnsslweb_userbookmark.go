@@ -26,7 +26,7 @@ func resourceVpnSslWebUserBookmark() *schema.Resource {
 		Delete: resourceVpnSslWebUserBookmarkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -259,6 +259,8 @@ func resourceVpnSslWebUserBookmarkCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating VpnSslWebUserBookmark resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_userbookmark", obj)
+
 	o, err := c.CreateVpnSslWebUserBookmark(obj, vdomparam)
 
 	if err != nil {
@@ -292,6 +294,8 @@ func resourceVpnSslWebUserBookmarkUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating VpnSslWebUserBookmark resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_userbookmark", obj)
+
 	o, err := c.UpdateVpnSslWebUserBookmark(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebUserBookmark resource: %v", err)
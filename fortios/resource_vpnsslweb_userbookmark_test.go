@@ -49,7 +49,7 @@ func testAccCheckFortiOSVpnSslWebUserBookmarkExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebUserBookmark(i)
+		o, err := c.ReadVpnSslWebUserBookmark(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslWebUserBookmark: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckVpnSslWebUserBookmarkDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebUserBookmark(i)
+		o, err := c.ReadVpnSslWebUserBookmark(i, "")
 
 		if err == nil {
 			if o != nil {
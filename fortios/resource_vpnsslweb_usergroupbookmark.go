@@ -26,7 +26,7 @@ func resourceVpnSslWebUserGroupBookmark() *schema.Resource {
 		Delete: resourceVpnSslWebUserGroupBookmarkDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -253,6 +253,8 @@ func resourceVpnSslWebUserGroupBookmarkCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating VpnSslWebUserGroupBookmark resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_usergroupbookmark", obj)
+
 	o, err := c.CreateVpnSslWebUserGroupBookmark(obj, vdomparam)
 
 	if err != nil {
@@ -286,6 +288,8 @@ func resourceVpnSslWebUserGroupBookmarkUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating VpnSslWebUserGroupBookmark resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_vpnsslweb_usergroupbookmark", obj)
+
 	o, err := c.UpdateVpnSslWebUserGroupBookmark(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating VpnSslWebUserGroupBookmark resource: %v", err)
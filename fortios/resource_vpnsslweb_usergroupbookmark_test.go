@@ -60,7 +60,7 @@ func testAccCheckFortiOSVpnSslWebUserGroupBookmarkExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebUserGroupBookmark(i)
+		o, err := c.ReadVpnSslWebUserGroupBookmark(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading VpnSslWebUserGroupBookmark: %s", err)
@@ -83,7 +83,7 @@ func testAccCheckVpnSslWebUserGroupBookmarkDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadVpnSslWebUserGroupBookmark(i)
+		o, err := c.ReadVpnSslWebUserGroupBookmark(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWafMainClass() *schema.Resource {
 		Delete: resourceWafMainClassDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/waf/main-class", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceWafMainClassCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WafMainClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_mainclass", obj)
+
 	o, err := c.CreateWafMainClass(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceWafMainClassUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafMainClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_mainclass", obj)
+
 	o, err := c.UpdateWafMainClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafMainClass resource: %v", err)
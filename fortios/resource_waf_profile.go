@@ -26,7 +26,7 @@ func resourceWafProfile() *schema.Resource {
 		Delete: resourceWafProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -923,6 +923,8 @@ func resourceWafProfileCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WafProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_profile", obj)
+
 	o, err := c.CreateWafProfile(obj, vdomparam)
 
 	if err != nil {
@@ -956,6 +958,8 @@ func resourceWafProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_profile", obj)
+
 	o, err := c.UpdateWafProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafProfile resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWafProfileExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWafProfile(i)
+		o, err := c.ReadWafProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WafProfile: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWafProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWafProfile(i)
+		o, err := c.ReadWafProfile(i, "")
 
 		if err == nil {
 			if o != nil {
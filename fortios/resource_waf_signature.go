@@ -26,7 +26,7 @@ func resourceWafSignature() *schema.Resource {
 		Delete: resourceWafSignatureDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/waf/signature", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceWafSignatureCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WafSignature resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_signature", obj)
+
 	o, err := c.CreateWafSignature(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceWafSignatureUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafSignature resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_signature", obj)
+
 	o, err := c.UpdateWafSignature(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafSignature resource: %v", err)
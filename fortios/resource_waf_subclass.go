@@ -26,7 +26,7 @@ func resourceWafSubClass() *schema.Resource {
 		Delete: resourceWafSubClassDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/waf/sub-class", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceWafSubClassCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WafSubClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_subclass", obj)
+
 	o, err := c.CreateWafSubClass(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceWafSubClassUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WafSubClass resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_waf_subclass", obj)
+
 	o, err := c.UpdateWafSubClass(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WafSubClass resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWanoptAuthGroup() *schema.Resource {
 		Delete: resourceWanoptAuthGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,6 +90,8 @@ func resourceWanoptAuthGroupCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WanoptAuthGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_authgroup", obj)
+
 	o, err := c.CreateWanoptAuthGroup(obj, vdomparam)
 
 	if err != nil {
@@ -123,6 +125,8 @@ func resourceWanoptAuthGroupUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WanoptAuthGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_authgroup", obj)
+
 	o, err := c.UpdateWanoptAuthGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptAuthGroup resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSWanoptAuthGroupExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptAuthGroup(i)
+		o, err := c.ReadWanoptAuthGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptAuthGroup: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckWanoptAuthGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptAuthGroup(i)
+		o, err := c.ReadWanoptAuthGroup(i, "")
 
 		if err == nil {
 			if o != nil {
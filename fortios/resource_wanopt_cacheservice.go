@@ -26,7 +26,7 @@ func resourceWanoptCacheService() *schema.Resource {
 		Delete: resourceWanoptCacheServiceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -157,6 +157,8 @@ func resourceWanoptCacheServiceUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating WanoptCacheService resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_cacheservice", obj)
+
 	o, err := c.UpdateWanoptCacheService(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptCacheService resource: %v", err)
@@ -191,6 +193,8 @@ func resourceWanoptCacheServiceDelete(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating WanoptCacheService resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_cacheservice", obj)
+
 	_, err = c.UpdateWanoptCacheService(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WanoptCacheService resource: %v", err)
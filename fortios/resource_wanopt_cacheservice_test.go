@@ -51,7 +51,7 @@ func testAccCheckFortiOSWanoptCacheServiceExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptCacheService(i)
+		o, err := c.ReadWanoptCacheService(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptCacheService: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckWanoptCacheServiceDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptCacheService(i)
+		o, err := c.ReadWanoptCacheService(i, "")
 
 		if err == nil {
 			if o != nil {
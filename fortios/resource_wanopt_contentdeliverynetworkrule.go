@@ -26,7 +26,7 @@ func resourceWanoptContentDeliveryNetworkRule() *schema.Resource {
 		Delete: resourceWanoptContentDeliveryNetworkRuleDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -262,6 +262,8 @@ func resourceWanoptContentDeliveryNetworkRuleCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating WanoptContentDeliveryNetworkRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_contentdeliverynetworkrule", obj)
+
 	o, err := c.CreateWanoptContentDeliveryNetworkRule(obj, vdomparam)
 
 	if err != nil {
@@ -295,6 +297,8 @@ func resourceWanoptContentDeliveryNetworkRuleUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating WanoptContentDeliveryNetworkRule resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_contentdeliverynetworkrule", obj)
+
 	o, err := c.UpdateWanoptContentDeliveryNetworkRule(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptContentDeliveryNetworkRule resource: %v", err)
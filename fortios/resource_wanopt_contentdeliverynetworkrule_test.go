@@ -56,7 +56,7 @@ func testAccCheckFortiOSWanoptContentDeliveryNetworkRuleExists(n string) resourc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptContentDeliveryNetworkRule(i)
+		o, err := c.ReadWanoptContentDeliveryNetworkRule(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptContentDeliveryNetworkRule: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckWanoptContentDeliveryNetworkRuleDestroy(s *terraform.State) err
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptContentDeliveryNetworkRule(i)
+		o, err := c.ReadWanoptContentDeliveryNetworkRule(i, "")
 
 		if err == nil {
 			if o != nil {
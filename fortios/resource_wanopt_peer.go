@@ -26,7 +26,7 @@ func resourceWanoptPeer() *schema.Resource {
 		Delete: resourceWanoptPeerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,6 +68,8 @@ func resourceWanoptPeerCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WanoptPeer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_peer", obj)
+
 	o, err := c.CreateWanoptPeer(obj, vdomparam)
 
 	if err != nil {
@@ -101,6 +103,8 @@ func resourceWanoptPeerUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptPeer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_peer", obj)
+
 	o, err := c.UpdateWanoptPeer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptPeer resource: %v", err)
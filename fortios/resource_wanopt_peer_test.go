@@ -49,7 +49,7 @@ func testAccCheckFortiOSWanoptPeerExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptPeer(i)
+		o, err := c.ReadWanoptPeer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptPeer: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWanoptPeerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptPeer(i)
+		o, err := c.ReadWanoptPeer(i, "")
 
 		if err == nil {
 			if o != nil {
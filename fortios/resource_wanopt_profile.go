@@ -26,7 +26,7 @@ func resourceWanoptProfile() *schema.Resource {
 		Delete: resourceWanoptProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -350,6 +350,8 @@ func resourceWanoptProfileCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WanoptProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_profile", obj)
+
 	o, err := c.CreateWanoptProfile(obj, vdomparam)
 
 	if err != nil {
@@ -383,6 +385,8 @@ func resourceWanoptProfileUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_profile", obj)
+
 	o, err := c.UpdateWanoptProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptProfile resource: %v", err)
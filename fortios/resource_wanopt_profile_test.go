@@ -90,7 +90,7 @@ func testAccCheckFortiOSWanoptProfileExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptProfile(i)
+		o, err := c.ReadWanoptProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptProfile: %s", err)
@@ -113,7 +113,7 @@ func testAccCheckWanoptProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptProfile(i)
+		o, err := c.ReadWanoptProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWanoptRemoteStorage() *schema.Resource {
 		Delete: resourceWanoptRemoteStorageDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceWanoptRemoteStorageUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WanoptRemoteStorage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_remotestorage", obj)
+
 	o, err := c.UpdateWanoptRemoteStorage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptRemoteStorage resource: %v", err)
@@ -113,6 +115,8 @@ func resourceWanoptRemoteStorageDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WanoptRemoteStorage resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_remotestorage", obj)
+
 	_, err = c.UpdateWanoptRemoteStorage(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WanoptRemoteStorage resource: %v", err)
@@ -49,7 +49,7 @@ func testAccCheckFortiOSWanoptRemoteStorageExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptRemoteStorage(i)
+		o, err := c.ReadWanoptRemoteStorage(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptRemoteStorage: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWanoptRemoteStorageDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptRemoteStorage(i)
+		o, err := c.ReadWanoptRemoteStorage(i, "")
 
 		if err == nil {
 			if o != nil {
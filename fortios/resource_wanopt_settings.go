@@ -26,7 +26,7 @@ func resourceWanoptSettings() *schema.Resource {
 		Delete: resourceWanoptSettingsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,6 +72,8 @@ func resourceWanoptSettingsUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_settings", obj)
+
 	o, err := c.UpdateWanoptSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptSettings resource: %v", err)
@@ -106,6 +108,8 @@ func resourceWanoptSettingsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptSettings resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_settings", obj)
+
 	_, err = c.UpdateWanoptSettings(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WanoptSettings resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWanoptSettingsExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptSettings(i)
+		o, err := c.ReadWanoptSettings(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptSettings: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWanoptSettingsDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptSettings(i)
+		o, err := c.ReadWanoptSettings(i, "")
 
 		if err == nil {
 			if o != nil {
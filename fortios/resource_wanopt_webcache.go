@@ -26,7 +26,7 @@ func resourceWanoptWebcache() *schema.Resource {
 		Delete: resourceWanoptWebcacheDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -147,6 +147,8 @@ func resourceWanoptWebcacheUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptWebcache resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_webcache", obj)
+
 	o, err := c.UpdateWanoptWebcache(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WanoptWebcache resource: %v", err)
@@ -181,6 +183,8 @@ func resourceWanoptWebcacheDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WanoptWebcache resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wanopt_webcache", obj)
+
 	_, err = c.UpdateWanoptWebcache(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WanoptWebcache resource: %v", err)
@@ -64,7 +64,7 @@ func testAccCheckFortiOSWanoptWebcacheExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptWebcache(i)
+		o, err := c.ReadWanoptWebcache(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WanoptWebcache: %s", err)
@@ -87,7 +87,7 @@ func testAccCheckWanoptWebcacheDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWanoptWebcache(i)
+		o, err := c.ReadWanoptWebcache(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebfilterContent() *schema.Resource {
 		Delete: resourceWebfilterContentDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/webfilter/content", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,6 +115,8 @@ func resourceWebfilterContentCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WebfilterContent resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_content", obj)
+
 	o, err := c.CreateWebfilterContent(obj, vdomparam)
 
 	if err != nil {
@@ -148,6 +150,8 @@ func resourceWebfilterContentUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebfilterContent resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_content", obj)
+
 	o, err := c.UpdateWebfilterContent(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterContent resource: %v", err)
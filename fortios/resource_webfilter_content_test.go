@@ -49,7 +49,7 @@ func testAccCheckFortiOSWebfilterContentExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterContent(i)
+		o, err := c.ReadWebfilterContent(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterContent: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWebfilterContentDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterContent(i)
+		o, err := c.ReadWebfilterContent(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebfilterContentHeader() *schema.Resource {
 		Delete: resourceWebfilterContentHeaderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/webfilter/content-header", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,6 +100,8 @@ func resourceWebfilterContentHeaderCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WebfilterContentHeader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_contentheader", obj)
+
 	o, err := c.CreateWebfilterContentHeader(obj, vdomparam)
 
 	if err != nil {
@@ -133,6 +135,8 @@ func resourceWebfilterContentHeaderUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterContentHeader resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_contentheader", obj)
+
 	o, err := c.UpdateWebfilterContentHeader(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterContentHeader resource: %v", err)
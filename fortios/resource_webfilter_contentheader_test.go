@@ -49,7 +49,7 @@ func testAccCheckFortiOSWebfilterContentHeaderExists(n string) resource.TestChec
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterContentHeader(i)
+		o, err := c.ReadWebfilterContentHeader(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterContentHeader: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWebfilterContentHeaderDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterContentHeader(i)
+		o, err := c.ReadWebfilterContentHeader(i, "")
 
 		if err == nil {
 			if o != nil {
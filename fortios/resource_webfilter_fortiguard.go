@@ -26,7 +26,7 @@ func resourceWebfilterFortiguard() *schema.Resource {
 		Delete: resourceWebfilterFortiguardDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,6 +124,8 @@ func resourceWebfilterFortiguardUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WebfilterFortiguard resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_fortiguard", obj)
+
 	o, err := c.UpdateWebfilterFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFortiguard resource: %v", err)
@@ -158,6 +160,8 @@ func resourceWebfilterFortiguardDelete(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error updating WebfilterFortiguard resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_fortiguard", obj)
+
 	_, err = c.UpdateWebfilterFortiguard(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebfilterFortiguard resource: %v", err)
@@ -58,7 +58,7 @@ func testAccCheckFortiOSWebfilterFortiguardExists(n string) resource.TestCheckFu
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFortiguard(i)
+		o, err := c.ReadWebfilterFortiguard(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterFortiguard: %s", err)
@@ -81,7 +81,7 @@ func testAccCheckWebfilterFortiguardDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFortiguard(i)
+		o, err := c.ReadWebfilterFortiguard(i, "")
 
 		if err == nil {
 			if o != nil {
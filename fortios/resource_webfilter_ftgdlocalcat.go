@@ -26,7 +26,7 @@ func resourceWebfilterFtgdLocalCat() *schema.Resource {
 		Delete: resourceWebfilterFtgdLocalCatDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceWebfilterFtgdLocalCatCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WebfilterFtgdLocalCat resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ftgdlocalcat", obj)
+
 	o, err := c.CreateWebfilterFtgdLocalCat(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceWebfilterFtgdLocalCatUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterFtgdLocalCat resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ftgdlocalcat", obj)
+
 	o, err := c.UpdateWebfilterFtgdLocalCat(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFtgdLocalCat resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWebfilterFtgdLocalCatExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFtgdLocalCat(i)
+		o, err := c.ReadWebfilterFtgdLocalCat(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterFtgdLocalCat: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWebfilterFtgdLocalCatDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFtgdLocalCat(i)
+		o, err := c.ReadWebfilterFtgdLocalCat(i, "")
 
 		if err == nil {
 			if o != nil {
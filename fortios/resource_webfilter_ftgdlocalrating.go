@@ -26,7 +26,7 @@ func resourceWebfilterFtgdLocalRating() *schema.Resource {
 		Delete: resourceWebfilterFtgdLocalRatingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,6 +77,8 @@ func resourceWebfilterFtgdLocalRatingCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating WebfilterFtgdLocalRating resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ftgdlocalrating", obj)
+
 	o, err := c.CreateWebfilterFtgdLocalRating(obj, vdomparam)
 
 	if err != nil {
@@ -110,6 +112,8 @@ func resourceWebfilterFtgdLocalRatingUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WebfilterFtgdLocalRating resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ftgdlocalrating", obj)
+
 	o, err := c.UpdateWebfilterFtgdLocalRating(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterFtgdLocalRating resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWebfilterFtgdLocalRatingExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFtgdLocalRating(i)
+		o, err := c.ReadWebfilterFtgdLocalRating(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterFtgdLocalRating: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWebfilterFtgdLocalRatingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterFtgdLocalRating(i)
+		o, err := c.ReadWebfilterFtgdLocalRating(i, "")
 
 		if err == nil {
 			if o != nil {
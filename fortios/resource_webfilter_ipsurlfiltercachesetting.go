@@ -26,7 +26,7 @@ func resourceWebfilterIpsUrlfilterCacheSetting() *schema.Resource {
 		Delete: resourceWebfilterIpsUrlfilterCacheSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,6 +69,8 @@ func resourceWebfilterIpsUrlfilterCacheSettingUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterCacheSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltercachesetting", obj)
+
 	o, err := c.UpdateWebfilterIpsUrlfilterCacheSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterCacheSetting resource: %v", err)
@@ -103,6 +105,8 @@ func resourceWebfilterIpsUrlfilterCacheSettingDelete(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterCacheSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltercachesetting", obj)
+
 	_, err = c.UpdateWebfilterIpsUrlfilterCacheSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebfilterIpsUrlfilterCacheSetting resource: %v", err)
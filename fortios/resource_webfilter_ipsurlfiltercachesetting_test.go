@@ -49,7 +49,7 @@ func testAccCheckFortiOSWebfilterIpsUrlfilterCacheSettingExists(n string) resour
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterCacheSetting(i)
+		o, err := c.ReadWebfilterIpsUrlfilterCacheSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterIpsUrlfilterCacheSetting: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWebfilterIpsUrlfilterCacheSettingDestroy(s *terraform.State) er
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterCacheSetting(i)
+		o, err := c.ReadWebfilterIpsUrlfilterCacheSetting(i, "")
 
 		if err == nil {
 			if o != nil {
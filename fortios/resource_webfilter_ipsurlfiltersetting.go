@@ -26,7 +26,7 @@ func resourceWebfilterIpsUrlfilterSetting() *schema.Resource {
 		Delete: resourceWebfilterIpsUrlfilterSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceWebfilterIpsUrlfilterSettingUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltersetting", obj)
+
 	o, err := c.UpdateWebfilterIpsUrlfilterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting resource: %v", err)
@@ -113,6 +115,8 @@ func resourceWebfilterIpsUrlfilterSettingDelete(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltersetting", obj)
+
 	_, err = c.UpdateWebfilterIpsUrlfilterSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebfilterIpsUrlfilterSetting resource: %v", err)
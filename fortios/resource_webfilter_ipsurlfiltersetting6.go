@@ -26,7 +26,7 @@ func resourceWebfilterIpsUrlfilterSetting6() *schema.Resource {
 		Delete: resourceWebfilterIpsUrlfilterSetting6Delete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,6 +79,8 @@ func resourceWebfilterIpsUrlfilterSetting6Update(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltersetting6", obj)
+
 	o, err := c.UpdateWebfilterIpsUrlfilterSetting6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting6 resource: %v", err)
@@ -113,6 +115,8 @@ func resourceWebfilterIpsUrlfilterSetting6Delete(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WebfilterIpsUrlfilterSetting6 resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_ipsurlfiltersetting6", obj)
+
 	_, err = c.UpdateWebfilterIpsUrlfilterSetting6(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebfilterIpsUrlfilterSetting6 resource: %v", err)
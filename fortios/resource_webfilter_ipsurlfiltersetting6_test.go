@@ -49,7 +49,7 @@ func testAccCheckFortiOSWebfilterIpsUrlfilterSetting6Exists(n string) resource.T
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterSetting6(i)
+		o, err := c.ReadWebfilterIpsUrlfilterSetting6(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterIpsUrlfilterSetting6: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWebfilterIpsUrlfilterSetting6Destroy(s *terraform.State) error
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterSetting6(i)
+		o, err := c.ReadWebfilterIpsUrlfilterSetting6(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -49,7 +49,7 @@ func testAccCheckFortiOSWebfilterIpsUrlfilterSettingExists(n string) resource.Te
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterSetting(i)
+		o, err := c.ReadWebfilterIpsUrlfilterSetting(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterIpsUrlfilterSetting: %s", err)
@@ -72,7 +72,7 @@ func testAccCheckWebfilterIpsUrlfilterSettingDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterIpsUrlfilterSetting(i)
+		o, err := c.ReadWebfilterIpsUrlfilterSetting(i, "")
 
 		if err == nil {
 			if o != nil {
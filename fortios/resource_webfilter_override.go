@@ -26,7 +26,7 @@ func resourceWebfilterOverride() *schema.Resource {
 		Delete: resourceWebfilterOverrideDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/webfilter/override", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,6 +113,8 @@ func resourceWebfilterOverrideCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error creating WebfilterOverride resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_override", obj)
+
 	o, err := c.CreateWebfilterOverride(obj, vdomparam)
 
 	if err != nil {
@@ -146,6 +148,8 @@ func resourceWebfilterOverrideUpdate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error updating WebfilterOverride resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_override", obj)
+
 	o, err := c.UpdateWebfilterOverride(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterOverride resource: %v", err)
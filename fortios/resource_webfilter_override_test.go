@@ -56,7 +56,7 @@ func testAccCheckFortiOSWebfilterOverrideExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterOverride(i)
+		o, err := c.ReadWebfilterOverride(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterOverride: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckWebfilterOverrideDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterOverride(i)
+		o, err := c.ReadWebfilterOverride(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebfilterProfile() *schema.Resource {
 		Delete: resourceWebfilterProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -747,6 +747,8 @@ func resourceWebfilterProfileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WebfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_profile", obj)
+
 	o, err := c.CreateWebfilterProfile(obj, vdomparam)
 
 	if err != nil {
@@ -780,6 +782,8 @@ func resourceWebfilterProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebfilterProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_profile", obj)
+
 	o, err := c.UpdateWebfilterProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterProfile resource: %v", err)
@@ -105,7 +105,7 @@ func testAccCheckFortiOSWebfilterProfileExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterProfile(i)
+		o, err := c.ReadWebfilterProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterProfile: %s", err)
@@ -128,7 +128,7 @@ func testAccCheckWebfilterProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterProfile(i)
+		o, err := c.ReadWebfilterProfile(i, "")
 
 		if err == nil {
 			if o != nil {
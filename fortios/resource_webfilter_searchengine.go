@@ -26,7 +26,7 @@ func resourceWebfilterSearchEngine() *schema.Resource {
 		Delete: resourceWebfilterSearchEngineDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,6 +96,8 @@ func resourceWebfilterSearchEngineCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WebfilterSearchEngine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_searchengine", obj)
+
 	o, err := c.CreateWebfilterSearchEngine(obj, vdomparam)
 
 	if err != nil {
@@ -129,6 +131,8 @@ func resourceWebfilterSearchEngineUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebfilterSearchEngine resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_searchengine", obj)
+
 	o, err := c.UpdateWebfilterSearchEngine(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterSearchEngine resource: %v", err)
@@ -53,7 +53,7 @@ func testAccCheckFortiOSWebfilterSearchEngineExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterSearchEngine(i)
+		o, err := c.ReadWebfilterSearchEngine(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterSearchEngine: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckWebfilterSearchEngineDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterSearchEngine(i)
+		o, err := c.ReadWebfilterSearchEngine(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebfilterUrlfilter() *schema.Resource {
 		Delete: resourceWebfilterUrlfilterDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/webfilter/urlfilter", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -147,6 +147,8 @@ func resourceWebfilterUrlfilterCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error creating WebfilterUrlfilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_urlfilter", obj)
+
 	o, err := c.CreateWebfilterUrlfilter(obj, vdomparam)
 
 	if err != nil {
@@ -180,6 +182,8 @@ func resourceWebfilterUrlfilterUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error updating WebfilterUrlfilter resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webfilter_urlfilter", obj)
+
 	o, err := c.UpdateWebfilterUrlfilter(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebfilterUrlfilter resource: %v", err)
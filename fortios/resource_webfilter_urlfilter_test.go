@@ -51,7 +51,7 @@ func testAccCheckFortiOSWebfilterUrlfilterExists(n string) resource.TestCheckFun
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterUrlfilter(i)
+		o, err := c.ReadWebfilterUrlfilter(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebfilterUrlfilter: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckWebfilterUrlfilterDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebfilterUrlfilter(i)
+		o, err := c.ReadWebfilterUrlfilter(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebProxyDebugUrl() *schema.Resource {
 		Delete: resourceWebProxyDebugUrlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceWebProxyDebugUrlCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WebProxyDebugUrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_debugurl", obj)
+
 	o, err := c.CreateWebProxyDebugUrl(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceWebProxyDebugUrlUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyDebugUrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_debugurl", obj)
+
 	o, err := c.UpdateWebProxyDebugUrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyDebugUrl resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSWebProxyDebugUrlExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyDebugUrl(i)
+		o, err := c.ReadWebProxyDebugUrl(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyDebugUrl: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckWebProxyDebugUrlDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyDebugUrl(i)
+		o, err := c.ReadWebProxyDebugUrl(i, "")
 
 		if err == nil {
 			if o != nil {
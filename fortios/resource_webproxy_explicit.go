@@ -26,7 +26,7 @@ func resourceWebProxyExplicit() *schema.Resource {
 		Delete: resourceWebProxyExplicitDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -271,6 +271,8 @@ func resourceWebProxyExplicitUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyExplicit resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_explicit", obj)
+
 	o, err := c.UpdateWebProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyExplicit resource: %v", err)
@@ -305,6 +307,8 @@ func resourceWebProxyExplicitDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyExplicit resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_explicit", obj)
+
 	_, err = c.UpdateWebProxyExplicit(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebProxyExplicit resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWebProxyForwardServer() *schema.Resource {
 		Delete: resourceWebProxyForwardServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,6 +119,8 @@ func resourceWebProxyForwardServerCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WebProxyForwardServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_forwardserver", obj)
+
 	o, err := c.CreateWebProxyForwardServer(obj, vdomparam)
 
 	if err != nil {
@@ -152,6 +154,8 @@ func resourceWebProxyForwardServerUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WebProxyForwardServer resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_forwardserver", obj)
+
 	o, err := c.UpdateWebProxyForwardServer(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyForwardServer resource: %v", err)
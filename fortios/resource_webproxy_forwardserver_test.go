@@ -54,7 +54,7 @@ func testAccCheckFortiOSWebProxyForwardServerExists(n string) resource.TestCheck
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyForwardServer(i)
+		o, err := c.ReadWebProxyForwardServer(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyForwardServer: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckWebProxyForwardServerDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyForwardServer(i)
+		o, err := c.ReadWebProxyForwardServer(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebProxyForwardServerGroup() *schema.Resource {
 		Delete: resourceWebProxyForwardServerGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +103,8 @@ func resourceWebProxyForwardServerGroupCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating WebProxyForwardServerGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_forwardservergroup", obj)
+
 	o, err := c.CreateWebProxyForwardServerGroup(obj, vdomparam)
 
 	if err != nil {
@@ -136,6 +138,8 @@ func resourceWebProxyForwardServerGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WebProxyForwardServerGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_forwardservergroup", obj)
+
 	o, err := c.UpdateWebProxyForwardServerGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyForwardServerGroup resource: %v", err)
@@ -55,7 +55,7 @@ func testAccCheckFortiOSWebProxyForwardServerGroupExists(n string) resource.Test
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyForwardServerGroup(i)
+		o, err := c.ReadWebProxyForwardServerGroup(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyForwardServerGroup: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckWebProxyForwardServerGroupDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyForwardServerGroup(i)
+		o, err := c.ReadWebProxyForwardServerGroup(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebProxyGlobal() *schema.Resource {
 		Delete: resourceWebProxyGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -187,6 +187,8 @@ func resourceWebProxyGlobalUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WebProxyGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_global", obj)
+
 	o, err := c.UpdateWebProxyGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyGlobal resource: %v", err)
@@ -221,6 +223,8 @@ func resourceWebProxyGlobalDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WebProxyGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_global", obj)
+
 	_, err = c.UpdateWebProxyGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WebProxyGlobal resource: %v", err)
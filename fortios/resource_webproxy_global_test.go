@@ -60,7 +60,7 @@ func testAccCheckFortiOSWebProxyGlobalExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyGlobal(i)
+		o, err := c.ReadWebProxyGlobal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyGlobal: %s", err)
@@ -83,7 +83,7 @@ func testAccCheckWebProxyGlobalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyGlobal(i)
+		o, err := c.ReadWebProxyGlobal(i, "")
 
 		if err == nil {
 			if o != nil {
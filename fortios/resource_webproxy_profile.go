@@ -26,7 +26,7 @@ func resourceWebProxyProfile() *schema.Resource {
 		Delete: resourceWebProxyProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -191,6 +191,8 @@ func resourceWebProxyProfileCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WebProxyProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_profile", obj)
+
 	o, err := c.CreateWebProxyProfile(obj, vdomparam)
 
 	if err != nil {
@@ -224,6 +226,8 @@ func resourceWebProxyProfileUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_profile", obj)
+
 	o, err := c.UpdateWebProxyProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyProfile resource: %v", err)
@@ -57,7 +57,7 @@ func testAccCheckFortiOSWebProxyProfileExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyProfile(i)
+		o, err := c.ReadWebProxyProfile(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyProfile: %s", err)
@@ -80,7 +80,7 @@ func testAccCheckWebProxyProfileDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyProfile(i)
+		o, err := c.ReadWebProxyProfile(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebProxyUrlMatch() *schema.Resource {
 		Delete: resourceWebProxyUrlMatchDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,8 @@ func resourceWebProxyUrlMatchCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error creating WebProxyUrlMatch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_urlmatch", obj)
+
 	o, err := c.CreateWebProxyUrlMatch(obj, vdomparam)
 
 	if err != nil {
@@ -122,6 +124,8 @@ func resourceWebProxyUrlMatchUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error updating WebProxyUrlMatch resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_urlmatch", obj)
+
 	o, err := c.UpdateWebProxyUrlMatch(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyUrlMatch resource: %v", err)
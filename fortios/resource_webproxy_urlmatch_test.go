@@ -54,7 +54,7 @@ func testAccCheckFortiOSWebProxyUrlMatchExists(n string) resource.TestCheckFunc
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyUrlMatch(i)
+		o, err := c.ReadWebProxyUrlMatch(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyUrlMatch: %s", err)
@@ -77,7 +77,7 @@ func testAccCheckWebProxyUrlMatchDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyUrlMatch(i)
+		o, err := c.ReadWebProxyUrlMatch(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWebProxyWisp() *schema.Resource {
 		Delete: resourceWebProxyWispDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,6 +93,8 @@ func resourceWebProxyWispCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error creating WebProxyWisp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_wisp", obj)
+
 	o, err := c.CreateWebProxyWisp(obj, vdomparam)
 
 	if err != nil {
@@ -126,6 +128,8 @@ func resourceWebProxyWispUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating WebProxyWisp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_webproxy_wisp", obj)
+
 	o, err := c.UpdateWebProxyWisp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WebProxyWisp resource: %v", err)
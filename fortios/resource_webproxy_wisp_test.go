@@ -53,7 +53,7 @@ func testAccCheckFortiOSWebProxyWispExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyWisp(i)
+		o, err := c.ReadWebProxyWisp(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WebProxyWisp: %s", err)
@@ -76,7 +76,7 @@ func testAccCheckWebProxyWispDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWebProxyWisp(i)
+		o, err := c.ReadWebProxyWisp(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWirelessControllerAccessControlList() *schema.Resource {
 		Delete: resourceWirelessControllerAccessControlListDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -180,6 +180,8 @@ func resourceWirelessControllerAccessControlListCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error creating WirelessControllerAccessControlList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_accesscontrollist", obj)
+
 	o, err := c.CreateWirelessControllerAccessControlList(obj, vdomparam)
 
 	if err != nil {
@@ -213,6 +215,8 @@ func resourceWirelessControllerAccessControlListUpdate(d *schema.ResourceData, m
 		return fmt.Errorf("Error updating WirelessControllerAccessControlList resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_accesscontrollist", obj)
+
 	o, err := c.UpdateWirelessControllerAccessControlList(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAccessControlList resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerAddress() *schema.Resource {
 		Delete: resourceWirelessControllerAddressDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceWirelessControllerAddressCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating WirelessControllerAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_address", obj)
+
 	o, err := c.CreateWirelessControllerAddress(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceWirelessControllerAddressUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerAddress resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_address", obj)
+
 	o, err := c.UpdateWirelessControllerAddress(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAddress resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerAddrgrp() *schema.Resource {
 		Delete: resourceWirelessControllerAddrgrpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceWirelessControllerAddrgrpCreate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error creating WirelessControllerAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_addrgrp", obj)
+
 	o, err := c.CreateWirelessControllerAddrgrp(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceWirelessControllerAddrgrpUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerAddrgrp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_addrgrp", obj)
+
 	o, err := c.UpdateWirelessControllerAddrgrp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerAddrgrp resource: %v", err)
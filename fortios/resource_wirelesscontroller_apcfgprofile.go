@@ -26,7 +26,7 @@ func resourceWirelessControllerApcfgProfile() *schema.Resource {
 		Delete: resourceWirelessControllerApcfgProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -137,6 +137,8 @@ func resourceWirelessControllerApcfgProfileCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error creating WirelessControllerApcfgProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_apcfgprofile", obj)
+
 	o, err := c.CreateWirelessControllerApcfgProfile(obj, vdomparam)
 
 	if err != nil {
@@ -170,6 +172,8 @@ func resourceWirelessControllerApcfgProfileUpdate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error updating WirelessControllerApcfgProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_apcfgprofile", obj)
+
 	o, err := c.UpdateWirelessControllerApcfgProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerApcfgProfile resource: %v", err)
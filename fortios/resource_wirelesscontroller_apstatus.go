@@ -26,7 +26,7 @@ func resourceWirelessControllerApStatus() *schema.Resource {
 		Delete: resourceWirelessControllerApStatusDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdomLookup("/api/v2/cmdb/wireless-controller/ap-status", "id"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,6 +78,8 @@ func resourceWirelessControllerApStatusCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating WirelessControllerApStatus resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_apstatus", obj)
+
 	o, err := c.CreateWirelessControllerApStatus(obj, vdomparam)
 
 	if err != nil {
@@ -111,6 +113,8 @@ func resourceWirelessControllerApStatusUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerApStatus resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_apstatus", obj)
+
 	o, err := c.UpdateWirelessControllerApStatus(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerApStatus resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerArrpProfile() *schema.Resource {
 		Delete: resourceWirelessControllerArrpProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -198,6 +198,8 @@ func resourceWirelessControllerArrpProfileCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating WirelessControllerArrpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_arrpprofile", obj)
+
 	o, err := c.CreateWirelessControllerArrpProfile(obj, vdomparam)
 
 	if err != nil {
@@ -231,6 +233,8 @@ func resourceWirelessControllerArrpProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerArrpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_arrpprofile", obj)
+
 	o, err := c.UpdateWirelessControllerArrpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerArrpProfile resource: %v", err)
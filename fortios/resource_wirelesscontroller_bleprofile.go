@@ -26,7 +26,7 @@ func resourceWirelessControllerBleProfile() *schema.Resource {
 		Delete: resourceWirelessControllerBleProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -132,6 +132,8 @@ func resourceWirelessControllerBleProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerBleProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_bleprofile", obj)
+
 	o, err := c.CreateWirelessControllerBleProfile(obj, vdomparam)
 
 	if err != nil {
@@ -165,6 +167,8 @@ func resourceWirelessControllerBleProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerBleProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_bleprofile", obj)
+
 	o, err := c.UpdateWirelessControllerBleProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerBleProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerBonjourProfile() *schema.Resource {
 		Delete: resourceWirelessControllerBonjourProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceWirelessControllerBonjourProfileCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error creating WirelessControllerBonjourProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_bonjourprofile", obj)
+
 	o, err := c.CreateWirelessControllerBonjourProfile(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceWirelessControllerBonjourProfileUpdate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error updating WirelessControllerBonjourProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_bonjourprofile", obj)
+
 	o, err := c.UpdateWirelessControllerBonjourProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerBonjourProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerGlobal() *schema.Resource {
 		Delete: resourceWirelessControllerGlobalDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -160,6 +160,8 @@ func resourceWirelessControllerGlobalUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_global", obj)
+
 	o, err := c.UpdateWirelessControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerGlobal resource: %v", err)
@@ -194,6 +196,8 @@ func resourceWirelessControllerGlobalDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerGlobal resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_global", obj)
+
 	_, err = c.UpdateWirelessControllerGlobal(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerGlobal resource: %v", err)
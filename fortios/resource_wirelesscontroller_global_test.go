@@ -62,7 +62,7 @@ func testAccCheckFortiOSWirelessControllerGlobalExists(n string) resource.TestCh
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerGlobal(i)
+		o, err := c.ReadWirelessControllerGlobal(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerGlobal: %s", err)
@@ -85,7 +85,7 @@ func testAccCheckWirelessControllerGlobalDestroy(s *terraform.State) error {
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerGlobal(i)
+		o, err := c.ReadWirelessControllerGlobal(i, "")
 
 		if err == nil {
 			if o != nil {
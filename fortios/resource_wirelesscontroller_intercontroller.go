@@ -26,7 +26,7 @@ func resourceWirelessControllerInterController() *schema.Resource {
 		Delete: resourceWirelessControllerInterControllerDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,6 +119,8 @@ func resourceWirelessControllerInterControllerUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WirelessControllerInterController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_intercontroller", obj)
+
 	o, err := c.UpdateWirelessControllerInterController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerInterController resource: %v", err)
@@ -153,6 +155,8 @@ func resourceWirelessControllerInterControllerDelete(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WirelessControllerInterController resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_intercontroller", obj)
+
 	_, err = c.UpdateWirelessControllerInterController(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerInterController resource: %v", err)
@@ -52,7 +52,7 @@ func testAccCheckFortiOSWirelessControllerInterControllerExists(n string) resour
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerInterController(i)
+		o, err := c.ReadWirelessControllerInterController(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerInterController: %s", err)
@@ -75,7 +75,7 @@ func testAccCheckWirelessControllerInterControllerDestroy(s *terraform.State) er
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerInterController(i)
+		o, err := c.ReadWirelessControllerInterController(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWirelessControllerLog() *schema.Resource {
 		Delete: resourceWirelessControllerLogDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -117,6 +117,8 @@ func resourceWirelessControllerLogUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_log", obj)
+
 	o, err := c.UpdateWirelessControllerLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerLog resource: %v", err)
@@ -151,6 +153,8 @@ func resourceWirelessControllerLogDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerLog resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_log", obj)
+
 	_, err = c.UpdateWirelessControllerLog(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerLog resource: %v", err)
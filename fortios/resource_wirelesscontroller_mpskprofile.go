@@ -26,7 +26,7 @@ func resourceWirelessControllerMpskProfile() *schema.Resource {
 		Delete: resourceWirelessControllerMpskProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,6 +154,8 @@ func resourceWirelessControllerMpskProfileCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating WirelessControllerMpskProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_mpskprofile", obj)
+
 	o, err := c.CreateWirelessControllerMpskProfile(obj, vdomparam)
 
 	if err != nil {
@@ -187,6 +189,8 @@ func resourceWirelessControllerMpskProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerMpskProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_mpskprofile", obj)
+
 	o, err := c.UpdateWirelessControllerMpskProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerMpskProfile resource: %v", err)
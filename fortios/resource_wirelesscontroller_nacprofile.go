@@ -26,7 +26,7 @@ func resourceWirelessControllerNacProfile() *schema.Resource {
 		Delete: resourceWirelessControllerNacProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceWirelessControllerNacProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerNacProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_nacprofile", obj)
+
 	o, err := c.CreateWirelessControllerNacProfile(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceWirelessControllerNacProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerNacProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_nacprofile", obj)
+
 	o, err := c.UpdateWirelessControllerNacProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerNacProfile resource: %v", err)
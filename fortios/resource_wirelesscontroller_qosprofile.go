@@ -26,7 +26,7 @@ func resourceWirelessControllerQosProfile() *schema.Resource {
 		Delete: resourceWirelessControllerQosProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -225,6 +225,8 @@ func resourceWirelessControllerQosProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerQosProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_qosprofile", obj)
+
 	o, err := c.CreateWirelessControllerQosProfile(obj, vdomparam)
 
 	if err != nil {
@@ -258,6 +260,8 @@ func resourceWirelessControllerQosProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerQosProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_qosprofile", obj)
+
 	o, err := c.UpdateWirelessControllerQosProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerQosProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerRegion() *schema.Resource {
 		Delete: resourceWirelessControllerRegionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,6 +85,8 @@ func resourceWirelessControllerRegionCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error creating WirelessControllerRegion resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_region", obj)
+
 	o, err := c.CreateWirelessControllerRegion(obj, vdomparam)
 
 	if err != nil {
@@ -118,6 +120,8 @@ func resourceWirelessControllerRegionUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerRegion resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_region", obj)
+
 	o, err := c.UpdateWirelessControllerRegion(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerRegion resource: %v", err)
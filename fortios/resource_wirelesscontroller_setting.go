@@ -26,7 +26,7 @@ func resourceWirelessControllerSetting() *schema.Resource {
 		Delete: resourceWirelessControllerSettingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -166,6 +166,8 @@ func resourceWirelessControllerSettingUpdate(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_setting", obj)
+
 	o, err := c.UpdateWirelessControllerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSetting resource: %v", err)
@@ -200,6 +202,8 @@ func resourceWirelessControllerSettingDelete(d *schema.ResourceData, m interface
 		return fmt.Errorf("Error updating WirelessControllerSetting resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_setting", obj)
+
 	_, err = c.UpdateWirelessControllerSetting(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerSetting resource: %v", err)
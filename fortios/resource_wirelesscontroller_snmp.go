@@ -26,7 +26,7 @@ func resourceWirelessControllerSnmp() *schema.Resource {
 		Delete: resourceWirelessControllerSnmpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -209,6 +209,8 @@ func resourceWirelessControllerSnmpUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerSnmp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_snmp", obj)
+
 	o, err := c.UpdateWirelessControllerSnmp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSnmp resource: %v", err)
@@ -243,6 +245,8 @@ func resourceWirelessControllerSnmpDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerSnmp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_snmp", obj)
+
 	_, err = c.UpdateWirelessControllerSnmp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerSnmp resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerSsidPolicy() *schema.Resource {
 		Delete: resourceWirelessControllerSsidPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceWirelessControllerSsidPolicyCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerSsidPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_ssidpolicy", obj)
+
 	o, err := c.CreateWirelessControllerSsidPolicy(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceWirelessControllerSsidPolicyUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerSsidPolicy resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_ssidpolicy", obj)
+
 	o, err := c.UpdateWirelessControllerSsidPolicy(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSsidPolicy resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerSyslogProfile() *schema.Resource {
 		Delete: resourceWirelessControllerSyslogProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,6 +100,8 @@ func resourceWirelessControllerSyslogProfileCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating WirelessControllerSyslogProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_syslogprofile", obj)
+
 	o, err := c.CreateWirelessControllerSyslogProfile(obj, vdomparam)
 
 	if err != nil {
@@ -133,6 +135,8 @@ func resourceWirelessControllerSyslogProfileUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating WirelessControllerSyslogProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_syslogprofile", obj)
+
 	o, err := c.UpdateWirelessControllerSyslogProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerSyslogProfile resource: %v", err)
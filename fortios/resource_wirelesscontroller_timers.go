@@ -26,7 +26,7 @@ func resourceWirelessControllerTimers() *schema.Resource {
 		Delete: resourceWirelessControllerTimersDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -165,6 +165,8 @@ func resourceWirelessControllerTimersUpdate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerTimers resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_timers", obj)
+
 	o, err := c.UpdateWirelessControllerTimers(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerTimers resource: %v", err)
@@ -199,6 +201,8 @@ func resourceWirelessControllerTimersDelete(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error updating WirelessControllerTimers resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_timers", obj)
+
 	_, err = c.UpdateWirelessControllerTimers(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error clearing WirelessControllerTimers resource: %v", err)
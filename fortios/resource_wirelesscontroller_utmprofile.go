@@ -26,7 +26,7 @@ func resourceWirelessControllerUtmProfile() *schema.Resource {
 		Delete: resourceWirelessControllerUtmProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +103,8 @@ func resourceWirelessControllerUtmProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerUtmProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_utmprofile", obj)
+
 	o, err := c.CreateWirelessControllerUtmProfile(obj, vdomparam)
 
 	if err != nil {
@@ -136,6 +138,8 @@ func resourceWirelessControllerUtmProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerUtmProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_utmprofile", obj)
+
 	o, err := c.UpdateWirelessControllerUtmProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerUtmProfile resource: %v", err)
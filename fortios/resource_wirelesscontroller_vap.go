@@ -26,7 +26,7 @@ func resourceWirelessControllerVap() *schema.Resource {
 		Delete: resourceWirelessControllerVapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1074,6 +1074,8 @@ func resourceWirelessControllerVapCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WirelessControllerVap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_vap", obj)
+
 	o, err := c.CreateWirelessControllerVap(obj, vdomparam)
 
 	if err != nil {
@@ -1107,6 +1109,8 @@ func resourceWirelessControllerVapUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerVap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_vap", obj)
+
 	o, err := c.UpdateWirelessControllerVap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerVap resource: %v", err)
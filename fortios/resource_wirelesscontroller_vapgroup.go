@@ -26,7 +26,7 @@ func resourceWirelessControllerVapGroup() *schema.Resource {
 		Delete: resourceWirelessControllerVapGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,6 +86,8 @@ func resourceWirelessControllerVapGroupCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating WirelessControllerVapGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_vapgroup", obj)
+
 	o, err := c.CreateWirelessControllerVapGroup(obj, vdomparam)
 
 	if err != nil {
@@ -119,6 +121,8 @@ func resourceWirelessControllerVapGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerVapGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_vapgroup", obj)
+
 	o, err := c.UpdateWirelessControllerVapGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerVapGroup resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerWagProfile() *schema.Resource {
 		Delete: resourceWirelessControllerWagProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -107,6 +107,8 @@ func resourceWirelessControllerWagProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerWagProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wagprofile", obj)
+
 	o, err := c.CreateWirelessControllerWagProfile(obj, vdomparam)
 
 	if err != nil {
@@ -140,6 +142,8 @@ func resourceWirelessControllerWagProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerWagProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wagprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWagProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWagProfile resource: %v", err)
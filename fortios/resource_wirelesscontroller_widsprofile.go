@@ -26,7 +26,7 @@ func resourceWirelessControllerWidsProfile() *schema.Resource {
 		Delete: resourceWirelessControllerWidsProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -353,6 +353,8 @@ func resourceWirelessControllerWidsProfileCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error creating WirelessControllerWidsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_widsprofile", obj)
+
 	o, err := c.CreateWirelessControllerWidsProfile(obj, vdomparam)
 
 	if err != nil {
@@ -386,6 +388,8 @@ func resourceWirelessControllerWidsProfileUpdate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error updating WirelessControllerWidsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_widsprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWidsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWidsProfile resource: %v", err)
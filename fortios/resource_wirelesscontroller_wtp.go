@@ -26,7 +26,7 @@ func resourceWirelessControllerWtp() *schema.Resource {
 		Delete: resourceWirelessControllerWtpDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -885,6 +885,8 @@ func resourceWirelessControllerWtpCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error creating WirelessControllerWtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtp", obj)
+
 	o, err := c.CreateWirelessControllerWtp(obj, vdomparam)
 
 	if err != nil {
@@ -918,6 +920,8 @@ func resourceWirelessControllerWtpUpdate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error updating WirelessControllerWtp resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtp", obj)
+
 	o, err := c.UpdateWirelessControllerWtp(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtp resource: %v", err)
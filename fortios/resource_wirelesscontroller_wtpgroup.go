@@ -26,7 +26,7 @@ func resourceWirelessControllerWtpGroup() *schema.Resource {
 		Delete: resourceWirelessControllerWtpGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,6 +87,8 @@ func resourceWirelessControllerWtpGroupCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error creating WirelessControllerWtpGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtpgroup", obj)
+
 	o, err := c.CreateWirelessControllerWtpGroup(obj, vdomparam)
 
 	if err != nil {
@@ -120,6 +122,8 @@ func resourceWirelessControllerWtpGroupUpdate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error updating WirelessControllerWtpGroup resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtpgroup", obj)
+
 	o, err := c.UpdateWirelessControllerWtpGroup(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtpGroup resource: %v", err)
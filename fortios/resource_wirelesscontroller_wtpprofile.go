@@ -26,7 +26,7 @@ func resourceWirelessControllerWtpProfile() *schema.Resource {
 		Delete: resourceWirelessControllerWtpProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -2258,6 +2258,8 @@ func resourceWirelessControllerWtpProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error creating WirelessControllerWtpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtpprofile", obj)
+
 	o, err := c.CreateWirelessControllerWtpProfile(obj, vdomparam)
 
 	if err != nil {
@@ -2291,6 +2293,8 @@ func resourceWirelessControllerWtpProfileUpdate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error updating WirelessControllerWtpProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontroller_wtpprofile", obj)
+
 	o, err := c.UpdateWirelessControllerWtpProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerWtpProfile resource: %v", err)
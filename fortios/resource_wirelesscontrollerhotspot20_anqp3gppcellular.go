@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20Anqp3GppCellular() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20Anqp3GppCellularDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceWirelessControllerHotspot20Anqp3GppCellularCreate(d *schema.Resourc
 		return fmt.Errorf("Error creating WirelessControllerHotspot20Anqp3GppCellular resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqp3gppcellular", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20Anqp3GppCellular(obj, vdomparam)
 
 	if err != nil {
@@ -127,6 +129,8 @@ func resourceWirelessControllerHotspot20Anqp3GppCellularUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Anqp3GppCellular resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqp3gppcellular", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20Anqp3GppCellular(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Anqp3GppCellular resource: %v", err)
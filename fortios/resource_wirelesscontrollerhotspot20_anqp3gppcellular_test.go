@@ -48,7 +48,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20Anqp3GppCellularExists(n stri
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20Anqp3GppCellular(i)
+		o, err := c.ReadWirelessControllerHotspot20Anqp3GppCellular(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20Anqp3GppCellular: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckWirelessControllerHotspot20Anqp3GppCellularDestroy(s *terraform
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20Anqp3GppCellular(i)
+		o, err := c.ReadWirelessControllerHotspot20Anqp3GppCellular(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpIpAddressType() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20AnqpIpAddressTypeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,6 +73,8 @@ func resourceWirelessControllerHotspot20AnqpIpAddressTypeCreate(d *schema.Resour
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpIpAddressType resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpipaddresstype", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpIpAddressType(obj, vdomparam)
 
 	if err != nil {
@@ -106,6 +108,8 @@ func resourceWirelessControllerHotspot20AnqpIpAddressTypeUpdate(d *schema.Resour
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpIpAddressType resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpipaddresstype", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpIpAddressType(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpIpAddressType resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20AnqpIpAddressTypeExists(n str
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpIpAddressType(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpIpAddressType(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20AnqpIpAddressType: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWirelessControllerHotspot20AnqpIpAddressTypeDestroy(s *terrafor
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpIpAddressType(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpIpAddressType(i, "")
 
 		if err == nil {
 			if o != nil {
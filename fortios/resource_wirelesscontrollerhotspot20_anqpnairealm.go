@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpNaiRealm() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20AnqpNaiRealmDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +136,8 @@ func resourceWirelessControllerHotspot20AnqpNaiRealmCreate(d *schema.ResourceDat
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpNaiRealm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpnairealm", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpNaiRealm(obj, vdomparam)
 
 	if err != nil {
@@ -169,6 +171,8 @@ func resourceWirelessControllerHotspot20AnqpNaiRealmUpdate(d *schema.ResourceDat
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNaiRealm resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpnairealm", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpNaiRealm(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNaiRealm resource: %v", err)
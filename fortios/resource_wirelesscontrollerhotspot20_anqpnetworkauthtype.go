@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpNetworkAuthType() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20AnqpNetworkAuthTypeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,6 +74,8 @@ func resourceWirelessControllerHotspot20AnqpNetworkAuthTypeCreate(d *schema.Reso
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpNetworkAuthType resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpNetworkAuthType(obj, vdomparam)
 
 	if err != nil {
@@ -107,6 +109,8 @@ func resourceWirelessControllerHotspot20AnqpNetworkAuthTypeUpdate(d *schema.Reso
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNetworkAuthType resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpnetworkauthtype", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpNetworkAuthType(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpNetworkAuthType resource: %v", err)
@@ -50,7 +50,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20AnqpNetworkAuthTypeExists(n s
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpNetworkAuthType(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpNetworkAuthType(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20AnqpNetworkAuthType: %s", err)
@@ -73,7 +73,7 @@ func testAccCheckWirelessControllerHotspot20AnqpNetworkAuthTypeDestroy(s *terraf
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpNetworkAuthType(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpNetworkAuthType(i, "")
 
 		if err == nil {
 			if o != nil {
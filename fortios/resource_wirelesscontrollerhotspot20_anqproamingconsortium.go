@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpRoamingConsortium() *schema.Resource
 		Delete: resourceWirelessControllerHotspot20AnqpRoamingConsortiumDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceWirelessControllerHotspot20AnqpRoamingConsortiumCreate(d *schema.Re
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpRoamingConsortium resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqproamingconsortium", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpRoamingConsortium(obj, vdomparam)
 
 	if err != nil {
@@ -127,6 +129,8 @@ func resourceWirelessControllerHotspot20AnqpRoamingConsortiumUpdate(d *schema.Re
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpRoamingConsortium resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqproamingconsortium", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpRoamingConsortium(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpRoamingConsortium resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20AnqpRoamingConsortiumExists(n
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpRoamingConsortium(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpRoamingConsortium(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20AnqpRoamingConsortium: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckWirelessControllerHotspot20AnqpRoamingConsortiumDestroy(s *terr
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpRoamingConsortium(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpRoamingConsortium(i, "")
 
 		if err == nil {
 			if o != nil {
@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpVenueName() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20AnqpVenueNameDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceWirelessControllerHotspot20AnqpVenueNameCreate(d *schema.ResourceDa
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpVenueName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpvenuename", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpVenueName(obj, vdomparam)
 
 	if err != nil {
@@ -127,6 +129,8 @@ func resourceWirelessControllerHotspot20AnqpVenueNameUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpvenuename", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpVenueName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueName resource: %v", err)
@@ -51,7 +51,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20AnqpVenueNameExists(n string)
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpVenueName(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpVenueName(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20AnqpVenueName: %s", err)
@@ -74,7 +74,7 @@ func testAccCheckWirelessControllerHotspot20AnqpVenueNameDestroy(s *terraform.St
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20AnqpVenueName(i)
+		o, err := c.ReadWirelessControllerHotspot20AnqpVenueName(i, "")
 
 		if err == nil {
 			if o != nil {
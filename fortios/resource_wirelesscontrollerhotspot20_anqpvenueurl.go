@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20AnqpVenueUrl() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20AnqpVenueUrlDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceWirelessControllerHotspot20AnqpVenueUrlCreate(d *schema.ResourceDat
 		return fmt.Errorf("Error creating WirelessControllerHotspot20AnqpVenueUrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpvenueurl", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20AnqpVenueUrl(obj, vdomparam)
 
 	if err != nil {
@@ -127,6 +129,8 @@ func resourceWirelessControllerHotspot20AnqpVenueUrlUpdate(d *schema.ResourceDat
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueUrl resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_anqpvenueurl", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20AnqpVenueUrl(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20AnqpVenueUrl resource: %v", err)
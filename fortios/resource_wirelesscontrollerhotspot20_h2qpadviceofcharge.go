@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpAdviceOfCharge() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpAdviceOfChargeDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,6 +131,8 @@ func resourceWirelessControllerHotspot20H2QpAdviceOfChargeCreate(d *schema.Resou
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpAdviceOfCharge resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpAdviceOfCharge(obj, vdomparam)
 
 	if err != nil {
@@ -164,6 +166,8 @@ func resourceWirelessControllerHotspot20H2QpAdviceOfChargeUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpAdviceOfCharge resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpadviceofcharge", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpAdviceOfCharge(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpAdviceOfCharge resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpConnCapability() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpConnCapabilityDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +118,8 @@ func resourceWirelessControllerHotspot20H2QpConnCapabilityCreate(d *schema.Resou
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpConnCapability resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpconncapability", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpConnCapability(obj, vdomparam)
 
 	if err != nil {
@@ -151,6 +153,8 @@ func resourceWirelessControllerHotspot20H2QpConnCapabilityUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpConnCapability resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpconncapability", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpConnCapability(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpConnCapability resource: %v", err)
@@ -59,7 +59,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20H2QpConnCapabilityExists(n st
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpConnCapability(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpConnCapability(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20H2QpConnCapability: %s", err)
@@ -82,7 +82,7 @@ func testAccCheckWirelessControllerHotspot20H2QpConnCapabilityDestroy(s *terrafo
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpConnCapability(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpConnCapability(i, "")
 
 		if err == nil {
 			if o != nil {
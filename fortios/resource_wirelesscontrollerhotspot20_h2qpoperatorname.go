@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpOperatorName() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpOperatorNameDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +94,8 @@ func resourceWirelessControllerHotspot20H2QpOperatorNameCreate(d *schema.Resourc
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpOperatorName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpoperatorname", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpOperatorName(obj, vdomparam)
 
 	if err != nil {
@@ -127,6 +129,8 @@ func resourceWirelessControllerHotspot20H2QpOperatorNameUpdate(d *schema.Resourc
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOperatorName resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpoperatorname", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOperatorName(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOperatorName resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20H2QpOperatorNameExists(n stri
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpOperatorName(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpOperatorName(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20H2QpOperatorName: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckWirelessControllerHotspot20H2QpOperatorNameDestroy(s *terraform
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpOperatorName(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpOperatorName(i, "")
 
 		if err == nil {
 			if o != nil {
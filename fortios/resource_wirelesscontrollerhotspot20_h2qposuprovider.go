@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpOsuProvider() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpOsuProviderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,6 +142,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderCreate(d *schema.Resource
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpOsuProvider resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qposuprovider", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpOsuProvider(obj, vdomparam)
 
 	if err != nil {
@@ -175,6 +177,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderUpdate(d *schema.Resource
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProvider resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qposuprovider", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOsuProvider(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProvider resource: %v", err)
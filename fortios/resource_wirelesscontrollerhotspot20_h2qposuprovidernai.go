@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderNai() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpOsuProviderNaiDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,6 +88,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderNaiCreate(d *schema.Resou
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpOsuProviderNai resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qposuprovidernai", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpOsuProviderNai(obj, vdomparam)
 
 	if err != nil {
@@ -121,6 +123,8 @@ func resourceWirelessControllerHotspot20H2QpOsuProviderNaiUpdate(d *schema.Resou
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProviderNai resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qposuprovidernai", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpOsuProviderNai(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpOsuProviderNai resource: %v", err)
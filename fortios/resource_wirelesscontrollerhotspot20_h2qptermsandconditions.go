@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpTermsAndConditions() *schema.Resourc
 		Delete: resourceWirelessControllerHotspot20H2QpTermsAndConditionsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -80,6 +80,8 @@ func resourceWirelessControllerHotspot20H2QpTermsAndConditionsCreate(d *schema.R
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpTermsAndConditions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qptermsandconditions", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpTermsAndConditions(obj, vdomparam)
 
 	if err != nil {
@@ -113,6 +115,8 @@ func resourceWirelessControllerHotspot20H2QpTermsAndConditionsUpdate(d *schema.R
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpTermsAndConditions resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qptermsandconditions", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpTermsAndConditions(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpTermsAndConditions resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20H2QpWanMetric() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20H2QpWanMetricDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +106,8 @@ func resourceWirelessControllerHotspot20H2QpWanMetricCreate(d *schema.ResourceDa
 		return fmt.Errorf("Error creating WirelessControllerHotspot20H2QpWanMetric resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpwanmetric", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20H2QpWanMetric(obj, vdomparam)
 
 	if err != nil {
@@ -139,6 +141,8 @@ func resourceWirelessControllerHotspot20H2QpWanMetricUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpWanMetric resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_h2qpwanmetric", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20H2QpWanMetric(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20H2QpWanMetric resource: %v", err)
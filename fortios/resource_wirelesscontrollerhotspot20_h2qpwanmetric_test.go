@@ -56,7 +56,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20H2QpWanMetricExists(n string)
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpWanMetric(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpWanMetric(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20H2QpWanMetric: %s", err)
@@ -79,7 +79,7 @@ func testAccCheckWirelessControllerHotspot20H2QpWanMetricDestroy(s *terraform.St
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20H2QpWanMetric(i)
+		o, err := c.ReadWirelessControllerHotspot20H2QpWanMetric(i, "")
 
 		if err == nil {
 			if o != nil {
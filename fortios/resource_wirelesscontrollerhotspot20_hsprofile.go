@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20HsProfile() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20HsProfileDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -284,6 +284,8 @@ func resourceWirelessControllerHotspot20HsProfileCreate(d *schema.ResourceData,
 		return fmt.Errorf("Error creating WirelessControllerHotspot20HsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_hsprofile", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20HsProfile(obj, vdomparam)
 
 	if err != nil {
@@ -317,6 +319,8 @@ func resourceWirelessControllerHotspot20HsProfileUpdate(d *schema.ResourceData,
 		return fmt.Errorf("Error updating WirelessControllerHotspot20HsProfile resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_hsprofile", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20HsProfile(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20HsProfile resource: %v", err)
@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20Icon() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20IconDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,6 +111,8 @@ func resourceWirelessControllerHotspot20IconCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error creating WirelessControllerHotspot20Icon resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_icon", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20Icon(obj, vdomparam)
 
 	if err != nil {
@@ -144,6 +146,8 @@ func resourceWirelessControllerHotspot20IconUpdate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Icon resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_icon", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20Icon(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20Icon resource: %v", err)
@@ -48,7 +48,7 @@ func testAccCheckFortiOSWirelessControllerHotspot20IconExists(n string) resource
 		c := testAccProvider.Meta().(*FortiClient).Client
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20Icon(i)
+		o, err := c.ReadWirelessControllerHotspot20Icon(i, "")
 
 		if err != nil {
 			return fmt.Errorf("Error reading WirelessControllerHotspot20Icon: %s", err)
@@ -71,7 +71,7 @@ func testAccCheckWirelessControllerHotspot20IconDestroy(s *terraform.State) erro
 		}
 
 		i := rs.Primary.ID
-		o, err := c.ReadWirelessControllerHotspot20Icon(i)
+		o, err := c.ReadWirelessControllerHotspot20Icon(i, "")
 
 		if err == nil {
 			if o != nil {
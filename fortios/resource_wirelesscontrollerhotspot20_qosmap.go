@@ -26,7 +26,7 @@ func resourceWirelessControllerHotspot20QosMap() *schema.Resource {
 		Delete: resourceWirelessControllerHotspot20QosMapDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: fortiImportStateVdom,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,6 +126,8 @@ func resourceWirelessControllerHotspot20QosMapCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error creating WirelessControllerHotspot20QosMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_qosmap", obj)
+
 	o, err := c.CreateWirelessControllerHotspot20QosMap(obj, vdomparam)
 
 	if err != nil {
@@ -159,6 +161,8 @@ func resourceWirelessControllerHotspot20QosMapUpdate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error updating WirelessControllerHotspot20QosMap resource while getting object: %v", err)
 	}
 
+	removeIgnoredFields(m, "fortios_wirelesscontrollerhotspot20_qosmap", obj)
+
 	o, err := c.UpdateWirelessControllerHotspot20QosMap(obj, mkey, vdomparam)
 	if err != nil {
 		return fmt.Errorf("Error updating WirelessControllerHotspot20QosMap resource: %v", err)
@@ -0,0 +1,146 @@
+package fortios
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// scheduleTimeLayout is the layout of the start and end of the one-time schedules in
+// FortiOS, "hh:mm yyyy/mm/dd".
+const scheduleTimeLayout = "15:04 2006/01/02"
+
+// scheduleLocation returns the time zone of the FortiGate, the IANA name tz or UTC when
+// empty. The time zone of the machine running Terraform has no relation to the FortiGate.
+func scheduleLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+// scheduleTimeRFC3339 reports whether the start or end of a one-time schedule is in RFC 3339,
+// which needs the time zone of the FortiGate to be converted.
+func scheduleTimeRFC3339(s string) bool {
+	_, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+	return err == nil
+}
+
+// scheduleTime parses the start or end of a one-time schedule, in FortiOS format or in
+// RFC 3339, such as 2024-01-31T18:00:00Z. The FortiOS format is in the time zone loc.
+func scheduleTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	// FortiOS also takes the hours, minutes, months and days without leading zeros
+	for _, layout := range []string{scheduleTimeLayout, "15:4 2006/1/2"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.In(loc), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected \"hh:mm yyyy/mm/dd\" or RFC 3339", s)
+}
+
+// scheduleTimeFortiOS converts the start or end of a one-time schedule to FortiOS format, in
+// the time zone of the FortiGate.
+func scheduleTimeFortiOS(s string, tz string) (string, error) {
+	if tz == "" && scheduleTimeRFC3339(s) {
+		return "", fmt.Errorf("timezone is required to convert %q", s)
+	}
+
+	loc, err := scheduleLocation(tz)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := scheduleTime(s, loc)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(scheduleTimeLayout), nil
+}
+
+// scheduleStatus reports whether the one-time schedule from start to end has expired and
+// whether it is active at now. The time zone tz of the FortiGate is required, as the times
+// of FortiOS are local to the FortiGate.
+func scheduleStatus(start, end string, tz string, now time.Time) (expired bool, active bool, err error) {
+	if tz == "" {
+		return false, false, fmt.Errorf("timezone is required to compare the schedule with the current time")
+	}
+
+	loc, err := scheduleLocation(tz)
+	if err != nil {
+		return false, false, err
+	}
+
+	s, err := scheduleTime(start, loc)
+	if err != nil {
+		return false, false, err
+	}
+	e, err := scheduleTime(end, loc)
+	if err != nil {
+		return false, false, err
+	}
+
+	expired = !now.Before(e)
+	active = !now.Before(s) && !expired
+	return expired, active, nil
+}
+
+func validateScheduleTime(v interface{}, k string) (ws []string, es []error) {
+	if _, err := scheduleTime(v.(string), time.UTC); err != nil {
+		es = append(es, fmt.Errorf("%s: %v", k, err))
+	}
+	return
+}
+
+func validateScheduleTimezone(v interface{}, k string) (ws []string, es []error) {
+	if _, err := scheduleLocation(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s: unknown time zone %q", k, v.(string)))
+	}
+	return
+}
+
+// suppressScheduleTimeDiff suppresses the diff of a time in RFC 3339 against the same time
+// in FortiOS format, as FortiOS returns it.
+func suppressScheduleTimeDiff(k, old, new string, d *schema.ResourceData) bool {
+	tz := d.Get("timezone").(string)
+
+	o, err1 := scheduleTimeFortiOS(old, tz)
+	n, err2 := scheduleTimeFortiOS(new, tz)
+	return err1 == nil && err2 == nil && o == n
+}
+
+// validateScheduleOnetime checks that a one-time schedule ends after it starts, and that the
+// time zone is set when the start or the end is in RFC 3339.
+func validateScheduleOnetime(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start") || !d.NewValueKnown("end") || !d.NewValueKnown("timezone") {
+		return nil
+	}
+
+	if d.Get("timezone").(string) == "" {
+		for _, k := range []string{"start", "end"} {
+			if scheduleTimeRFC3339(d.Get(k).(string)) {
+				return fmt.Errorf("timezone is required when %s is in RFC 3339", k)
+			}
+		}
+	}
+
+	loc, err := scheduleLocation(d.Get("timezone").(string))
+	if err != nil {
+		return nil
+	}
+
+	start, err1 := scheduleTime(d.Get("start").(string), loc)
+	end, err2 := scheduleTime(d.Get("end").(string), loc)
+	if err1 == nil && err2 == nil && !start.Before(end) {
+		return fmt.Errorf("end %s must be after start %s", end.Format(scheduleTimeLayout), start.Format(scheduleTimeLayout))
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package fortios
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestScheduleLocation(t *testing.T) {
+	loc, err := scheduleLocation("")
+	if err != nil || loc != time.UTC {
+		t.Errorf("got %v, %v for no time zone, want UTC", loc, err)
+	}
+
+	if loc, err := scheduleLocation("Europe/Paris"); err != nil || loc.String() != "Europe/Paris" {
+		t.Errorf("got %v, %v for Europe/Paris", loc, err)
+	}
+
+	if _, es := validateScheduleTimezone("Mars/Olympus", "timezone"); len(es) == 0 {
+		t.Errorf("unknown time zone not rejected")
+	}
+}
+
+func TestScheduleTimeFortiOS(t *testing.T) {
+	cases := []struct {
+		s, tz string
+		want  string
+		err   bool
+	}{
+		{s: "18:00 2024/01/31", want: "18:00 2024/01/31"},
+		{s: " 8:05 2024/1/3 ", want: "08:05 2024/01/03"},
+		{s: "18:00 2024/01/31", tz: "Europe/Paris", want: "18:00 2024/01/31"},
+		{s: "2024-01-31T17:00:00Z", tz: "Europe/Paris", want: "18:00 2024/01/31"},
+		{s: "2024-07-31T17:00:00Z", tz: "Europe/Paris", want: "19:00 2024/07/31"},
+		{s: "2024-01-31T23:30:00-05:00", tz: "UTC", want: "04:30 2024/02/01"},
+		{s: "2024-01-31T17:00:00Z", err: true},
+		{s: "2024/01/31 18:00", tz: "UTC", err: true},
+		{s: "25:00 2024/01/31", tz: "UTC", err: true},
+		{s: "18:00 2024/01/31", tz: "Mars/Olympus", err: true},
+	}
+
+	for _, c := range cases {
+		s, err := scheduleTimeFortiOS(c.s, c.tz)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q in %q: no error", c.s, c.tz)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q in %q: unexpected error %v", c.s, c.tz, err)
+			continue
+		}
+		if s != c.want {
+			t.Errorf("%q in %q: got %q, want %q", c.s, c.tz, s, c.want)
+		}
+	}
+
+	if _, es := validateScheduleTime("2024-01-31T17:00:00Z", "start"); len(es) != 0 {
+		t.Errorf("RFC 3339 rejected: %v", es)
+	}
+	if _, es := validateScheduleTime("tomorrow", "start"); len(es) == 0 {
+		t.Errorf("invalid time not rejected")
+	}
+}
+
+func TestScheduleStatus(t *testing.T) {
+	now := time.Date(2024, 1, 31, 17, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		start, end, tz  string
+		expired, active bool
+	}{
+		{"17:00 2024/01/31", "18:00 2024/01/31", "UTC", false, true},
+		{"17:00 2024/01/31", "17:30 2024/01/31", "UTC", true, false},
+		{"17:45 2024/01/31", "18:00 2024/01/31", "UTC", false, false},
+		// 17:30 UTC is 18:30 in Paris
+		{"17:00 2024/01/31", "18:00 2024/01/31", "Europe/Paris", true, false},
+		{"18:00 2024/01/31", "19:00 2024/01/31", "Europe/Paris", false, true},
+		{"2024-01-31T17:00:00Z", "2024-01-31T18:00:00Z", "Asia/Tokyo", false, true},
+	}
+
+	for _, c := range cases {
+		expired, active, err := scheduleStatus(c.start, c.end, c.tz, now)
+		if err != nil {
+			t.Errorf("%s to %s in %q: unexpected error %v", c.start, c.end, c.tz, err)
+			continue
+		}
+		if expired != c.expired || active != c.active {
+			t.Errorf("%s to %s in %q: got expired %v, active %v, want %v, %v", c.start, c.end, c.tz, expired, active, c.expired, c.active)
+		}
+	}
+
+	if _, _, err := scheduleStatus("never", "18:00 2024/01/31", "UTC", now); err == nil {
+		t.Errorf("invalid start: no error")
+	}
+	// the times of FortiOS are not compared with now without the time zone of the FortiGate
+	if _, _, err := scheduleStatus("17:00 2024/01/31", "18:00 2024/01/31", "", now); err == nil {
+		t.Errorf("no time zone: no error")
+	}
+}
+
+func TestSuppressScheduleTimeDiff(t *testing.T) {
+	d := resourceFirewallScheduleOnetime().TestResourceData()
+	d.Set("timezone", "Europe/Paris")
+
+	if !suppressScheduleTimeDiff("start", "18:00 2024/01/31", "2024-01-31T17:00:00Z", d) {
+		t.Errorf("same time in RFC 3339 not suppressed")
+	}
+	if suppressScheduleTimeDiff("start", "17:00 2024/01/31", "2024-01-31T17:00:00Z", d) {
+		t.Errorf("other time suppressed")
+	}
+
+	// without time zone, an RFC 3339 time cannot be compared
+	d.Set("timezone", "")
+	if suppressScheduleTimeDiff("start", "17:00 2024/01/31", "2024-01-31T17:00:00Z", d) {
+		t.Errorf("RFC 3339 time without time zone suppressed")
+	}
+	if !suppressScheduleTimeDiff("start", "17:00 2024/01/31", "17:0 2024/1/31", d) {
+		t.Errorf("same time in FortiOS format not suppressed")
+	}
+}
+
+func TestValidateScheduleOnetime(t *testing.T) {
+	cases := []struct {
+		config map[string]interface{}
+		err    string
+	}{
+		{
+			config: map[string]interface{}{"start": "17:00 2024/01/31", "end": "18:00 2024/01/31"},
+		},
+		{
+			config: map[string]interface{}{"start": "2024-01-31T17:00:00Z", "end": "2024-01-31T18:00:00Z", "timezone": "Europe/Paris"},
+		},
+		{
+			config: map[string]interface{}{"start": "18:00 2024/01/31", "end": "17:00 2024/01/31"},
+			err:    "must be after start",
+		},
+		{
+			// 18:00 in Paris is 17:00 UTC
+			config: map[string]interface{}{"start": "18:00 2024/01/31", "end": "2024-01-31T17:00:00Z", "timezone": "Europe/Paris"},
+			err:    "must be after start",
+		},
+		{
+			config: map[string]interface{}{"start": "2024-01-31T17:00:00Z", "end": "18:00 2024/01/31"},
+			err:    "timezone is required when start is in RFC 3339",
+		},
+		{
+			config: map[string]interface{}{"start": "17:00 2024/01/31", "end": "2024-01-31T18:00:00Z"},
+			err:    "timezone is required when end is in RFC 3339",
+		},
+	}
+
+	for _, c := range cases {
+		c.config["name"] = "once"
+
+		_, err := resourceFirewallScheduleOnetime().Diff(nil, terraform.NewResourceConfigRaw(c.config), nil)
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%v: unexpected error %v", c.config, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%v: got error %v, want %q", c.config, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package fortios
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// servicePortRange is an entry of the port ranges of a custom service, dst[-dst][:src[-src]]
+// in FortiOS syntax. Src is 0 to 0 when the entry has no source ports.
+type servicePortRange struct {
+	Dst valueRange
+	Src valueRange
+}
+
+func (r servicePortRange) String() string {
+	s := servicePortRangeString(r.Dst)
+	if r.Src != (valueRange{}) {
+		s += ":" + servicePortRangeString(r.Src)
+	}
+	return s
+}
+
+func servicePortRangeString(r valueRange) string {
+	if r.Lo == r.Hi {
+		return strconv.Itoa(int(r.Lo))
+	}
+	return fmt.Sprintf("%d-%d", r.Lo, r.Hi)
+}
+
+// servicePortRanges parses the port ranges of a custom service, such as "80 443" or
+// "1024-2048:53", as the tcp-portrange, udp-portrange and sctp-portrange attributes take them.
+func servicePortRanges(s string) ([]servicePortRange, error) {
+	var res []servicePortRange
+
+	for _, f := range strings.Fields(s) {
+		var r servicePortRange
+		var err error
+
+		dst := f
+		if i := strings.Index(f, ":"); i >= 0 {
+			dst = f[:i]
+			if r.Src, err = analysisPortRange(f[i+1:]); err != nil {
+				return nil, fmt.Errorf("invalid source ports %q in %q", f[i+1:], f)
+			}
+		}
+		if r.Dst, err = analysisPortRange(dst); err != nil {
+			return nil, fmt.Errorf("invalid destination ports %q in %q", dst, f)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// servicePortRangesNormalize returns the port ranges sorted without duplicates, for the
+// port ranges in another order not to be drift. Invalid port ranges are returned unchanged.
+func servicePortRangesNormalize(s string) string {
+	l, err := servicePortRanges(s)
+	if err != nil {
+		return s
+	}
+
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Dst != l[j].Dst {
+			return l[i].Dst.Lo < l[j].Dst.Lo || l[i].Dst.Lo == l[j].Dst.Lo && l[i].Dst.Hi < l[j].Dst.Hi
+		}
+		return l[i].Src.Lo < l[j].Src.Lo || l[i].Src.Lo == l[j].Src.Lo && l[i].Src.Hi < l[j].Src.Hi
+	})
+
+	res := make([]string, 0, len(l))
+	for i, r := range l {
+		if i > 0 && r == l[i-1] {
+			continue
+		}
+		res = append(res, r.String())
+	}
+	return strings.Join(res, " ")
+}
+
+func validateServicePortRanges(v interface{}, k string) (ws []string, es []error) {
+	if _, err := servicePortRanges(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s: %v, expected dst[-dst][:src[-src]] entries separated by spaces", k, err))
+	}
+	return
+}
+
+func suppressServicePortRangesDiff(k, old, new string, d *schema.ResourceData) bool {
+	return servicePortRangesNormalize(old) == servicePortRangesNormalize(new)
+}
+
+// servicePortsArgs maps the structured port blocks of fortios_firewallservice_custom to the
+// port range arguments they are an alternative to.
+var servicePortsArgs = map[string]string{
+	"tcp_ports":  "tcp_portrange",
+	"udp_ports":  "udp_portrange",
+	"sctp_ports": "sctp_portrange",
+}
+
+func servicePortsSchema(portrange string) *schema.Schema {
+	port := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Optional:     true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{portrange},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dst_low": &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 65535),
+					Required:     true,
+				},
+				"dst_high": port(),
+				"src_low":  port(),
+				"src_high": port(),
+			},
+		},
+	}
+}
+
+// servicePortsRanges converts the structured port blocks to port ranges. A high port of
+// 0 is the low port.
+func servicePortsRanges(l []interface{}) ([]servicePortRange, error) {
+	res := make([]servicePortRange, 0, len(l))
+
+	for _, e := range l {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		bound := func(lo, hi string) (valueRange, error) {
+			r := valueRange{Lo: uint32(m[lo].(int)), Hi: uint32(m[hi].(int))}
+			if r.Hi == 0 {
+				r.Hi = r.Lo
+			}
+			if r.Lo > r.Hi {
+				return r, fmt.Errorf("%s %d is greater than %s %d", lo, r.Lo, hi, r.Hi)
+			}
+			return r, nil
+		}
+
+		var r servicePortRange
+		var err error
+		if r.Dst, err = bound("dst_low", "dst_high"); err != nil {
+			return nil, err
+		}
+		if r.Src, err = bound("src_low", "src_high"); err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// servicePortsString converts the structured port blocks to port ranges in FortiOS syntax.
+func servicePortsString(l []interface{}) (string, error) {
+	ranges, err := servicePortsRanges(l)
+	if err != nil {
+		return "", err
+	}
+
+	res := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		res = append(res, r.String())
+	}
+	return strings.Join(res, " "), nil
+}
+
+// flattenServicePorts converts port ranges as FortiOS returns them to the structured port
+// blocks, in the order of the blocks configured when it has the same ranges.
+func flattenServicePorts(v interface{}, configured []interface{}) []interface{} {
+	s, _ := v.(string)
+	if c, err := servicePortsString(configured); err == nil && servicePortRangesNormalize(c) == servicePortRangesNormalize(s) {
+		return configured
+	}
+
+	ranges, err := servicePortRanges(s)
+	if err != nil {
+		return nil
+	}
+
+	res := make([]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		m := map[string]interface{}{
+			"dst_low":  int(r.Dst.Lo),
+			"dst_high": int(r.Dst.Hi),
+			"src_low":  int(r.Src.Lo),
+			"src_high": int(r.Src.Hi),
+		}
+		if r.Dst.Hi == r.Dst.Lo {
+			m["dst_high"] = 0
+		}
+		if r.Src.Hi == r.Src.Lo {
+			m["src_high"] = 0
+		}
+		res = append(res, m)
+	}
+	return res
+}
+
+// validateServicePorts checks the structured port blocks of the plan.
+func validateServicePorts(d *schema.ResourceDiff, m interface{}) error {
+	for k := range servicePortsArgs {
+		if v, ok := d.GetOk(k); ok {
+			if _, err := servicePortsRanges(v.([]interface{})); err != nil {
+				return fmt.Errorf("invalid %s: %v", k, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package fortios
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestServicePortRanges(t *testing.T) {
+	cases := []struct {
+		s    string
+		want []servicePortRange
+		err  bool
+	}{
+		{s: "", want: nil},
+		{s: "80", want: []servicePortRange{{Dst: valueRange{80, 80}}}},
+		{s: "80 443", want: []servicePortRange{{Dst: valueRange{80, 80}}, {Dst: valueRange{443, 443}}}},
+		{s: "1024-2048", want: []servicePortRange{{Dst: valueRange{1024, 2048}}}},
+		{s: "53:1024-65535", want: []servicePortRange{{Dst: valueRange{53, 53}, Src: valueRange{1024, 65535}}}},
+		{s: "1024-2048:53", want: []servicePortRange{{Dst: valueRange{1024, 2048}, Src: valueRange{53, 53}}}},
+		{s: "  80:1-2\t8080 ", want: []servicePortRange{{Dst: valueRange{80, 80}, Src: valueRange{1, 2}}, {Dst: valueRange{8080, 8080}}}},
+		{s: "http", err: true},
+		{s: "65536", err: true},
+		{s: "2048-1024", err: true},
+		{s: "80:", err: true},
+		{s: ":80", err: true},
+		{s: "80:2-1", err: true},
+		{s: "80:53:53", err: true},
+		{s: "80-", err: true},
+	}
+
+	for _, c := range cases {
+		l, err := servicePortRanges(c.s)
+		if c.err {
+			if err == nil {
+				t.Errorf("%q: no error", c.s)
+			}
+			if _, es := validateServicePortRanges(c.s, "tcp_portrange"); len(es) == 0 {
+				t.Errorf("%q: not rejected by the validation", c.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", c.s, err)
+			continue
+		}
+		if !reflect.DeepEqual(l, c.want) {
+			t.Errorf("%q: got %v, want %v", c.s, l, c.want)
+		}
+		if _, es := validateServicePortRanges(c.s, "tcp_portrange"); len(es) != 0 {
+			t.Errorf("%q: rejected by the validation: %v", c.s, es)
+		}
+	}
+}
+
+func TestServicePortRangeString(t *testing.T) {
+	cases := []struct {
+		r    servicePortRange
+		want string
+	}{
+		{servicePortRange{Dst: valueRange{80, 80}}, "80"},
+		{servicePortRange{Dst: valueRange{1, 65535}}, "1-65535"},
+		{servicePortRange{Dst: valueRange{53, 53}, Src: valueRange{1024, 65535}}, "53:1024-65535"},
+		{servicePortRange{Dst: valueRange{1024, 2048}, Src: valueRange{53, 53}}, "1024-2048:53"},
+	}
+
+	for _, c := range cases {
+		if s := c.r.String(); s != c.want {
+			t.Errorf("got %q, want %q", s, c.want)
+		}
+	}
+}
+
+func TestServicePortRangesNormalize(t *testing.T) {
+	cases := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"443 80", "80 443"},
+		{"80 80 443", "80 443"},
+		{"80-80", "80"},
+		{"80:0", "80"},
+		{"80:1024 80 80:53", "80 80:53 80:1024"},
+		{"100-200 100-150 100:5-6 100:5", "100:5 100:5-6 100-150 100-200"},
+		{" 53:1-2  53:1-2 ", "53:1-2"},
+		{"80 http", "80 http"},
+	}
+
+	for _, c := range cases {
+		if s := servicePortRangesNormalize(c.s); s != c.want {
+			t.Errorf("%q: got %q, want %q", c.s, s, c.want)
+		}
+	}
+
+	if !suppressServicePortRangesDiff("tcp_portrange", "443 80:1024-65535", "80:1024-65535 443 443", nil) {
+		t.Errorf("same ranges in another order not suppressed")
+	}
+	if suppressServicePortRangesDiff("tcp_portrange", "80:1024", "80", nil) {
+		t.Errorf("source ports dropped suppressed")
+	}
+}
+
+func TestServicePorts(t *testing.T) {
+	block := func(dstLow, dstHigh, srcLow, srcHigh int) map[string]interface{} {
+		return map[string]interface{}{"dst_low": dstLow, "dst_high": dstHigh, "src_low": srcLow, "src_high": srcHigh}
+	}
+
+	l := []interface{}{block(80, 0, 0, 0), block(1024, 2048, 53, 0), block(53, 53, 1024, 65535)}
+	s, err := servicePortsString(l)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if s != "80 1024-2048:53 53:1024-65535" {
+		t.Errorf("got %q", s)
+	}
+
+	for _, b := range []map[string]interface{}{block(2048, 1024, 0, 0), block(80, 0, 2, 1)} {
+		if _, err := servicePortsString([]interface{}{b}); err == nil {
+			t.Errorf("%v: no error", b)
+		}
+	}
+
+	// the blocks configured are kept when FortiOS returns the same ranges in another order
+	if got := flattenServicePorts("53:1024-65535 80 1024-2048:53", l); !reflect.DeepEqual(got, l) {
+		t.Errorf("got %v, want the blocks configured", got)
+	}
+
+	want := []interface{}{block(80, 0, 0, 0), block(1024, 2048, 53, 0)}
+	if got := flattenServicePorts("80 1024-2048:53", nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := flattenServicePorts("80 1024-2048:53", l[:1]); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := flattenServicePorts("http", nil); got != nil {
+		t.Errorf("got %v for invalid ranges", got)
+	}
+}
@@ -0,0 +1,150 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceTableMove returns the resource moving an entry of the table t before or after
+// another one.
+func resourceTableMove(t orderTable) *schema.Resource {
+	key := schema.TypeString
+	if t.Integer {
+		key = schema.TypeInt
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableMoveCreateUpdate(d, m, t)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableMoveRead(d, m, t)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableMoveCreateUpdate(d, m, t)
+		},
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			t.Arg + "_src": &schema.Schema{
+				Type:     key,
+				Required: true,
+			},
+			t.Arg + "_dst": &schema.Schema{
+				Type:     key,
+				Required: true,
+			},
+			"move": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"before", "after"}, false),
+			},
+			"state_policy_srcdst_pos": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceTableMoveCreateUpdate(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	mv := orderMove{
+		ID:    fmt.Sprintf("%v", d.Get(t.Arg+"_src")),
+		Where: d.Get("move").(string),
+		Dst:   fmt.Sprintf("%v", d.Get(t.Arg+"_dst")),
+	}
+
+	log.Printf("[INFO] Moving %s %s %s %s", t.Name, mv.ID, mv.Where, mv.Dst)
+	orderForget(m, t, vdomparam)
+	if err := orderApply(c, t, mv, vdomparam); err != nil {
+		return fmt.Errorf("Error moving %s %s %s %s: %v", t.Name, mv.ID, mv.Where, mv.Dst, err)
+	}
+
+	d.SetId(mv.ID)
+
+	return resourceTableMoveRead(d, m, t)
+}
+
+func resourceTableMoveRead(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	current, err := orderRead(c, t, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading order of %s: %v", t.Path, err)
+	}
+
+	sid := fmt.Sprintf("%v", d.Get(t.Arg+"_src"))
+	did := fmt.Sprintf("%v", d.Get(t.Arg+"_dst"))
+
+	d.Set("state_policy_srcdst_pos", orderMoveState(t, current, t.Arg+"_src", sid, t.Arg+"_dst", did, d.Get("move").(string)))
+
+	return nil
+}
+
+// orderMoveState describes where the entry sid is relative to did in current, "" if it
+// is before or after it as moved. srcArg and dstArg are the arguments holding them.
+func orderMoveState(t orderTable, current []string, srcArg, sid, dstArg, did, where string) string {
+	src := fmt.Sprintf("%s(%s)", srcArg, sid)
+	dst := fmt.Sprintf("%s(%s)", dstArg, did)
+
+	ns := orderIndex(current, sid)
+	nd := orderIndex(current, did)
+
+	switch {
+	case ns < 0 && nd < 0:
+		return fmt.Sprintf("%s with %s and %s with %s were deleted", t.Name, src, t.Name, dst)
+	case ns < 0:
+		return fmt.Sprintf("%s with %s was deleted", t.Name, src)
+	case nd < 0:
+		return fmt.Sprintf("%s with %s was deleted", t.Name, dst)
+	case where == "before" && ns == nd-1, where == "after" && ns == nd+1:
+		return ""
+	case ns > nd:
+		return fmt.Sprintf("%s with %s is %d behind %s with %s", t.Name, src, ns-nd, t.Name, dst)
+	default:
+		return fmt.Sprintf("%s with %s is %d ahead of %s with %s", t.Name, src, nd-ns, t.Name, dst)
+	}
+}
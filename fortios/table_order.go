@@ -0,0 +1,408 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// orderTable is a CMDB table whose entries are evaluated in order, such as firewall policy.
+type orderTable struct {
+	// Path is the API path of the table
+	Path string
+	// Mkey is the FortiOS key of the entries
+	Mkey string
+	// Arg is the argument of the resource of the entries holding the key
+	Arg string
+	// Integer is set if the key is a number
+	Integer bool
+	// Name is what the entries are called in messages, such as "policy"
+	Name string
+}
+
+// orderTableNames maps the resource types of the entries of the tables evaluated in order
+// to what the entries are called.
+var orderTableNames = map[string]string{
+	"fortios_authentication_rule":       "rule",
+	"fortios_firewall_DoSpolicy":        "policy",
+	"fortios_firewall_DoSpolicy6":       "policy",
+	"fortios_firewall_centralsnatmap":   "policy",
+	"fortios_firewall_interfacepolicy":  "policy",
+	"fortios_firewall_interfacepolicy6": "policy",
+	"fortios_firewall_localinpolicy":    "policy",
+	"fortios_firewall_localinpolicy6":   "policy",
+	"fortios_firewall_multicastpolicy":  "policy",
+	"fortios_firewall_multicastpolicy6": "policy",
+	"fortios_firewall_policy":           "policy",
+	"fortios_firewall_policy46":         "policy",
+	"fortios_firewall_policy6":          "policy",
+	"fortios_firewall_policy64":         "policy",
+	"fortios_firewall_proxypolicy":      "policy",
+	"fortios_firewall_shapingpolicy":    "policy",
+	"fortios_firewall_ttlpolicy":        "policy",
+	"fortios_router_policy":             "policy",
+	"fortios_router_policy6":            "policy",
+}
+
+// orderTableOf returns the ordered table of the entries of the resource type rtype.
+func orderTableOf(rtype string) orderTable {
+	t := cmdbTables[rtype]
+
+	return orderTable{
+		Path:    t.Path,
+		Mkey:    fortiAPIKey(t.Mkey),
+		Arg:     t.Mkey,
+		Integer: t.MkeyType == "integer",
+		Name:    orderTableNames[rtype],
+	}
+}
+
+// orderMove moves the entry ID before or after Dst.
+type orderMove struct {
+	ID    string
+	Where string
+	Dst   string
+}
+
+// orderRead returns the keys of the entries of the table in order.
+func orderRead(c *forticlient.FortiSDKClient, t orderTable, vdomparam string) ([]string, error) {
+	o, err := dataSourceListGroupRead(c, t.Path, "format="+t.Mkey, 0, vdomparam)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(o))
+	for _, r := range o {
+		if i, ok := r.(map[string]interface{}); ok && i[t.Mkey] != nil {
+			ids = append(ids, importMkeyString(i[t.Mkey]))
+		}
+	}
+
+	return ids, nil
+}
+
+// orderApply moves the entry id before or after dst.
+func orderApply(c *forticlient.FortiSDKClient, t orderTable, mv orderMove, vdomparam string) error {
+	params := "action=move&" + mv.Where + "=" + forticlient.EscapeURLString(mv.Dst)
+
+	_, err := fortiGenericRequest(c, "PUT", t.Path+"/"+forticlient.EscapeURLString(mv.ID), params, nil, vdomparam)
+	return err
+}
+
+// orderTarget returns the order the table should have: the listed entries in order, and
+// the other entries in their current order above them for unlisted "top", below them for
+// "bottom". For "any" only the listed entries are returned, the others can be anywhere.
+func orderTarget(current, listed []string, unlisted string) []string {
+	if unlisted == "any" {
+		return listed
+	}
+
+	in := make(map[string]bool, len(listed))
+	for _, id := range listed {
+		in[id] = true
+	}
+
+	var others []string
+	for _, id := range current {
+		if !in[id] {
+			others = append(others, id)
+		}
+	}
+
+	if unlisted == "top" {
+		return append(others, listed...)
+	}
+	return append(append([]string{}, listed...), others...)
+}
+
+// orderMoves returns the fewest moves reordering current so that the entries of target
+// are in its order. The entries in the longest subsequence of current already in the
+// order of target stay in place, every other entry of target is moved once.
+func orderMoves(current, target []string) []orderMove {
+	rank := make(map[string]int, len(target))
+	for i, id := range target {
+		rank[id] = i
+	}
+
+	// The ranks of the entries of target in their current order
+	var seq []int
+	for _, id := range current {
+		if r, ok := rank[id]; ok {
+			seq = append(seq, r)
+		}
+	}
+
+	// Longest increasing subsequence of seq, in O(n log n)
+	tails := []int{}
+	tailIdx := []int{}
+	prev := make([]int, len(seq))
+	for i, r := range seq {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if tails[mid] < r {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[i] = tailIdx[lo-1]
+		} else {
+			prev[i] = -1
+		}
+		if lo == len(tails) {
+			tails = append(tails, r)
+			tailIdx = append(tailIdx, i)
+		} else {
+			tails[lo] = r
+			tailIdx[lo] = i
+		}
+	}
+
+	kept := make(map[int]bool, len(tails))
+	if len(tailIdx) > 0 {
+		for i := tailIdx[len(tailIdx)-1]; i >= 0; i = prev[i] {
+			kept[seq[i]] = true
+		}
+	}
+
+	first := -1
+	for i := range target {
+		if kept[i] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return nil
+	}
+
+	// Entries are placed in the order of target, after the entry before them which is in
+	// place by then, or before the first kept entry for the first entry of target.
+	var moves []orderMove
+	for i, id := range target {
+		if kept[i] {
+			continue
+		}
+		if i == 0 {
+			moves = append(moves, orderMove{ID: id, Where: "before", Dst: target[first]})
+		} else {
+			moves = append(moves, orderMove{ID: id, Where: "after", Dst: target[i-1]})
+		}
+	}
+
+	return moves
+}
+
+func orderIndex(l []string, id string) int {
+	for i, v := range l {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// orderInPlace reports whether the entries of target are in its order in current.
+func orderInPlace(current, target []string) bool {
+	return len(orderMoves(current, target)) == 0
+}
+
+// resourceTableOrder returns the resource setting the full order of the table t.
+func resourceTableOrder(t orderTable) *schema.Resource {
+	elem := schema.TypeString
+	if t.Integer {
+		elem = schema.TypeInt
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableOrderCreateUpdate(d, m, t)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableOrderRead(d, m, t)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableOrderCreateUpdate(d, m, t)
+		},
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"order": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: elem},
+			},
+			"unlisted": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "any",
+				ValidateFunc: validation.StringInSlice([]string{"any", "top", "bottom"}, false),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"current_order": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: elem},
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func orderListed(d *schema.ResourceData, name string) ([]string, error) {
+	var ids []string
+	seen := make(map[string]bool)
+
+	for _, v := range d.Get("order").([]interface{}) {
+		id := fmt.Sprintf("%v", v)
+		if seen[id] {
+			return nil, fmt.Errorf("%s %s is listed more than once", name, id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func orderValues(t orderTable, ids []string) []interface{} {
+	res := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if t.Integer {
+			if i, err := strconv.Atoi(id); err == nil {
+				res = append(res, i)
+				continue
+			}
+		}
+		res = append(res, id)
+	}
+	return res
+}
+
+func resourceTableOrderCreateUpdate(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	listed, err := orderListed(d, t.Name)
+	if err != nil {
+		return fmt.Errorf("Error ordering %s: %v", t.Path, err)
+	}
+
+	current, err := orderRead(c, t, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error ordering %s: %v", t.Path, err)
+	}
+
+	var missing []string
+	for _, id := range listed {
+		if orderIndex(current, id) < 0 {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("Error ordering %s: %s %s not found", t.Path, t.Name, strings.Join(missing, ", "))
+	}
+
+	moves := orderMoves(current, orderTarget(current, listed, d.Get("unlisted").(string)))
+	orderForget(m, t, vdomparam)
+	for _, mv := range moves {
+		log.Printf("[INFO] Moving %s %s %s %s", t.Name, mv.ID, mv.Where, mv.Dst)
+		if err := orderApply(c, t, mv, vdomparam); err != nil {
+			return fmt.Errorf("Error moving %s %s %s %s: %v", t.Name, mv.ID, mv.Where, mv.Dst, err)
+		}
+	}
+
+	d.SetId(strings.TrimPrefix(t.Path, "/api/v2/cmdb/"))
+
+	return resourceTableOrderRead(d, m, t)
+}
+
+func resourceTableOrderRead(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	listed, err := orderListed(d, t.Name)
+	if err != nil {
+		return fmt.Errorf("Error reading order of %s: %v", t.Path, err)
+	}
+
+	current, err := orderRead(c, t, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error reading order of %s: %v", t.Path, err)
+	}
+
+	// The listed entries which still exist, in the order they are listed and in their
+	// current order, which is saved so that the plan shows where they are
+	var actual, existing []string
+	for _, id := range current {
+		if orderIndex(listed, id) >= 0 {
+			actual = append(actual, id)
+		}
+	}
+	for _, id := range listed {
+		if orderIndex(current, id) >= 0 {
+			existing = append(existing, id)
+		}
+	}
+
+	if orderInPlace(current, orderTarget(current, existing, d.Get("unlisted").(string))) {
+		d.Set("status", "")
+	} else {
+		d.Set("status", "unordered")
+	}
+
+	if err := d.Set("order", orderValues(t, actual)); err != nil {
+		return fmt.Errorf("Error reading order: %v", err)
+	}
+	if err := d.Set("current_order", orderValues(t, current)); err != nil {
+		return fmt.Errorf("Error reading current_order: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package fortios
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testOrderApply returns the order of the table after the moves, as FortiOS applies them.
+func testOrderApply(t *testing.T, current []string, moves []orderMove) []string {
+	res := append([]string{}, current...)
+	for _, mv := range moves {
+		i := orderIndex(res, mv.ID)
+		if i < 0 || mv.ID == mv.Dst {
+			t.Fatalf("invalid move %+v of %v", mv, res)
+		}
+		res = append(res[:i], res[i+1:]...)
+
+		j := orderIndex(res, mv.Dst)
+		if j < 0 {
+			t.Fatalf("move %+v of %v: %s not found", mv, res, mv.Dst)
+		}
+		if mv.Where == "after" {
+			j++
+		}
+		res = append(res[:j], append([]string{mv.ID}, res[j:]...)...)
+	}
+	return res
+}
+
+func TestOrderTarget(t *testing.T) {
+	current := strings.Split("1,2,3,4,5", ",")
+	listed := strings.Split("4,2", ",")
+
+	cases := []struct {
+		unlisted string
+		want     string
+	}{
+		{"any", "4,2"},
+		{"top", "1,3,5,4,2"},
+		{"bottom", "4,2,1,3,5"},
+	}
+
+	for _, c := range cases {
+		if got := strings.Join(orderTarget(current, listed, c.unlisted), ","); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.unlisted, got, c.want)
+		}
+	}
+}
+
+func TestOrderMoves(t *testing.T) {
+	cases := []struct {
+		name            string
+		current, target string
+		moves           int
+	}{
+		{"in order", "1,2,3,4,5", "1,2,3,4,5", 0},
+		{"listed in order", "1,2,3,4,5", "2,4", 0},
+		{"one moved to the top", "1,2,3,4,5", "5,1,2,3,4", 1},
+		{"one moved to the bottom", "1,2,3,4,5", "2,3,4,5,1", 1},
+		{"one moved to the middle", "1,2,3,4,5", "1,4,2,3,5", 1},
+		{"two swapped", "1,2,3,4,5", "1,4,3,2,5", 2},
+		{"reversed", "1,2,3,4,5", "5,4,3,2,1", 4},
+		{"listed out of order", "1,2,3,4,5", "4,2", 1},
+		{"unlisted in between", "a,1,b,2,c,3", "3,1,2", 1},
+		{"interleaved", "6,1,5,2,4,3", "1,2,3,4,5,6", 3},
+		{"single", "1", "1", 0},
+		{"empty target", "1,2", "", 0},
+	}
+
+	for _, c := range cases {
+		current := strings.Split(c.current, ",")
+		var target []string
+		if c.target != "" {
+			target = strings.Split(c.target, ",")
+		}
+
+		moves := orderMoves(current, target)
+		if len(moves) != c.moves {
+			t.Errorf("%s: got %d moves %+v, want %d", c.name, len(moves), moves, c.moves)
+		}
+
+		// The entries of target are in its order once moved
+		var got []string
+		for _, id := range testOrderApply(t, current, moves) {
+			if orderIndex(target, id) >= 0 {
+				got = append(got, id)
+			}
+		}
+		if !reflect.DeepEqual(got, target) {
+			t.Errorf("%s: got %v after the moves, want %v", c.name, got, target)
+		}
+
+		if orderInPlace(current, target) != (c.moves == 0) {
+			t.Errorf("%s: orderInPlace is %v", c.name, !(c.moves == 0))
+		}
+	}
+}
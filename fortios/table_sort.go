@@ -0,0 +1,233 @@
+package fortios
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fortinetdev/forti-sdk-go/fortios/sdkcore"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceTableSort returns the resource sorting the entries of the table t by their key,
+// or by name for the tables whose entries have one.
+func resourceTableSort(t orderTable) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableSortCreateUpdate(d, m, t)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableSortRead(d, m, t)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceTableSortCreateUpdate(d, m, t)
+		},
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"vdomparam": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sortby": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sortdirection": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ascending", "descending"}, false),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"force_recreate": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// orderSortKey returns the FortiOS key the entries of t are sorted by for sortby, which is
+// the argument holding their key or name if they have one.
+func orderSortKey(t orderTable, sortby string) (string, error) {
+	if sortby == t.Arg {
+		return t.Mkey, nil
+	}
+
+	if sortby == "name" {
+		if r, _ := cliConfigResource(strings.TrimPrefix(t.Path, "/api/v2/cmdb/")); r != nil && r.Schema["name"] != nil {
+			return "name", nil
+		}
+	}
+
+	return "", fmt.Errorf("Unsupported sort type: %s", sortby)
+}
+
+// orderSorted returns the keys of the entries of the table in their current order and
+// sorted by the field key.
+func orderSorted(c *forticlient.FortiSDKClient, t orderTable, key, direction, vdomparam string) ([]string, []string, error) {
+	format := t.Mkey
+	if key != t.Mkey {
+		format += "|" + key
+	}
+
+	o, err := dataSourceListGroupRead(c, t.Path, "format="+format, 0, vdomparam)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type entry struct {
+		id    string
+		value string
+	}
+
+	var entries []entry
+	for _, r := range o {
+		if i, ok := r.(map[string]interface{}); ok && i[t.Mkey] != nil {
+			entries = append(entries, entry{id: importMkeyString(i[t.Mkey]), value: cmdbObjectString(i[key])})
+		}
+	}
+
+	current := make([]string, 0, len(entries))
+	for _, e := range entries {
+		current = append(current, e.id)
+	}
+
+	less := func(a, b string) bool {
+		if key == t.Mkey && t.Integer {
+			x, errx := strconv.Atoi(a)
+			y, erry := strconv.Atoi(b)
+			if errx == nil && erry == nil {
+				return x < y
+			}
+		}
+		return a < b
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if direction == "descending" {
+			return less(entries[j].value, entries[i].value)
+		}
+		return less(entries[i].value, entries[j].value)
+	})
+
+	target := make([]string, 0, len(entries))
+	for _, e := range entries {
+		target = append(target, e.id)
+	}
+
+	return current, target, nil
+}
+
+func resourceTableSortCreateUpdate(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	sortby := d.Get("sortby").(string)
+	sortdirection := d.Get("sortdirection").(string)
+
+	if err := orderSort(m, t, sortby, sortdirection, vdomparam); err != nil {
+		return err
+	}
+
+	d.SetId(sortby + sortdirection)
+
+	return resourceTableSortRead(d, m, t)
+}
+
+func resourceTableSortRead(d *schema.ResourceData, m interface{}, t orderTable) error {
+	c := m.(*FortiClient).Client
+
+	if c == nil {
+		return fmt.Errorf("FortiOS connection did not initialize successfully!")
+	}
+
+	c.Retries = 1
+
+	vdomparam := ""
+
+	if v, ok := d.GetOk("vdomparam"); ok {
+		if s, ok := v.(string); ok {
+			vdomparam = s
+		}
+	}
+
+	status, err := orderSortStatus(c, t, d.Get("sortby").(string), d.Get("sortdirection").(string), vdomparam)
+	if err != nil {
+		return err
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+// orderSort moves the entries of the table t to sort them by sortby in direction.
+func orderSort(m interface{}, t orderTable, sortby, direction, vdomparam string) error {
+	c := m.(*FortiClient).Client
+
+	key, err := orderSortKey(t, sortby)
+	if err != nil {
+		return err
+	}
+
+	current, target, err := orderSorted(c, t, key, direction, vdomparam)
+	if err != nil {
+		return fmt.Errorf("Error sorting %s: %v", t.Path, err)
+	}
+
+	orderForget(m, t, vdomparam)
+	for _, mv := range orderMoves(current, target) {
+		log.Printf("[INFO] Moving %s %s %s %s", t.Name, mv.ID, mv.Where, mv.Dst)
+		if err := orderApply(c, t, mv, vdomparam); err != nil {
+			return fmt.Errorf("Error moving %s %s %s %s: %v", t.Name, mv.ID, mv.Where, mv.Dst, err)
+		}
+	}
+
+	return nil
+}
+
+// orderSortStatus returns "" if the entries of the table t are sorted by sortby in
+// direction, "unsorted" otherwise.
+func orderSortStatus(c *forticlient.FortiSDKClient, t orderTable, sortby, direction, vdomparam string) (string, error) {
+	key, err := orderSortKey(t, sortby)
+	if err != nil {
+		return "", err
+	}
+
+	current, target, err := orderSorted(c, t, key, direction, vdomparam)
+	if err != nil {
+		return "", fmt.Errorf("Error reading sort status of %s: %v", t.Path, err)
+	}
+
+	if orderInPlace(current, target) {
+		return "", nil
+	}
+	return "unsorted", nil
+}
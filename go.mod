@@ -7,4 +7,5 @@ require (
 	github.com/google/uuid v1.1.2
 	github.com/hashicorp/go-version v1.3.0
 	github.com/hashicorp/terraform-plugin-sdk v1.17.2
+	golang.org/x/crypto v0.0.0-20210322153248-0c34fe9e7dc2
 )
@@ -1,11 +1,34 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/plugin"
 	"github.com/terraform-providers/terraform-provider-fortios/fortios"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		var err error
+
+		switch os.Args[1] {
+		case "generate":
+			err = fortios.Generate(os.Args[2:])
+		case "catalog":
+			err = fortios.Catalog(os.Args[2:])
+		default:
+			fmt.Fprintf(os.Stderr, "Unknown command %s, the supported commands are: generate, catalog\n", os.Args[1])
+			os.Exit(2)
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: fortios.Provider})
 }